@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+)
+
+var ErrMigrateUsage = errors.New("usage: betterreads migrate up | down [steps] | status")
+
+// runMigrate handles `betterreads migrate ...`, managing the schema without
+// starting the servers or connecting to Firebase.
+func runMigrate(ctx context.Context, out io.Writer, args []string) error {
+	if len(args) == 0 {
+		return ErrMigrateUsage
+	}
+
+	steps := 1
+	switch args[0] {
+	case "up", "status":
+		if len(args) != 1 {
+			return ErrMigrateUsage
+		}
+	case "down":
+		if len(args) > 2 { //nolint: mnd // down takes one optional argument
+			return ErrMigrateUsage
+		}
+		if len(args) == 2 { //nolint: mnd // down takes one optional argument
+			n, err := strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				return fmt.Errorf("%w: steps must be a positive integer", ErrMigrateUsage)
+			}
+			steps = n
+		}
+	default:
+		return ErrMigrateUsage
+	}
+
+	sqlClient, err := postgres.NewClient(ctx, postgres.Config{URL: SQLURL, MaxConns: 1, SkipMigrations: true})
+	if err != nil {
+		return fmt.Errorf("unable to connect to postgres client %w", err)
+	}
+	defer sqlClient.Close()
+
+	switch args[0] {
+	case "up":
+		applied, err := postgres.MigrateUp(ctx, sqlClient.DB)
+		printMigrations(out, "applied", applied)
+		return err
+	case "down":
+		reverted, err := postgres.MigrateDown(ctx, sqlClient.DB, steps)
+		printMigrations(out, "reverted", reverted)
+		return err
+	default:
+		statuses, err := postgres.GetMigrationStatus(ctx, sqlClient.DB)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0) //nolint: mnd // column padding
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range statuses {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%04d\t%s\t%s\n", s.Version, s.Name, appliedAt)
+		}
+		return w.Flush()
+	}
+}
+
+func printMigrations(out io.Writer, verb string, migrations []postgres.Migration) {
+	if len(migrations) == 0 {
+		fmt.Fprintf(out, "no migrations %s\n", verb)
+		return
+	}
+	for _, m := range migrations {
+		fmt.Fprintf(out, "%s %04d_%s\n", verb, m.Version, m.Name)
+	}
+}
@@ -188,6 +188,162 @@ func (BookRating) EnumDescriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{2}
 }
 
+type ImportRowStatus int32
+
+const (
+	ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED ImportRowStatus = 0
+	ImportRowStatus_IMPORT_ROW_STATUS_IMPORTED    ImportRowStatus = 1
+	ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED     ImportRowStatus = 2
+	ImportRowStatus_IMPORT_ROW_STATUS_FAILED      ImportRowStatus = 3
+)
+
+// Enum value maps for ImportRowStatus.
+var (
+	ImportRowStatus_name = map[int32]string{
+		0: "IMPORT_ROW_STATUS_UNSPECIFIED",
+		1: "IMPORT_ROW_STATUS_IMPORTED",
+		2: "IMPORT_ROW_STATUS_SKIPPED",
+		3: "IMPORT_ROW_STATUS_FAILED",
+	}
+	ImportRowStatus_value = map[string]int32{
+		"IMPORT_ROW_STATUS_UNSPECIFIED": 0,
+		"IMPORT_ROW_STATUS_IMPORTED":    1,
+		"IMPORT_ROW_STATUS_SKIPPED":     2,
+		"IMPORT_ROW_STATUS_FAILED":      3,
+	}
+)
+
+func (x ImportRowStatus) Enum() *ImportRowStatus {
+	p := new(ImportRowStatus)
+	*p = x
+	return p
+}
+
+func (x ImportRowStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportRowStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_betterreads_proto_enumTypes[3].Descriptor()
+}
+
+func (ImportRowStatus) Type() protoreflect.EnumType {
+	return &file_betterreads_proto_enumTypes[3]
+}
+
+func (x ImportRowStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportRowStatus.Descriptor instead.
+func (ImportRowStatus) EnumDescriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{3}
+}
+
+type ImportSource int32
+
+const (
+	ImportSource_IMPORT_SOURCE_UNSPECIFIED  ImportSource = 0
+	ImportSource_IMPORT_SOURCE_GOODREADS    ImportSource = 1
+	ImportSource_IMPORT_SOURCE_STORYGRAPH   ImportSource = 2
+	ImportSource_IMPORT_SOURCE_LIBRARYTHING ImportSource = 3
+)
+
+// Enum value maps for ImportSource.
+var (
+	ImportSource_name = map[int32]string{
+		0: "IMPORT_SOURCE_UNSPECIFIED",
+		1: "IMPORT_SOURCE_GOODREADS",
+		2: "IMPORT_SOURCE_STORYGRAPH",
+		3: "IMPORT_SOURCE_LIBRARYTHING",
+	}
+	ImportSource_value = map[string]int32{
+		"IMPORT_SOURCE_UNSPECIFIED":  0,
+		"IMPORT_SOURCE_GOODREADS":    1,
+		"IMPORT_SOURCE_STORYGRAPH":   2,
+		"IMPORT_SOURCE_LIBRARYTHING": 3,
+	}
+)
+
+func (x ImportSource) Enum() *ImportSource {
+	p := new(ImportSource)
+	*p = x
+	return p
+}
+
+func (x ImportSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_betterreads_proto_enumTypes[4].Descriptor()
+}
+
+func (ImportSource) Type() protoreflect.EnumType {
+	return &file_betterreads_proto_enumTypes[4]
+}
+
+func (x ImportSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportSource.Descriptor instead.
+func (ImportSource) EnumDescriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{4}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED    ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_JSON           ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_GOODREADS_CSV  ExportFormat = 2
+	ExportFormat_EXPORT_FORMAT_STORYGRAPH_CSV ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_JSON",
+		2: "EXPORT_FORMAT_GOODREADS_CSV",
+		3: "EXPORT_FORMAT_STORYGRAPH_CSV",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED":    0,
+		"EXPORT_FORMAT_JSON":           1,
+		"EXPORT_FORMAT_GOODREADS_CSV":  2,
+		"EXPORT_FORMAT_STORYGRAPH_CSV": 3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_betterreads_proto_enumTypes[5].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_betterreads_proto_enumTypes[5]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{5}
+}
+
 type ShelfSortOrder int32
 
 const (
@@ -233,11 +389,11 @@ func (x ShelfSortOrder) String() string {
 }
 
 func (ShelfSortOrder) Descriptor() protoreflect.EnumDescriptor {
-	return file_betterreads_proto_enumTypes[3].Descriptor()
+	return file_betterreads_proto_enumTypes[6].Descriptor()
 }
 
 func (ShelfSortOrder) Type() protoreflect.EnumType {
-	return &file_betterreads_proto_enumTypes[3]
+	return &file_betterreads_proto_enumTypes[6]
 }
 
 func (x ShelfSortOrder) Number() protoreflect.EnumNumber {
@@ -246,7 +402,62 @@ func (x ShelfSortOrder) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ShelfSortOrder.Descriptor instead.
 func (ShelfSortOrder) EnumDescriptor() ([]byte, []int) {
-	return file_betterreads_proto_rawDescGZIP(), []int{3}
+	return file_betterreads_proto_rawDescGZIP(), []int{6}
+}
+
+type GoalPace int32
+
+const (
+	GoalPace_GOAL_PACE_UNSPECIFIED GoalPace = 0
+	GoalPace_GOAL_PACE_ON_TRACK    GoalPace = 1
+	GoalPace_GOAL_PACE_AHEAD       GoalPace = 2
+	GoalPace_GOAL_PACE_BEHIND      GoalPace = 3
+	GoalPace_GOAL_PACE_COMPLETED   GoalPace = 4
+)
+
+// Enum value maps for GoalPace.
+var (
+	GoalPace_name = map[int32]string{
+		0: "GOAL_PACE_UNSPECIFIED",
+		1: "GOAL_PACE_ON_TRACK",
+		2: "GOAL_PACE_AHEAD",
+		3: "GOAL_PACE_BEHIND",
+		4: "GOAL_PACE_COMPLETED",
+	}
+	GoalPace_value = map[string]int32{
+		"GOAL_PACE_UNSPECIFIED": 0,
+		"GOAL_PACE_ON_TRACK":    1,
+		"GOAL_PACE_AHEAD":       2,
+		"GOAL_PACE_BEHIND":      3,
+		"GOAL_PACE_COMPLETED":   4,
+	}
+)
+
+func (x GoalPace) Enum() *GoalPace {
+	p := new(GoalPace)
+	*p = x
+	return p
+}
+
+func (x GoalPace) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GoalPace) Descriptor() protoreflect.EnumDescriptor {
+	return file_betterreads_proto_enumTypes[7].Descriptor()
+}
+
+func (GoalPace) Type() protoreflect.EnumType {
+	return &file_betterreads_proto_enumTypes[7]
+}
+
+func (x GoalPace) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GoalPace.Descriptor instead.
+func (GoalPace) EnumDescriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{7}
 }
 
 type PaginationMetadata struct {
@@ -254,9 +465,10 @@ type PaginationMetadata struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page  int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Total int32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Total         int32  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Opaque token for the next page; empty when the page was not full
 }
 
 func (x *PaginationMetadata) Reset() {
@@ -312,6 +524,13 @@ func (x *PaginationMetadata) GetTotal() int32 {
 	return 0
 }
 
+func (x *PaginationMetadata) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type Book struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -431,21 +650,25 @@ func (x *Book) GetTitle() string {
 	return ""
 }
 
-// Unified book details for posts - includes user's rating and review info
-type BookDetails struct {
+// One published form of a book
+type Edition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Author   string     `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
-	Title    string     `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	ImageUrl string     `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Rating   BookRating `protobuf:"varint,5,opt,name=rating,proto3,enum=betterreads.BookRating" json:"rating,omitempty"`
+	Id             string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Subtitle       string   `protobuf:"bytes,3,opt,name=subtitle,proto3" json:"subtitle,omitempty"`
+	Publishers     []string `protobuf:"bytes,4,rep,name=publishers,proto3" json:"publishers,omitempty"`
+	PublishDate    string   `protobuf:"bytes,5,opt,name=publish_date,json=publishDate,proto3" json:"publish_date,omitempty"` // As printed, e.g. "September 1990"
+	PageCount      int32    `protobuf:"varint,6,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	Isbn           string   `protobuf:"bytes,7,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	BookImage      string   `protobuf:"bytes,8,opt,name=book_image,json=bookImage,proto3" json:"book_image,omitempty"`
+	PhysicalFormat string   `protobuf:"bytes,9,opt,name=physical_format,json=physicalFormat,proto3" json:"physical_format,omitempty"`
 }
 
-func (x *BookDetails) Reset() {
-	*x = BookDetails{}
+func (x *Edition) Reset() {
+	*x = Edition{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -453,13 +676,13 @@ func (x *BookDetails) Reset() {
 	}
 }
 
-func (x *BookDetails) String() string {
+func (x *Edition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BookDetails) ProtoMessage() {}
+func (*Edition) ProtoMessage() {}
 
-func (x *BookDetails) ProtoReflect() protoreflect.Message {
+func (x *Edition) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -471,63 +694,89 @@ func (x *BookDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BookDetails.ProtoReflect.Descriptor instead.
-func (*BookDetails) Descriptor() ([]byte, []int) {
+// Deprecated: Use Edition.ProtoReflect.Descriptor instead.
+func (*Edition) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *BookDetails) GetId() string {
+func (x *Edition) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *BookDetails) GetAuthor() string {
+func (x *Edition) GetTitle() string {
 	if x != nil {
-		return x.Author
+		return x.Title
 	}
 	return ""
 }
 
-func (x *BookDetails) GetTitle() string {
+func (x *Edition) GetSubtitle() string {
 	if x != nil {
-		return x.Title
+		return x.Subtitle
 	}
 	return ""
 }
 
-func (x *BookDetails) GetImageUrl() string {
+func (x *Edition) GetPublishers() []string {
 	if x != nil {
-		return x.ImageUrl
+		return x.Publishers
+	}
+	return nil
+}
+
+func (x *Edition) GetPublishDate() string {
+	if x != nil {
+		return x.PublishDate
 	}
 	return ""
 }
 
-func (x *BookDetails) GetRating() BookRating {
+func (x *Edition) GetPageCount() int32 {
 	if x != nil {
-		return x.Rating
+		return x.PageCount
 	}
-	return BookRating_BOOK_RATING_UNSPECIFIED
+	return 0
 }
 
-type Post struct {
+func (x *Edition) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *Edition) GetBookImage() string {
+	if x != nil {
+		return x.BookImage
+	}
+	return ""
+}
+
+func (x *Edition) GetPhysicalFormat() string {
+	if x != nil {
+		return x.PhysicalFormat
+	}
+	return ""
+}
+
+type Author struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Description  string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Book         *BookDetails           `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
-	LikeCount    int32                  `protobuf:"varint,4,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
-	Summary      string                 `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
-	UserId       string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CommentCount int32                  `protobuf:"varint,7,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
-	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Bio       string `protobuf:"bytes,3,opt,name=bio,proto3" json:"bio,omitempty"`
+	PhotoUrl  string `protobuf:"bytes,4,opt,name=photo_url,json=photoUrl,proto3" json:"photo_url,omitempty"`
+	BirthDate string `protobuf:"bytes,5,opt,name=birth_date,json=birthDate,proto3" json:"birth_date,omitempty"` // As given by the source, e.g. "13 September 1916"
+	DeathDate string `protobuf:"bytes,6,opt,name=death_date,json=deathDate,proto3" json:"death_date,omitempty"` // Empty while the author is alive or when unknown
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
+func (x *Author) Reset() {
+	*x = Author{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -535,13 +784,13 @@ func (x *Post) Reset() {
 	}
 }
 
-func (x *Post) String() string {
+func (x *Author) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*Author) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
+func (x *Author) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -553,81 +802,70 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
+// Deprecated: Use Author.ProtoReflect.Descriptor instead.
+func (*Author) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Post) GetId() string {
+func (x *Author) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Post) GetDescription() string {
+func (x *Author) GetName() string {
 	if x != nil {
-		return x.Description
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Post) GetBook() *BookDetails {
-	if x != nil {
-		return x.Book
-	}
-	return nil
-}
-
-func (x *Post) GetLikeCount() int32 {
-	if x != nil {
-		return x.LikeCount
-	}
-	return 0
-}
-
-func (x *Post) GetSummary() string {
+func (x *Author) GetBio() string {
 	if x != nil {
-		return x.Summary
+		return x.Bio
 	}
 	return ""
 }
 
-func (x *Post) GetUserId() string {
+func (x *Author) GetPhotoUrl() string {
 	if x != nil {
-		return x.UserId
+		return x.PhotoUrl
 	}
 	return ""
 }
 
-func (x *Post) GetCommentCount() int32 {
+func (x *Author) GetBirthDate() string {
 	if x != nil {
-		return x.CommentCount
+		return x.BirthDate
 	}
-	return 0
+	return ""
 }
 
-func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Author) GetDeathDate() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.DeathDate
 	}
-	return nil
+	return ""
 }
 
-type Comment struct {
+// One work in an author's bibliography
+type AuthorWork struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Content   string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	PostId    string                 `protobuf:"bytes,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId    string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	WorkId           string `protobuf:"bytes,1,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	Title            string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Subtitle         string `protobuf:"bytes,3,opt,name=subtitle,proto3" json:"subtitle,omitempty"`
+	BookImage        string `protobuf:"bytes,4,opt,name=book_image,json=bookImage,proto3" json:"book_image,omitempty"`
+	FirstPublishDate string `protobuf:"bytes,5,opt,name=first_publish_date,json=firstPublishDate,proto3" json:"first_publish_date,omitempty"`
+	InLibrary        bool   `protobuf:"varint,6,opt,name=in_library,json=inLibrary,proto3" json:"in_library,omitempty"`              // Whether the caller has an edition of the work in their library
+	LibraryBookId    string `protobuf:"bytes,7,opt,name=library_book_id,json=libraryBookId,proto3" json:"library_book_id,omitempty"` // The caller's edition, when in_library is set
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
+func (x *AuthorWork) Reset() {
+	*x = AuthorWork{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -635,13 +873,13 @@ func (x *Comment) Reset() {
 	}
 }
 
-func (x *Comment) String() string {
+func (x *AuthorWork) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*AuthorWork) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
+func (x *AuthorWork) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -653,60 +891,75 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthorWork.ProtoReflect.Descriptor instead.
+func (*AuthorWork) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Comment) GetId() string {
+func (x *AuthorWork) GetWorkId() string {
 	if x != nil {
-		return x.Id
+		return x.WorkId
 	}
 	return ""
 }
 
-func (x *Comment) GetContent() string {
+func (x *AuthorWork) GetTitle() string {
 	if x != nil {
-		return x.Content
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Comment) GetPostId() string {
+func (x *AuthorWork) GetSubtitle() string {
 	if x != nil {
-		return x.PostId
+		return x.Subtitle
 	}
 	return ""
 }
 
-func (x *Comment) GetUserId() string {
+func (x *AuthorWork) GetBookImage() string {
 	if x != nil {
-		return x.UserId
+		return x.BookImage
 	}
 	return ""
 }
 
-func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+func (x *AuthorWork) GetFirstPublishDate() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.FirstPublishDate
 	}
-	return nil
+	return ""
 }
 
-type Shelf struct {
+func (x *AuthorWork) GetInLibrary() bool {
+	if x != nil {
+		return x.InLibrary
+	}
+	return false
+}
+
+func (x *AuthorWork) GetLibraryBookId() string {
+	if x != nil {
+		return x.LibraryBookId
+	}
+	return ""
+}
+
+// Unified book details for posts - includes user's rating and review info
+type BookDetails struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	UserId    string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id       string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Author   string     `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	Title    string     `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	ImageUrl string     `protobuf:"bytes,4,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Rating   BookRating `protobuf:"varint,5,opt,name=rating,proto3,enum=betterreads.BookRating" json:"rating,omitempty"`
 }
 
-func (x *Shelf) Reset() {
-	*x = Shelf{}
+func (x *BookDetails) Reset() {
+	*x = BookDetails{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -714,13 +967,13 @@ func (x *Shelf) Reset() {
 	}
 }
 
-func (x *Shelf) String() string {
+func (x *BookDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Shelf) ProtoMessage() {}
+func (*BookDetails) ProtoMessage() {}
 
-func (x *Shelf) ProtoReflect() protoreflect.Message {
+func (x *BookDetails) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -732,65 +985,66 @@ func (x *Shelf) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Shelf.ProtoReflect.Descriptor instead.
-func (*Shelf) Descriptor() ([]byte, []int) {
+// Deprecated: Use BookDetails.ProtoReflect.Descriptor instead.
+func (*BookDetails) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Shelf) GetId() string {
+func (x *BookDetails) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Shelf) GetName() string {
+func (x *BookDetails) GetAuthor() string {
 	if x != nil {
-		return x.Name
+		return x.Author
 	}
 	return ""
 }
 
-func (x *Shelf) GetUserId() string {
+func (x *BookDetails) GetTitle() string {
 	if x != nil {
-		return x.UserId
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Shelf) GetCreatedAt() *timestamppb.Timestamp {
+func (x *BookDetails) GetImageUrl() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ImageUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *Shelf) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *BookDetails) GetRating() BookRating {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Rating
 	}
-	return nil
+	return BookRating_BOOK_RATING_UNSPECIFIED
 }
 
-type LibraryBook struct {
+type Post struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthorName    string                 `protobuf:"bytes,1,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
-	BookId        string                 `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
-	BookImage     string                 `protobuf:"bytes,3,opt,name=book_image,json=bookImage,proto3" json:"book_image,omitempty"`
-	Rating        BookRating             `protobuf:"varint,4,opt,name=rating,proto3,enum=betterreads.BookRating" json:"rating,omitempty"`
-	ShelfIds      []string               `protobuf:"bytes,5,rep,name=shelf_ids,json=shelfIds,proto3" json:"shelf_ids,omitempty"` // Books can be on multiple shelves
-	Source        BookSource             `protobuf:"varint,6,opt,name=source,proto3,enum=betterreads.BookSource" json:"source,omitempty"`
-	Title         string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
-	AddedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	ReadingStatus ReadingStatus          `protobuf:"varint,10,opt,name=reading_status,json=readingStatus,proto3,enum=betterreads.ReadingStatus" json:"reading_status,omitempty"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Description     string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Book            *BookDetails           `protobuf:"bytes,3,opt,name=book,proto3" json:"book,omitempty"`
+	LikeCount       int32                  `protobuf:"varint,4,opt,name=like_count,json=likeCount,proto3" json:"like_count,omitempty"`
+	Summary         string                 `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`
+	UserId          string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CommentCount    int32                  `protobuf:"varint,7,opt,name=comment_count,json=commentCount,proto3" json:"comment_count,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SystemGenerated bool                   `protobuf:"varint,9,opt,name=system_generated,json=systemGenerated,proto3" json:"system_generated,omitempty"` // Set for posts generated from library changes
+	HighlightId     string                 `protobuf:"bytes,10,opt,name=highlight_id,json=highlightId,proto3" json:"highlight_id,omitempty"`             // The shared highlight, if any; cleared if the highlight is deleted
+	Quote           string                 `protobuf:"bytes,11,opt,name=quote,proto3" json:"quote,omitempty"`                                            // The shared highlight's quote as it was when the post was created
 }
 
-func (x *LibraryBook) Reset() {
-	*x = LibraryBook{}
+func (x *Post) Reset() {
+	*x = Post{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -798,13 +1052,13 @@ func (x *LibraryBook) Reset() {
 	}
 }
 
-func (x *LibraryBook) String() string {
+func (x *Post) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LibraryBook) ProtoMessage() {}
+func (*Post) ProtoMessage() {}
 
-func (x *LibraryBook) ProtoReflect() protoreflect.Message {
+func (x *Post) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -816,92 +1070,102 @@ func (x *LibraryBook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LibraryBook.ProtoReflect.Descriptor instead.
-func (*LibraryBook) Descriptor() ([]byte, []int) {
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *LibraryBook) GetAuthorName() string {
+func (x *Post) GetId() string {
 	if x != nil {
-		return x.AuthorName
+		return x.Id
 	}
 	return ""
 }
 
-func (x *LibraryBook) GetBookId() string {
+func (x *Post) GetDescription() string {
 	if x != nil {
-		return x.BookId
+		return x.Description
 	}
 	return ""
 }
 
-func (x *LibraryBook) GetBookImage() string {
+func (x *Post) GetBook() *BookDetails {
 	if x != nil {
-		return x.BookImage
+		return x.Book
 	}
-	return ""
+	return nil
 }
 
-func (x *LibraryBook) GetRating() BookRating {
+func (x *Post) GetLikeCount() int32 {
 	if x != nil {
-		return x.Rating
+		return x.LikeCount
 	}
-	return BookRating_BOOK_RATING_UNSPECIFIED
+	return 0
 }
 
-func (x *LibraryBook) GetShelfIds() []string {
+func (x *Post) GetSummary() string {
 	if x != nil {
-		return x.ShelfIds
+		return x.Summary
 	}
-	return nil
+	return ""
 }
 
-func (x *LibraryBook) GetSource() BookSource {
+func (x *Post) GetUserId() string {
 	if x != nil {
-		return x.Source
+		return x.UserId
 	}
-	return BookSource_BOOK_SOURCE_UNSPECIFIED
+	return ""
 }
 
-func (x *LibraryBook) GetTitle() string {
+func (x *Post) GetCommentCount() int32 {
 	if x != nil {
-		return x.Title
+		return x.CommentCount
 	}
-	return ""
+	return 0
 }
 
-func (x *LibraryBook) GetAddedAt() *timestamppb.Timestamp {
+func (x *Post) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.AddedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *LibraryBook) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Post) GetSystemGenerated() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.SystemGenerated
 	}
-	return nil
+	return false
 }
 
-func (x *LibraryBook) GetReadingStatus() ReadingStatus {
+func (x *Post) GetHighlightId() string {
 	if x != nil {
-		return x.ReadingStatus
+		return x.HighlightId
 	}
-	return ReadingStatus_READING_STATUS_UNSPECIFIED
+	return ""
 }
 
-type ShelfWithBooks struct {
+func (x *Post) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+type Comment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Shelf *Shelf         `protobuf:"bytes,1,opt,name=shelf,proto3" json:"shelf,omitempty"`
-	Books []*LibraryBook `protobuf:"bytes,2,rep,name=books,proto3" json:"books,omitempty"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Content   string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	PostId    string                 `protobuf:"bytes,3,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *ShelfWithBooks) Reset() {
-	*x = ShelfWithBooks{}
+func (x *Comment) Reset() {
+	*x = Comment{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -909,13 +1173,13 @@ func (x *ShelfWithBooks) Reset() {
 	}
 }
 
-func (x *ShelfWithBooks) String() string {
+func (x *Comment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShelfWithBooks) ProtoMessage() {}
+func (*Comment) ProtoMessage() {}
 
-func (x *ShelfWithBooks) ProtoReflect() protoreflect.Message {
+func (x *Comment) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -927,40 +1191,60 @@ func (x *ShelfWithBooks) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShelfWithBooks.ProtoReflect.Descriptor instead.
-func (*ShelfWithBooks) Descriptor() ([]byte, []int) {
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ShelfWithBooks) GetShelf() *Shelf {
+func (x *Comment) GetId() string {
 	if x != nil {
-		return x.Shelf
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *ShelfWithBooks) GetBooks() []*LibraryBook {
+func (x *Comment) GetContent() string {
 	if x != nil {
-		return x.Books
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Comment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type SearchBooksRequest struct {
+type Shelf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query   string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Author  string `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
-	Subject string `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
-	Page    int32  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
-	Limit   int32  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UserId    string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
-func (x *SearchBooksRequest) Reset() {
-	*x = SearchBooksRequest{}
+func (x *Shelf) Reset() {
+	*x = Shelf{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -968,13 +1252,13 @@ func (x *SearchBooksRequest) Reset() {
 	}
 }
 
-func (x *SearchBooksRequest) String() string {
+func (x *Shelf) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchBooksRequest) ProtoMessage() {}
+func (*Shelf) ProtoMessage() {}
 
-func (x *SearchBooksRequest) ProtoReflect() protoreflect.Message {
+func (x *Shelf) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -986,64 +1270,71 @@ func (x *SearchBooksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchBooksRequest.ProtoReflect.Descriptor instead.
-func (*SearchBooksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Shelf.ProtoReflect.Descriptor instead.
+func (*Shelf) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SearchBooksRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
-}
-
-func (x *SearchBooksRequest) GetTitle() string {
+func (x *Shelf) GetId() string {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SearchBooksRequest) GetAuthor() string {
+func (x *Shelf) GetName() string {
 	if x != nil {
-		return x.Author
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SearchBooksRequest) GetSubject() string {
+func (x *Shelf) GetUserId() string {
 	if x != nil {
-		return x.Subject
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *SearchBooksRequest) GetPage() int32 {
+func (x *Shelf) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Page
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *SearchBooksRequest) GetLimit() int32 {
+func (x *Shelf) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Limit
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-type SearchBooksResponse struct {
+type LibraryBook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Books      []*Book             `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
-	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	AuthorName        string                 `protobuf:"bytes,1,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	BookId            string                 `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	BookImage         string                 `protobuf:"bytes,3,opt,name=book_image,json=bookImage,proto3" json:"book_image,omitempty"`
+	Rating            BookRating             `protobuf:"varint,4,opt,name=rating,proto3,enum=betterreads.BookRating" json:"rating,omitempty"`
+	ShelfIds          []string               `protobuf:"bytes,5,rep,name=shelf_ids,json=shelfIds,proto3" json:"shelf_ids,omitempty"` // Books can be on multiple shelves
+	Source            BookSource             `protobuf:"varint,6,opt,name=source,proto3,enum=betterreads.BookSource" json:"source,omitempty"`
+	Title             string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	AddedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ReadingStatus     ReadingStatus          `protobuf:"varint,10,opt,name=reading_status,json=readingStatus,proto3,enum=betterreads.ReadingStatus" json:"reading_status,omitempty"`
+	Isbn              string                 `protobuf:"bytes,11,opt,name=isbn,proto3" json:"isbn,omitempty"`
+	Sessions          []*ReadingSession      `protobuf:"bytes,12,rep,name=sessions,proto3" json:"sessions,omitempty"` // Reads of the book, oldest first
+	Review            string                 `protobuf:"bytes,13,opt,name=review,proto3" json:"review,omitempty"`
+	ReviewHasSpoilers bool                   `protobuf:"varint,14,opt,name=review_has_spoilers,json=reviewHasSpoilers,proto3" json:"review_has_spoilers,omitempty"`
+	Notes             string                 `protobuf:"bytes,15,opt,name=notes,proto3" json:"notes,omitempty"` // Private notes, only ever shown to the owner
+	Tags              []string               `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`   // Free-form labels, separate from shelves
 }
 
-func (x *SearchBooksResponse) Reset() {
-	*x = SearchBooksResponse{}
+func (x *LibraryBook) Reset() {
+	*x = LibraryBook{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1051,13 +1342,13 @@ func (x *SearchBooksResponse) Reset() {
 	}
 }
 
-func (x *SearchBooksResponse) String() string {
+func (x *LibraryBook) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchBooksResponse) ProtoMessage() {}
+func (*LibraryBook) ProtoMessage() {}
 
-func (x *SearchBooksResponse) ProtoReflect() protoreflect.Message {
+func (x *LibraryBook) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1069,51 +1360,155 @@ func (x *SearchBooksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchBooksResponse.ProtoReflect.Descriptor instead.
-func (*SearchBooksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LibraryBook.ProtoReflect.Descriptor instead.
+func (*LibraryBook) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *SearchBooksResponse) GetBooks() []*Book {
+func (x *LibraryBook) GetAuthorName() string {
 	if x != nil {
-		return x.Books
+		return x.AuthorName
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchBooksResponse) GetPagination() *PaginationMetadata {
+func (x *LibraryBook) GetBookId() string {
 	if x != nil {
-		return x.Pagination
+		return x.BookId
 	}
-	return nil
+	return ""
 }
 
-type GetPersonalizedFeedRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Page  int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Since *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+func (x *LibraryBook) GetBookImage() string {
+	if x != nil {
+		return x.BookImage
+	}
+	return ""
 }
 
-func (x *GetPersonalizedFeedRequest) Reset() {
-	*x = GetPersonalizedFeedRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_betterreads_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *LibraryBook) GetRating() BookRating {
+	if x != nil {
+		return x.Rating
 	}
+	return BookRating_BOOK_RATING_UNSPECIFIED
 }
 
-func (x *GetPersonalizedFeedRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+func (x *LibraryBook) GetShelfIds() []string {
+	if x != nil {
+		return x.ShelfIds
+	}
+	return nil
+}
 
-func (*GetPersonalizedFeedRequest) ProtoMessage() {}
+func (x *LibraryBook) GetSource() BookSource {
+	if x != nil {
+		return x.Source
+	}
+	return BookSource_BOOK_SOURCE_UNSPECIFIED
+}
 
-func (x *GetPersonalizedFeedRequest) ProtoReflect() protoreflect.Message {
+func (x *LibraryBook) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LibraryBook) GetAddedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddedAt
+	}
+	return nil
+}
+
+func (x *LibraryBook) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *LibraryBook) GetReadingStatus() ReadingStatus {
+	if x != nil {
+		return x.ReadingStatus
+	}
+	return ReadingStatus_READING_STATUS_UNSPECIFIED
+}
+
+func (x *LibraryBook) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *LibraryBook) GetSessions() []*ReadingSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *LibraryBook) GetReview() string {
+	if x != nil {
+		return x.Review
+	}
+	return ""
+}
+
+func (x *LibraryBook) GetReviewHasSpoilers() bool {
+	if x != nil {
+		return x.ReviewHasSpoilers
+	}
+	return false
+}
+
+func (x *LibraryBook) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *LibraryBook) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// One read of a book; moving a book to READING opens a session and to READ or DID_NOT_FINISH closes it
+type ReadingSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookId       string                 `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	StartedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`    // Unset when unknown
+	FinishedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"` // Unset while the read is in progress
+	DidNotFinish bool                   `protobuf:"varint,5,opt,name=did_not_finish,json=didNotFinish,proto3" json:"did_not_finish,omitempty"`
+	Rating       BookRating             `protobuf:"varint,6,opt,name=rating,proto3,enum=betterreads.BookRating" json:"rating,omitempty"` // Rating given on this read
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ReadingSession) Reset() {
+	*x = ReadingSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadingSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingSession) ProtoMessage() {}
+
+func (x *ReadingSession) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1125,43 +1520,78 @@ func (x *GetPersonalizedFeedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPersonalizedFeedRequest.ProtoReflect.Descriptor instead.
-func (*GetPersonalizedFeedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadingSession.ProtoReflect.Descriptor instead.
+func (*ReadingSession) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetPersonalizedFeedRequest) GetPage() int32 {
+func (x *ReadingSession) GetId() string {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPersonalizedFeedRequest) GetLimit() int32 {
+func (x *ReadingSession) GetBookId() string {
 	if x != nil {
-		return x.Limit
+		return x.BookId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPersonalizedFeedRequest) GetSince() *timestamppb.Timestamp {
+func (x *ReadingSession) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Since
+		return x.StartedAt
 	}
 	return nil
 }
 
-type GetPersonalizedFeedResponse struct {
+func (x *ReadingSession) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ReadingSession) GetDidNotFinish() bool {
+	if x != nil {
+		return x.DidNotFinish
+	}
+	return false
+}
+
+func (x *ReadingSession) GetRating() BookRating {
+	if x != nil {
+		return x.Rating
+	}
+	return BookRating_BOOK_RATING_UNSPECIFIED
+}
+
+func (x *ReadingSession) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReadingSession) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ShelfWithBooks struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Posts      []*Post             `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
-	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Shelf *Shelf         `protobuf:"bytes,1,opt,name=shelf,proto3" json:"shelf,omitempty"`
+	Books []*LibraryBook `protobuf:"bytes,2,rep,name=books,proto3" json:"books,omitempty"`
 }
 
-func (x *GetPersonalizedFeedResponse) Reset() {
-	*x = GetPersonalizedFeedResponse{}
+func (x *ShelfWithBooks) Reset() {
+	*x = ShelfWithBooks{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1169,13 +1599,13 @@ func (x *GetPersonalizedFeedResponse) Reset() {
 	}
 }
 
-func (x *GetPersonalizedFeedResponse) String() string {
+func (x *ShelfWithBooks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPersonalizedFeedResponse) ProtoMessage() {}
+func (*ShelfWithBooks) ProtoMessage() {}
 
-func (x *GetPersonalizedFeedResponse) ProtoReflect() protoreflect.Message {
+func (x *ShelfWithBooks) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1187,37 +1617,41 @@ func (x *GetPersonalizedFeedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPersonalizedFeedResponse.ProtoReflect.Descriptor instead.
-func (*GetPersonalizedFeedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShelfWithBooks.ProtoReflect.Descriptor instead.
+func (*ShelfWithBooks) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetPersonalizedFeedResponse) GetPosts() []*Post {
+func (x *ShelfWithBooks) GetShelf() *Shelf {
 	if x != nil {
-		return x.Posts
+		return x.Shelf
 	}
 	return nil
 }
 
-func (x *GetPersonalizedFeedResponse) GetPagination() *PaginationMetadata {
+func (x *ShelfWithBooks) GetBooks() []*LibraryBook {
 	if x != nil {
-		return x.Pagination
+		return x.Books
 	}
 	return nil
 }
 
-type GetUserFeedRequest struct {
+type SearchBooksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page   int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit  int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Query   string       `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Title   string       `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Author  string       `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Subject string       `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Page    int32        `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Limit   int32        `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sources []BookSource `protobuf:"varint,7,rep,packed,name=sources,proto3,enum=betterreads.BookSource" json:"sources,omitempty"` // Where to search; every available source when empty
 }
 
-func (x *GetUserFeedRequest) Reset() {
-	*x = GetUserFeedRequest{}
+func (x *SearchBooksRequest) Reset() {
+	*x = SearchBooksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1225,13 +1659,13 @@ func (x *GetUserFeedRequest) Reset() {
 	}
 }
 
-func (x *GetUserFeedRequest) String() string {
+func (x *SearchBooksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserFeedRequest) ProtoMessage() {}
+func (*SearchBooksRequest) ProtoMessage() {}
 
-func (x *GetUserFeedRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchBooksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1243,43 +1677,71 @@ func (x *GetUserFeedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserFeedRequest.ProtoReflect.Descriptor instead.
-func (*GetUserFeedRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchBooksRequest.ProtoReflect.Descriptor instead.
+func (*SearchBooksRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetUserFeedRequest) GetUserId() string {
+func (x *SearchBooksRequest) GetQuery() string {
 	if x != nil {
-		return x.UserId
+		return x.Query
 	}
 	return ""
 }
 
-func (x *GetUserFeedRequest) GetPage() int32 {
+func (x *SearchBooksRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchBooksRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *SearchBooksRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *SearchBooksRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetUserFeedRequest) GetLimit() int32 {
+func (x *SearchBooksRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-type GetUserFeedResponse struct {
+func (x *SearchBooksRequest) GetSources() []BookSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type SearchBooksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Posts      []*Post             `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Books      []*Book             `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"` // Merged across sources; a book found in several by ISBN is listed once
 	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *GetUserFeedResponse) Reset() {
-	*x = GetUserFeedResponse{}
+func (x *SearchBooksResponse) Reset() {
+	*x = SearchBooksResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1287,13 +1749,13 @@ func (x *GetUserFeedResponse) Reset() {
 	}
 }
 
-func (x *GetUserFeedResponse) String() string {
+func (x *SearchBooksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserFeedResponse) ProtoMessage() {}
+func (*SearchBooksResponse) ProtoMessage() {}
 
-func (x *GetUserFeedResponse) ProtoReflect() protoreflect.Message {
+func (x *SearchBooksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1305,35 +1767,35 @@ func (x *GetUserFeedResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserFeedResponse.ProtoReflect.Descriptor instead.
-func (*GetUserFeedResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchBooksResponse.ProtoReflect.Descriptor instead.
+func (*SearchBooksResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetUserFeedResponse) GetPosts() []*Post {
+func (x *SearchBooksResponse) GetBooks() []*Book {
 	if x != nil {
-		return x.Posts
+		return x.Books
 	}
 	return nil
 }
 
-func (x *GetUserFeedResponse) GetPagination() *PaginationMetadata {
+func (x *SearchBooksResponse) GetPagination() *PaginationMetadata {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type RemoveLibraryBookRequest struct {
+type GetBookRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BookId string `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	BookId string `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"` // Open Library edition or work id, an ISBN, or the id of a book entered by hand
 }
 
-func (x *RemoveLibraryBookRequest) Reset() {
-	*x = RemoveLibraryBookRequest{}
+func (x *GetBookRequest) Reset() {
+	*x = GetBookRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1341,13 +1803,13 @@ func (x *RemoveLibraryBookRequest) Reset() {
 	}
 }
 
-func (x *RemoveLibraryBookRequest) String() string {
+func (x *GetBookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveLibraryBookRequest) ProtoMessage() {}
+func (*GetBookRequest) ProtoMessage() {}
 
-func (x *RemoveLibraryBookRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBookRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1359,26 +1821,38 @@ func (x *RemoveLibraryBookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveLibraryBookRequest.ProtoReflect.Descriptor instead.
-func (*RemoveLibraryBookRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBookRequest.ProtoReflect.Descriptor instead.
+func (*GetBookRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RemoveLibraryBookRequest) GetBookId() string {
+func (x *GetBookRequest) GetBookId() string {
 	if x != nil {
 		return x.BookId
 	}
 	return ""
 }
 
-type RemoveLibraryBookResponse struct {
+type GetBookResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *RemoveLibraryBookResponse) Reset() {
-	*x = RemoveLibraryBookResponse{}
+	Book         *Book      `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"` // Author name and ratings are set once the book is in the catalog
+	WorkId       string     `protobuf:"bytes,2,opt,name=work_id,json=workId,proto3" json:"work_id,omitempty"`
+	AuthorIds    []string   `protobuf:"bytes,3,rep,name=author_ids,json=authorIds,proto3" json:"author_ids,omitempty"`
+	Subtitle     string     `protobuf:"bytes,4,opt,name=subtitle,proto3" json:"subtitle,omitempty"`
+	Description  string     `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Subjects     []string   `protobuf:"bytes,6,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	PageCount    int32      `protobuf:"varint,7,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
+	Publishers   []string   `protobuf:"bytes,8,rep,name=publishers,proto3" json:"publishers,omitempty"`
+	PublishDate  string     `protobuf:"bytes,9,opt,name=publish_date,json=publishDate,proto3" json:"publish_date,omitempty"`
+	Editions     []*Edition `protobuf:"bytes,10,rep,name=editions,proto3" json:"editions,omitempty"`                              // The work's editions, up to 20
+	EditionCount int32      `protobuf:"varint,11,opt,name=edition_count,json=editionCount,proto3" json:"edition_count,omitempty"` // All of the work's editions
+}
+
+func (x *GetBookResponse) Reset() {
+	*x = GetBookResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1386,13 +1860,13 @@ func (x *RemoveLibraryBookResponse) Reset() {
 	}
 }
 
-func (x *RemoveLibraryBookResponse) String() string {
+func (x *GetBookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveLibraryBookResponse) ProtoMessage() {}
+func (*GetBookResponse) ProtoMessage() {}
 
-func (x *RemoveLibraryBookResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBookResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1404,28 +1878,103 @@ func (x *RemoveLibraryBookResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveLibraryBookResponse.ProtoReflect.Descriptor instead.
-func (*RemoveLibraryBookResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBookResponse.ProtoReflect.Descriptor instead.
+func (*GetBookResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{15}
 }
 
-type UpdateLibraryBookRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetBookResponse) GetBook() *Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+func (x *GetBookResponse) GetWorkId() string {
+	if x != nil {
+		return x.WorkId
+	}
+	return ""
+}
 
-	AuthorName    string        `protobuf:"bytes,1,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
-	BookId        string        `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
-	BookImage     string        `protobuf:"bytes,3,opt,name=book_image,json=bookImage,proto3" json:"book_image,omitempty"`
-	ShelfIds      []string      `protobuf:"bytes,4,rep,name=shelf_ids,json=shelfIds,proto3" json:"shelf_ids,omitempty"` // Optional: Books can exist without shelves
-	Source        BookSource    `protobuf:"varint,5,opt,name=source,proto3,enum=betterreads.BookSource" json:"source,omitempty"`
-	Title         string        `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
-	Rating        BookRating    `protobuf:"varint,7,opt,name=rating,proto3,enum=betterreads.BookRating" json:"rating,omitempty"`
-	ReadingStatus ReadingStatus `protobuf:"varint,8,opt,name=reading_status,json=readingStatus,proto3,enum=betterreads.ReadingStatus" json:"reading_status,omitempty"`
+func (x *GetBookResponse) GetAuthorIds() []string {
+	if x != nil {
+		return x.AuthorIds
+	}
+	return nil
 }
 
-func (x *UpdateLibraryBookRequest) Reset() {
-	*x = UpdateLibraryBookRequest{}
+func (x *GetBookResponse) GetSubtitle() string {
+	if x != nil {
+		return x.Subtitle
+	}
+	return ""
+}
+
+func (x *GetBookResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetBookResponse) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *GetBookResponse) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+func (x *GetBookResponse) GetPublishers() []string {
+	if x != nil {
+		return x.Publishers
+	}
+	return nil
+}
+
+func (x *GetBookResponse) GetPublishDate() string {
+	if x != nil {
+		return x.PublishDate
+	}
+	return ""
+}
+
+func (x *GetBookResponse) GetEditions() []*Edition {
+	if x != nil {
+		return x.Editions
+	}
+	return nil
+}
+
+func (x *GetBookResponse) GetEditionCount() int32 {
+	if x != nil {
+		return x.EditionCount
+	}
+	return 0
+}
+
+type CreateManualBookRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title         string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Authors       []string `protobuf:"bytes,2,rep,name=authors,proto3" json:"authors,omitempty"`                                   // At least one
+	Isbn          string   `protobuf:"bytes,3,opt,name=isbn,proto3" json:"isbn,omitempty"`                                         // Optional: ISBN-10 or ISBN-13, stored as ISBN-13
+	PageCount     int32    `protobuf:"varint,4,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`             // Optional
+	CoverUrl      string   `protobuf:"bytes,5,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`                 // Optional: http or https
+	PublishedYear int32    `protobuf:"varint,6,opt,name=published_year,json=publishedYear,proto3" json:"published_year,omitempty"` // Optional
+}
+
+func (x *CreateManualBookRequest) Reset() {
+	*x = CreateManualBookRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1433,13 +1982,13 @@ func (x *UpdateLibraryBookRequest) Reset() {
 	}
 }
 
-func (x *UpdateLibraryBookRequest) String() string {
+func (x *CreateManualBookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLibraryBookRequest) ProtoMessage() {}
+func (*CreateManualBookRequest) ProtoMessage() {}
 
-func (x *UpdateLibraryBookRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateManualBookRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1451,75 +2000,65 @@ func (x *UpdateLibraryBookRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLibraryBookRequest.ProtoReflect.Descriptor instead.
-func (*UpdateLibraryBookRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateManualBookRequest.ProtoReflect.Descriptor instead.
+func (*CreateManualBookRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *UpdateLibraryBookRequest) GetAuthorName() string {
+func (x *CreateManualBookRequest) GetTitle() string {
 	if x != nil {
-		return x.AuthorName
+		return x.Title
 	}
 	return ""
 }
 
-func (x *UpdateLibraryBookRequest) GetBookId() string {
+func (x *CreateManualBookRequest) GetAuthors() []string {
 	if x != nil {
-		return x.BookId
+		return x.Authors
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateLibraryBookRequest) GetBookImage() string {
+func (x *CreateManualBookRequest) GetIsbn() string {
 	if x != nil {
-		return x.BookImage
+		return x.Isbn
 	}
 	return ""
 }
 
-func (x *UpdateLibraryBookRequest) GetShelfIds() []string {
-	if x != nil {
-		return x.ShelfIds
-	}
-	return nil
-}
-
-func (x *UpdateLibraryBookRequest) GetSource() BookSource {
+func (x *CreateManualBookRequest) GetPageCount() int32 {
 	if x != nil {
-		return x.Source
+		return x.PageCount
 	}
-	return BookSource_BOOK_SOURCE_UNSPECIFIED
+	return 0
 }
 
-func (x *UpdateLibraryBookRequest) GetTitle() string {
+func (x *CreateManualBookRequest) GetCoverUrl() string {
 	if x != nil {
-		return x.Title
+		return x.CoverUrl
 	}
 	return ""
 }
 
-func (x *UpdateLibraryBookRequest) GetRating() BookRating {
-	if x != nil {
-		return x.Rating
-	}
-	return BookRating_BOOK_RATING_UNSPECIFIED
-}
-
-func (x *UpdateLibraryBookRequest) GetReadingStatus() ReadingStatus {
+func (x *CreateManualBookRequest) GetPublishedYear() int32 {
 	if x != nil {
-		return x.ReadingStatus
+		return x.PublishedYear
 	}
-	return ReadingStatus_READING_STATUS_UNSPECIFIED
+	return 0
 }
 
-type UpdateLibraryBookResponse struct {
+type CreateManualBookResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Book      *Book    `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"` // Add it to the library with UpdateLibraryBook, using its id and source
+	Authors   []string `protobuf:"bytes,2,rep,name=authors,proto3" json:"authors,omitempty"`
+	PageCount int32    `protobuf:"varint,3,opt,name=page_count,json=pageCount,proto3" json:"page_count,omitempty"`
 }
 
-func (x *UpdateLibraryBookResponse) Reset() {
-	*x = UpdateLibraryBookResponse{}
+func (x *CreateManualBookResponse) Reset() {
+	*x = CreateManualBookResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1527,13 +2066,13 @@ func (x *UpdateLibraryBookResponse) Reset() {
 	}
 }
 
-func (x *UpdateLibraryBookResponse) String() string {
+func (x *CreateManualBookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateLibraryBookResponse) ProtoMessage() {}
+func (*CreateManualBookResponse) ProtoMessage() {}
 
-func (x *UpdateLibraryBookResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateManualBookResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1545,23 +2084,44 @@ func (x *UpdateLibraryBookResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateLibraryBookResponse.ProtoReflect.Descriptor instead.
-func (*UpdateLibraryBookResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateManualBookResponse.ProtoReflect.Descriptor instead.
+func (*CreateManualBookResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{17}
 }
 
-type GetUserLibraryRequest struct {
+func (x *CreateManualBookResponse) GetBook() *Book {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+func (x *CreateManualBookResponse) GetAuthors() []string {
+	if x != nil {
+		return x.Authors
+	}
+	return nil
+}
+
+func (x *CreateManualBookResponse) GetPageCount() int32 {
+	if x != nil {
+		return x.PageCount
+	}
+	return 0
+}
+
+type GetAuthorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page   int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit  int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	AuthorId string `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // Open Library author id, as in Book.author_id
+	Page     int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit    int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *GetUserLibraryRequest) Reset() {
-	*x = GetUserLibraryRequest{}
+func (x *GetAuthorRequest) Reset() {
+	*x = GetAuthorRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1569,13 +2129,13 @@ func (x *GetUserLibraryRequest) Reset() {
 	}
 }
 
-func (x *GetUserLibraryRequest) String() string {
+func (x *GetAuthorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserLibraryRequest) ProtoMessage() {}
+func (*GetAuthorRequest) ProtoMessage() {}
 
-func (x *GetUserLibraryRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAuthorRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1587,44 +2147,44 @@ func (x *GetUserLibraryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserLibraryRequest.ProtoReflect.Descriptor instead.
-func (*GetUserLibraryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAuthorRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthorRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetUserLibraryRequest) GetUserId() string {
+func (x *GetAuthorRequest) GetAuthorId() string {
 	if x != nil {
-		return x.UserId
+		return x.AuthorId
 	}
 	return ""
 }
 
-func (x *GetUserLibraryRequest) GetPage() int32 {
+func (x *GetAuthorRequest) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetUserLibraryRequest) GetLimit() int32 {
+func (x *GetAuthorRequest) GetLimit() int32 {
 	if x != nil {
 		return x.Limit
 	}
 	return 0
 }
 
-type GetUserLibraryResponse struct {
+type GetAuthorResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Shelves        []*ShelfWithBooks   `protobuf:"bytes,1,rep,name=shelves,proto3" json:"shelves,omitempty"`
-	UnshelvedBooks []*LibraryBook      `protobuf:"bytes,2,rep,name=unshelved_books,json=unshelvedBooks,proto3" json:"unshelved_books,omitempty"` // Books not on any shelf
-	Pagination     *PaginationMetadata `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Author     *Author             `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Works      []*AuthorWork       `protobuf:"bytes,2,rep,name=works,proto3" json:"works,omitempty"` // In the order the source lists them
+	Pagination *PaginationMetadata `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *GetUserLibraryResponse) Reset() {
-	*x = GetUserLibraryResponse{}
+func (x *GetAuthorResponse) Reset() {
+	*x = GetAuthorResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1632,13 +2192,13 @@ func (x *GetUserLibraryResponse) Reset() {
 	}
 }
 
-func (x *GetUserLibraryResponse) String() string {
+func (x *GetAuthorResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserLibraryResponse) ProtoMessage() {}
+func (*GetAuthorResponse) ProtoMessage() {}
 
-func (x *GetUserLibraryResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAuthorResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1650,42 +2210,45 @@ func (x *GetUserLibraryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserLibraryResponse.ProtoReflect.Descriptor instead.
-func (*GetUserLibraryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAuthorResponse.ProtoReflect.Descriptor instead.
+func (*GetAuthorResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetUserLibraryResponse) GetShelves() []*ShelfWithBooks {
+func (x *GetAuthorResponse) GetAuthor() *Author {
 	if x != nil {
-		return x.Shelves
+		return x.Author
 	}
 	return nil
 }
 
-func (x *GetUserLibraryResponse) GetUnshelvedBooks() []*LibraryBook {
+func (x *GetAuthorResponse) GetWorks() []*AuthorWork {
 	if x != nil {
-		return x.UnshelvedBooks
+		return x.Works
 	}
 	return nil
 }
 
-func (x *GetUserLibraryResponse) GetPagination() *PaginationMetadata {
+func (x *GetAuthorResponse) GetPagination() *PaginationMetadata {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type CreateShelfRequest struct {
+type GetPersonalizedFeedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Page      int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"` // Must start within the first 1000 posts; continue with page_token beyond
+	Limit     int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Since     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	PageToken string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from a previous response; takes precedence over page
 }
 
-func (x *CreateShelfRequest) Reset() {
-	*x = CreateShelfRequest{}
+func (x *GetPersonalizedFeedRequest) Reset() {
+	*x = GetPersonalizedFeedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1693,13 +2256,13 @@ func (x *CreateShelfRequest) Reset() {
 	}
 }
 
-func (x *CreateShelfRequest) String() string {
+func (x *GetPersonalizedFeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShelfRequest) ProtoMessage() {}
+func (*GetPersonalizedFeedRequest) ProtoMessage() {}
 
-func (x *CreateShelfRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPersonalizedFeedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1711,28 +2274,50 @@ func (x *CreateShelfRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShelfRequest.ProtoReflect.Descriptor instead.
-func (*CreateShelfRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPersonalizedFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonalizedFeedRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CreateShelfRequest) GetName() string {
+func (x *GetPersonalizedFeedRequest) GetPage() int32 {
 	if x != nil {
-		return x.Name
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPersonalizedFeedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPersonalizedFeedRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetPersonalizedFeedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
-type CreateShelfResponse struct {
+type GetPersonalizedFeedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Shelf *Shelf `protobuf:"bytes,1,opt,name=shelf,proto3" json:"shelf,omitempty"`
+	Posts      []*Post             `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"` // total stops counting at 1000 posts
 }
 
-func (x *CreateShelfResponse) Reset() {
-	*x = CreateShelfResponse{}
+func (x *GetPersonalizedFeedResponse) Reset() {
+	*x = GetPersonalizedFeedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1740,13 +2325,13 @@ func (x *CreateShelfResponse) Reset() {
 	}
 }
 
-func (x *CreateShelfResponse) String() string {
+func (x *GetPersonalizedFeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShelfResponse) ProtoMessage() {}
+func (*GetPersonalizedFeedResponse) ProtoMessage() {}
 
-func (x *CreateShelfResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPersonalizedFeedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1758,29 +2343,38 @@ func (x *CreateShelfResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShelfResponse.ProtoReflect.Descriptor instead.
-func (*CreateShelfResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPersonalizedFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetPersonalizedFeedResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateShelfResponse) GetShelf() *Shelf {
+func (x *GetPersonalizedFeedResponse) GetPosts() []*Post {
 	if x != nil {
-		return x.Shelf
+		return x.Posts
 	}
 	return nil
 }
 
-type UpdateShelfRequest struct {
+func (x *GetPersonalizedFeedResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetUserFeedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ShelfId string `protobuf:"bytes,1,opt,name=shelf_id,json=shelfId,proto3" json:"shelf_id,omitempty"`
-	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Optional: if provided, renames the shelf
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page      int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from a previous response; takes precedence over page
 }
 
-func (x *UpdateShelfRequest) Reset() {
-	*x = UpdateShelfRequest{}
+func (x *GetUserFeedRequest) Reset() {
+	*x = GetUserFeedRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1788,13 +2382,13 @@ func (x *UpdateShelfRequest) Reset() {
 	}
 }
 
-func (x *UpdateShelfRequest) String() string {
+func (x *GetUserFeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateShelfRequest) ProtoMessage() {}
+func (*GetUserFeedRequest) ProtoMessage() {}
 
-func (x *UpdateShelfRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserFeedRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1806,35 +2400,50 @@ func (x *UpdateShelfRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateShelfRequest.ProtoReflect.Descriptor instead.
-func (*UpdateShelfRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetUserFeedRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateShelfRequest) GetShelfId() string {
+func (x *GetUserFeedRequest) GetUserId() string {
 	if x != nil {
-		return x.ShelfId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateShelfRequest) GetName() string {
+func (x *GetUserFeedRequest) GetPage() int32 {
 	if x != nil {
-		return x.Name
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-type UpdateShelfResponse struct {
+func (x *GetUserFeedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserFeedRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetUserFeedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Shelf *Shelf `protobuf:"bytes,1,opt,name=shelf,proto3" json:"shelf,omitempty"`
+	Posts      []*Post             `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *UpdateShelfResponse) Reset() {
-	*x = UpdateShelfResponse{}
+func (x *GetUserFeedResponse) Reset() {
+	*x = GetUserFeedResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1842,13 +2451,13 @@ func (x *UpdateShelfResponse) Reset() {
 	}
 }
 
-func (x *UpdateShelfResponse) String() string {
+func (x *GetUserFeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateShelfResponse) ProtoMessage() {}
+func (*GetUserFeedResponse) ProtoMessage() {}
 
-func (x *UpdateShelfResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUserFeedResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1860,28 +2469,35 @@ func (x *UpdateShelfResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateShelfResponse.ProtoReflect.Descriptor instead.
-func (*UpdateShelfResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetUserFeedResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UpdateShelfResponse) GetShelf() *Shelf {
+func (x *GetUserFeedResponse) GetPosts() []*Post {
 	if x != nil {
-		return x.Shelf
+		return x.Posts
 	}
 	return nil
 }
 
-type DeleteShelfRequest struct {
+func (x *GetUserFeedResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type RemoveLibraryBookRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ShelfId string `protobuf:"bytes,1,opt,name=shelf_id,json=shelfId,proto3" json:"shelf_id,omitempty"`
+	BookId string `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
 }
 
-func (x *DeleteShelfRequest) Reset() {
-	*x = DeleteShelfRequest{}
+func (x *RemoveLibraryBookRequest) Reset() {
+	*x = RemoveLibraryBookRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1889,13 +2505,13 @@ func (x *DeleteShelfRequest) Reset() {
 	}
 }
 
-func (x *DeleteShelfRequest) String() string {
+func (x *RemoveLibraryBookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteShelfRequest) ProtoMessage() {}
+func (*RemoveLibraryBookRequest) ProtoMessage() {}
 
-func (x *DeleteShelfRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveLibraryBookRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1907,26 +2523,26 @@ func (x *DeleteShelfRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteShelfRequest.ProtoReflect.Descriptor instead.
-func (*DeleteShelfRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveLibraryBookRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLibraryBookRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *DeleteShelfRequest) GetShelfId() string {
+func (x *RemoveLibraryBookRequest) GetBookId() string {
 	if x != nil {
-		return x.ShelfId
+		return x.BookId
 	}
 	return ""
 }
 
-type DeleteShelfResponse struct {
+type RemoveLibraryBookResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteShelfResponse) Reset() {
-	*x = DeleteShelfResponse{}
+func (x *RemoveLibraryBookResponse) Reset() {
+	*x = RemoveLibraryBookResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1934,13 +2550,13 @@ func (x *DeleteShelfResponse) Reset() {
 	}
 }
 
-func (x *DeleteShelfResponse) String() string {
+func (x *RemoveLibraryBookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteShelfResponse) ProtoMessage() {}
+func (*RemoveLibraryBookResponse) ProtoMessage() {}
 
-func (x *DeleteShelfResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveLibraryBookResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1952,21 +2568,36 @@ func (x *DeleteShelfResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteShelfResponse.ProtoReflect.Descriptor instead.
-func (*DeleteShelfResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveLibraryBookResponse.ProtoReflect.Descriptor instead.
+func (*RemoveLibraryBookResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{25}
 }
 
-type GetUserShelvesRequest struct {
+type UpdateLibraryBookRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// author_name, book_image, title and isbn describe an Open Library book the catalog does not have yet;
+	// books from other sources must already be in the catalog, and a book in it keeps its catalog entry
+	AuthorName        string        `protobuf:"bytes,1,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	BookId            string        `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	BookImage         string        `protobuf:"bytes,3,opt,name=book_image,json=bookImage,proto3" json:"book_image,omitempty"`
+	ShelfIds          []string      `protobuf:"bytes,4,rep,name=shelf_ids,json=shelfIds,proto3" json:"shelf_ids,omitempty"` // Optional: Books can exist without shelves
+	Source            BookSource    `protobuf:"varint,5,opt,name=source,proto3,enum=betterreads.BookSource" json:"source,omitempty"`
+	Title             string        `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Rating            BookRating    `protobuf:"varint,7,opt,name=rating,proto3,enum=betterreads.BookRating" json:"rating,omitempty"`
+	ReadingStatus     ReadingStatus `protobuf:"varint,8,opt,name=reading_status,json=readingStatus,proto3,enum=betterreads.ReadingStatus" json:"reading_status,omitempty"`
+	Isbn              string        `protobuf:"bytes,9,opt,name=isbn,proto3" json:"isbn,omitempty"`                                                              // Optional
+	Review            *string       `protobuf:"bytes,10,opt,name=review,proto3,oneof" json:"review,omitempty"`                                                   // Replaces the stored review when set; at most 20000 characters
+	ReviewHasSpoilers *bool         `protobuf:"varint,11,opt,name=review_has_spoilers,json=reviewHasSpoilers,proto3,oneof" json:"review_has_spoilers,omitempty"` // Replaces the stored flag when set
+	Notes             *string       `protobuf:"bytes,12,opt,name=notes,proto3,oneof" json:"notes,omitempty"`                                                     // Replaces the stored notes when set; at most 10000 characters
+	Tags              []string      `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`                                                             // Replaces the stored tags when not empty; at most 50, each at most 50 characters, stored lower-cased
+	ClearTags         bool          `protobuf:"varint,14,opt,name=clear_tags,json=clearTags,proto3" json:"clear_tags,omitempty"`                                 // Removes the stored tags; tags must be empty
 }
 
-func (x *GetUserShelvesRequest) Reset() {
-	*x = GetUserShelvesRequest{}
+func (x *UpdateLibraryBookRequest) Reset() {
+	*x = UpdateLibraryBookRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1974,13 +2605,13 @@ func (x *GetUserShelvesRequest) Reset() {
 	}
 }
 
-func (x *GetUserShelvesRequest) String() string {
+func (x *UpdateLibraryBookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserShelvesRequest) ProtoMessage() {}
+func (*UpdateLibraryBookRequest) ProtoMessage() {}
 
-func (x *GetUserShelvesRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateLibraryBookRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1992,28 +2623,117 @@ func (x *GetUserShelvesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserShelvesRequest.ProtoReflect.Descriptor instead.
-func (*GetUserShelvesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateLibraryBookRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLibraryBookRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetUserShelvesRequest) GetUserId() string {
+func (x *UpdateLibraryBookRequest) GetAuthorName() string {
 	if x != nil {
-		return x.UserId
+		return x.AuthorName
 	}
 	return ""
 }
 
-type GetUserShelvesResponse struct {
+func (x *UpdateLibraryBookRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *UpdateLibraryBookRequest) GetBookImage() string {
+	if x != nil {
+		return x.BookImage
+	}
+	return ""
+}
+
+func (x *UpdateLibraryBookRequest) GetShelfIds() []string {
+	if x != nil {
+		return x.ShelfIds
+	}
+	return nil
+}
+
+func (x *UpdateLibraryBookRequest) GetSource() BookSource {
+	if x != nil {
+		return x.Source
+	}
+	return BookSource_BOOK_SOURCE_UNSPECIFIED
+}
+
+func (x *UpdateLibraryBookRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateLibraryBookRequest) GetRating() BookRating {
+	if x != nil {
+		return x.Rating
+	}
+	return BookRating_BOOK_RATING_UNSPECIFIED
+}
+
+func (x *UpdateLibraryBookRequest) GetReadingStatus() ReadingStatus {
+	if x != nil {
+		return x.ReadingStatus
+	}
+	return ReadingStatus_READING_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateLibraryBookRequest) GetIsbn() string {
+	if x != nil {
+		return x.Isbn
+	}
+	return ""
+}
+
+func (x *UpdateLibraryBookRequest) GetReview() string {
+	if x != nil && x.Review != nil {
+		return *x.Review
+	}
+	return ""
+}
+
+func (x *UpdateLibraryBookRequest) GetReviewHasSpoilers() bool {
+	if x != nil && x.ReviewHasSpoilers != nil {
+		return *x.ReviewHasSpoilers
+	}
+	return false
+}
+
+func (x *UpdateLibraryBookRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateLibraryBookRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateLibraryBookRequest) GetClearTags() bool {
+	if x != nil {
+		return x.ClearTags
+	}
+	return false
+}
+
+type UpdateLibraryBookResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Shelves []*Shelf `protobuf:"bytes,1,rep,name=shelves,proto3" json:"shelves,omitempty"`
 }
 
-func (x *GetUserShelvesResponse) Reset() {
-	*x = GetUserShelvesResponse{}
+func (x *UpdateLibraryBookResponse) Reset() {
+	*x = UpdateLibraryBookResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2021,13 +2741,13 @@ func (x *GetUserShelvesResponse) Reset() {
 	}
 }
 
-func (x *GetUserShelvesResponse) String() string {
+func (x *UpdateLibraryBookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserShelvesResponse) ProtoMessage() {}
+func (*UpdateLibraryBookResponse) ProtoMessage() {}
 
-func (x *GetUserShelvesResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateLibraryBookResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2039,31 +2759,21 @@ func (x *GetUserShelvesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserShelvesResponse.ProtoReflect.Descriptor instead.
-func (*GetUserShelvesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateLibraryBookResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLibraryBookResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetUserShelvesResponse) GetShelves() []*Shelf {
-	if x != nil {
-		return x.Shelves
-	}
-	return nil
-}
-
-type GetShelfBooksRequest struct {
+type ImportGoodreadsLibraryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ShelfId string         `protobuf:"bytes,1,opt,name=shelf_id,json=shelfId,proto3" json:"shelf_id,omitempty"`
-	Page    int32          `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit   int32          `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Sort    ShelfSortOrder `protobuf:"varint,4,opt,name=sort,proto3,enum=betterreads.ShelfSortOrder" json:"sort,omitempty"`
+	Csv []byte `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"` // Contents of the Goodreads "Export Library" CSV
 }
 
-func (x *GetShelfBooksRequest) Reset() {
-	*x = GetShelfBooksRequest{}
+func (x *ImportGoodreadsLibraryRequest) Reset() {
+	*x = ImportGoodreadsLibraryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2071,13 +2781,13 @@ func (x *GetShelfBooksRequest) Reset() {
 	}
 }
 
-func (x *GetShelfBooksRequest) String() string {
+func (x *ImportGoodreadsLibraryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShelfBooksRequest) ProtoMessage() {}
+func (*ImportGoodreadsLibraryRequest) ProtoMessage() {}
 
-func (x *GetShelfBooksRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportGoodreadsLibraryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2089,50 +2799,31 @@ func (x *GetShelfBooksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShelfBooksRequest.ProtoReflect.Descriptor instead.
-func (*GetShelfBooksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportGoodreadsLibraryRequest.ProtoReflect.Descriptor instead.
+func (*ImportGoodreadsLibraryRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetShelfBooksRequest) GetShelfId() string {
-	if x != nil {
-		return x.ShelfId
-	}
-	return ""
-}
-
-func (x *GetShelfBooksRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetShelfBooksRequest) GetLimit() int32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-func (x *GetShelfBooksRequest) GetSort() ShelfSortOrder {
+func (x *ImportGoodreadsLibraryRequest) GetCsv() []byte {
 	if x != nil {
-		return x.Sort
+		return x.Csv
 	}
-	return ShelfSortOrder_SHELF_SORT_ORDER_UNSPECIFIED
+	return nil
 }
 
-type GetShelfBooksResponse struct {
+type ImportGoodreadsLibraryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Books      []*LibraryBook      `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
-	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ImportedCount int32              `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	SkippedCount  int32              `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	FailedCount   int32              `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Rows          []*ImportRowResult `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
 }
 
-func (x *GetShelfBooksResponse) Reset() {
-	*x = GetShelfBooksResponse{}
+func (x *ImportGoodreadsLibraryResponse) Reset() {
+	*x = ImportGoodreadsLibraryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2140,13 +2831,13 @@ func (x *GetShelfBooksResponse) Reset() {
 	}
 }
 
-func (x *GetShelfBooksResponse) String() string {
+func (x *ImportGoodreadsLibraryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetShelfBooksResponse) ProtoMessage() {}
+func (*ImportGoodreadsLibraryResponse) ProtoMessage() {}
 
-func (x *GetShelfBooksResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportGoodreadsLibraryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2158,36 +2849,51 @@ func (x *GetShelfBooksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetShelfBooksResponse.ProtoReflect.Descriptor instead.
-func (*GetShelfBooksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportGoodreadsLibraryResponse.ProtoReflect.Descriptor instead.
+func (*ImportGoodreadsLibraryResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetShelfBooksResponse) GetBooks() []*LibraryBook {
+func (x *ImportGoodreadsLibraryResponse) GetImportedCount() int32 {
 	if x != nil {
-		return x.Books
+		return x.ImportedCount
 	}
-	return nil
+	return 0
 }
 
-func (x *GetShelfBooksResponse) GetPagination() *PaginationMetadata {
+func (x *ImportGoodreadsLibraryResponse) GetSkippedCount() int32 {
 	if x != nil {
-		return x.Pagination
+		return x.SkippedCount
 	}
-	return nil
+	return 0
 }
 
-type AddBookToShelfRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BookId  string `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
-	ShelfId string `protobuf:"bytes,2,opt,name=shelf_id,json=shelfId,proto3" json:"shelf_id,omitempty"`
+func (x *ImportGoodreadsLibraryResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
 }
 
-func (x *AddBookToShelfRequest) Reset() {
-	*x = AddBookToShelfRequest{}
+func (x *ImportGoodreadsLibraryResponse) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type ImportLibraryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source ImportSource `protobuf:"varint,1,opt,name=source,proto3,enum=betterreads.ImportSource" json:"source,omitempty"`
+	File   []byte       `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`                    // Contents of the site's library export
+	DryRun bool         `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Report what would be imported without writing anything
+}
+
+func (x *ImportLibraryRequest) Reset() {
+	*x = ImportLibraryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2195,13 +2901,13 @@ func (x *AddBookToShelfRequest) Reset() {
 	}
 }
 
-func (x *AddBookToShelfRequest) String() string {
+func (x *ImportLibraryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddBookToShelfRequest) ProtoMessage() {}
+func (*ImportLibraryRequest) ProtoMessage() {}
 
-func (x *AddBookToShelfRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportLibraryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2213,33 +2919,47 @@ func (x *AddBookToShelfRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddBookToShelfRequest.ProtoReflect.Descriptor instead.
-func (*AddBookToShelfRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportLibraryRequest.ProtoReflect.Descriptor instead.
+func (*ImportLibraryRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *AddBookToShelfRequest) GetBookId() string {
+func (x *ImportLibraryRequest) GetSource() ImportSource {
 	if x != nil {
-		return x.BookId
+		return x.Source
 	}
-	return ""
+	return ImportSource_IMPORT_SOURCE_UNSPECIFIED
 }
 
-func (x *AddBookToShelfRequest) GetShelfId() string {
+func (x *ImportLibraryRequest) GetFile() []byte {
 	if x != nil {
-		return x.ShelfId
+		return x.File
 	}
-	return ""
+	return nil
 }
 
-type AddBookToShelfResponse struct {
+func (x *ImportLibraryRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportLibraryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ImportedCount int32              `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"` // With dry_run, the rows that would be imported
+	SkippedCount  int32              `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	FailedCount   int32              `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Rows          []*ImportRowResult `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	DryRun        bool               `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	NewShelves    []string           `protobuf:"bytes,6,rep,name=new_shelves,json=newShelves,proto3" json:"new_shelves,omitempty"` // Shelves created, or that would be created, for the import
 }
 
-func (x *AddBookToShelfResponse) Reset() {
-	*x = AddBookToShelfResponse{}
+func (x *ImportLibraryResponse) Reset() {
+	*x = ImportLibraryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2247,13 +2967,13 @@ func (x *AddBookToShelfResponse) Reset() {
 	}
 }
 
-func (x *AddBookToShelfResponse) String() string {
+func (x *ImportLibraryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddBookToShelfResponse) ProtoMessage() {}
+func (*ImportLibraryResponse) ProtoMessage() {}
 
-func (x *AddBookToShelfResponse) ProtoReflect() protoreflect.Message {
+func (x *ImportLibraryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2265,22 +2985,63 @@ func (x *AddBookToShelfResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddBookToShelfResponse.ProtoReflect.Descriptor instead.
-func (*AddBookToShelfResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportLibraryResponse.ProtoReflect.Descriptor instead.
+func (*ImportLibraryResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{31}
 }
 
-type RemoveBookFromShelfRequest struct {
+func (x *ImportLibraryResponse) GetImportedCount() int32 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportLibraryResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *ImportLibraryResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *ImportLibraryResponse) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportLibraryResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportLibraryResponse) GetNewShelves() []string {
+	if x != nil {
+		return x.NewShelves
+	}
+	return nil
+}
+
+type ExportLibraryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BookId  string `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
-	ShelfId string `protobuf:"bytes,2,opt,name=shelf_id,json=shelfId,proto3" json:"shelf_id,omitempty"`
+	Format ExportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=betterreads.ExportFormat" json:"format,omitempty"`
 }
 
-func (x *RemoveBookFromShelfRequest) Reset() {
-	*x = RemoveBookFromShelfRequest{}
+func (x *ExportLibraryRequest) Reset() {
+	*x = ExportLibraryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2288,13 +3049,13 @@ func (x *RemoveBookFromShelfRequest) Reset() {
 	}
 }
 
-func (x *RemoveBookFromShelfRequest) String() string {
+func (x *ExportLibraryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveBookFromShelfRequest) ProtoMessage() {}
+func (*ExportLibraryRequest) ProtoMessage() {}
 
-func (x *RemoveBookFromShelfRequest) ProtoReflect() protoreflect.Message {
+func (x *ExportLibraryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2306,33 +3067,29 @@ func (x *RemoveBookFromShelfRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveBookFromShelfRequest.ProtoReflect.Descriptor instead.
-func (*RemoveBookFromShelfRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportLibraryRequest.ProtoReflect.Descriptor instead.
+func (*ExportLibraryRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *RemoveBookFromShelfRequest) GetBookId() string {
-	if x != nil {
-		return x.BookId
-	}
-	return ""
-}
-
-func (x *RemoveBookFromShelfRequest) GetShelfId() string {
+func (x *ExportLibraryRequest) GetFormat() ExportFormat {
 	if x != nil {
-		return x.ShelfId
+		return x.Format
 	}
-	return ""
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
 }
 
-type RemoveBookFromShelfResponse struct {
+// One chunk of the exported file; concatenate the chunks in order
+type ExportLibraryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *RemoveBookFromShelfResponse) Reset() {
-	*x = RemoveBookFromShelfResponse{}
+func (x *ExportLibraryResponse) Reset() {
+	*x = ExportLibraryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2340,13 +3097,13 @@ func (x *RemoveBookFromShelfResponse) Reset() {
 	}
 }
 
-func (x *RemoveBookFromShelfResponse) String() string {
+func (x *ExportLibraryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveBookFromShelfResponse) ProtoMessage() {}
+func (*ExportLibraryResponse) ProtoMessage() {}
 
-func (x *RemoveBookFromShelfResponse) ProtoReflect() protoreflect.Message {
+func (x *ExportLibraryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2358,23 +3115,36 @@ func (x *RemoveBookFromShelfResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveBookFromShelfResponse.ProtoReflect.Descriptor instead.
-func (*RemoveBookFromShelfResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportLibraryResponse.ProtoReflect.Descriptor instead.
+func (*ExportLibraryResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{33}
 }
 
-type CreatePostRequest struct {
+func (x *ExportLibraryResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Outcome of importing one row of an export file
+type ImportRowResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Book        *BookDetails `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
-	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Summary     string       `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`
+	Line          int32           `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // Line number in the uploaded file
+	Title         string          `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	BookId        string          `protobuf:"bytes,3,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Status        ImportRowStatus `protobuf:"varint,4,opt,name=status,proto3,enum=betterreads.ImportRowStatus" json:"status,omitempty"`
+	Message       string          `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"` // Why the row was skipped or failed
+	Shelves       []string        `protobuf:"bytes,6,rep,name=shelves,proto3" json:"shelves,omitempty"` // Shelves the book is placed on
+	ReadingStatus ReadingStatus   `protobuf:"varint,7,opt,name=reading_status,json=readingStatus,proto3,enum=betterreads.ReadingStatus" json:"reading_status,omitempty"`
+	Rating        BookRating      `protobuf:"varint,8,opt,name=rating,proto3,enum=betterreads.BookRating" json:"rating,omitempty"`
 }
 
-func (x *CreatePostRequest) Reset() {
-	*x = CreatePostRequest{}
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2382,13 +3152,13 @@ func (x *CreatePostRequest) Reset() {
 	}
 }
 
-func (x *CreatePostRequest) String() string {
+func (x *ImportRowResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostRequest) ProtoMessage() {}
+func (*ImportRowResult) ProtoMessage() {}
 
-func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2400,42 +3170,80 @@ func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
-func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CreatePostRequest) GetBook() *BookDetails {
+func (x *ImportRowResult) GetLine() int32 {
 	if x != nil {
-		return x.Book
+		return x.Line
 	}
-	return nil
+	return 0
 }
 
-func (x *CreatePostRequest) GetDescription() string {
+func (x *ImportRowResult) GetTitle() string {
 	if x != nil {
-		return x.Description
+		return x.Title
 	}
 	return ""
 }
 
-func (x *CreatePostRequest) GetSummary() string {
+func (x *ImportRowResult) GetBookId() string {
 	if x != nil {
-		return x.Summary
+		return x.BookId
 	}
 	return ""
 }
 
-type CreatePostResponse struct {
+func (x *ImportRowResult) GetStatus() ImportRowStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ImportRowStatus_IMPORT_ROW_STATUS_UNSPECIFIED
+}
+
+func (x *ImportRowResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetShelves() []string {
+	if x != nil {
+		return x.Shelves
+	}
+	return nil
+}
+
+func (x *ImportRowResult) GetReadingStatus() ReadingStatus {
+	if x != nil {
+		return x.ReadingStatus
+	}
+	return ReadingStatus_READING_STATUS_UNSPECIFIED
+}
+
+func (x *ImportRowResult) GetRating() BookRating {
+	if x != nil {
+		return x.Rating
+	}
+	return BookRating_BOOK_RATING_UNSPECIFIED
+}
+
+type GetUserLibraryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page      int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from a previous response; takes precedence over page
 }
 
-func (x *CreatePostResponse) Reset() {
-	*x = CreatePostResponse{}
+func (x *GetUserLibraryRequest) Reset() {
+	*x = GetUserLibraryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2443,13 +3251,13 @@ func (x *CreatePostResponse) Reset() {
 	}
 }
 
-func (x *CreatePostResponse) String() string {
+func (x *GetUserLibraryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostResponse) ProtoMessage() {}
+func (*GetUserLibraryRequest) ProtoMessage() {}
 
-func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUserLibraryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2461,28 +3269,53 @@ func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
-func (*CreatePostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserLibraryRequest.ProtoReflect.Descriptor instead.
+func (*GetUserLibraryRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CreatePostResponse) GetPost() *Post {
+func (x *GetUserLibraryRequest) GetUserId() string {
 	if x != nil {
-		return x.Post
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type DeletePostRequest struct {
+func (x *GetUserLibraryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetUserLibraryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserLibraryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchLibraryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Query     string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // Matched case-insensitively against title, author, review, notes and tags
+	Tag       string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`     // Optional: only books with this tag
+	Page      int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from a previous response; takes precedence over page
 }
 
-func (x *DeletePostRequest) Reset() {
-	*x = DeletePostRequest{}
+func (x *SearchLibraryRequest) Reset() {
+	*x = SearchLibraryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2490,13 +3323,13 @@ func (x *DeletePostRequest) Reset() {
 	}
 }
 
-func (x *DeletePostRequest) String() string {
+func (x *SearchLibraryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostRequest) ProtoMessage() {}
+func (*SearchLibraryRequest) ProtoMessage() {}
 
-func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+func (x *SearchLibraryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2508,26 +3341,57 @@ func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
-func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchLibraryRequest.ProtoReflect.Descriptor instead.
+func (*SearchLibraryRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *DeletePostRequest) GetPostId() string {
+func (x *SearchLibraryRequest) GetQuery() string {
 	if x != nil {
-		return x.PostId
+		return x.Query
 	}
 	return ""
 }
 
-type DeletePostResponse struct {
+func (x *SearchLibraryRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *SearchLibraryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchLibraryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchLibraryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchLibraryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Books      []*LibraryBook      `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"` // Most recently added first
+	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *DeletePostResponse) Reset() {
-	*x = DeletePostResponse{}
+func (x *SearchLibraryResponse) Reset() {
+	*x = SearchLibraryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2535,13 +3399,13 @@ func (x *DeletePostResponse) Reset() {
 	}
 }
 
-func (x *DeletePostResponse) String() string {
+func (x *SearchLibraryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostResponse) ProtoMessage() {}
+func (*SearchLibraryResponse) ProtoMessage() {}
 
-func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+func (x *SearchLibraryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2553,24 +3417,37 @@ func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
-func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchLibraryResponse.ProtoReflect.Descriptor instead.
+func (*SearchLibraryResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{37}
 }
 
-type UpdatePostRequest struct {
+func (x *SearchLibraryResponse) GetBooks() []*LibraryBook {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+func (x *SearchLibraryResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetUserLibraryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId      string       `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"` // Path param
-	Book        *BookDetails `protobuf:"bytes,2,opt,name=book,proto3" json:"book,omitempty"`
-	Description string       `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Summary     string       `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
-}
-
-func (x *UpdatePostRequest) Reset() {
-	*x = UpdatePostRequest{}
+	Shelves        []*ShelfWithBooks   `protobuf:"bytes,1,rep,name=shelves,proto3" json:"shelves,omitempty"`
+	UnshelvedBooks []*LibraryBook      `protobuf:"bytes,2,rep,name=unshelved_books,json=unshelvedBooks,proto3" json:"unshelved_books,omitempty"` // Books not on any shelf
+	Pagination     *PaginationMetadata `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetUserLibraryResponse) Reset() {
+	*x = GetUserLibraryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2578,13 +3455,13 @@ func (x *UpdatePostRequest) Reset() {
 	}
 }
 
-func (x *UpdatePostRequest) String() string {
+func (x *GetUserLibraryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostRequest) ProtoMessage() {}
+func (*GetUserLibraryResponse) ProtoMessage() {}
 
-func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserLibraryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2596,49 +3473,42 @@ func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserLibraryResponse.ProtoReflect.Descriptor instead.
+func (*GetUserLibraryResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *UpdatePostRequest) GetPostId() string {
-	if x != nil {
-		return x.PostId
-	}
-	return ""
-}
-
-func (x *UpdatePostRequest) GetBook() *BookDetails {
+func (x *GetUserLibraryResponse) GetShelves() []*ShelfWithBooks {
 	if x != nil {
-		return x.Book
+		return x.Shelves
 	}
 	return nil
 }
 
-func (x *UpdatePostRequest) GetDescription() string {
+func (x *GetUserLibraryResponse) GetUnshelvedBooks() []*LibraryBook {
 	if x != nil {
-		return x.Description
+		return x.UnshelvedBooks
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdatePostRequest) GetSummary() string {
+func (x *GetUserLibraryResponse) GetPagination() *PaginationMetadata {
 	if x != nil {
-		return x.Summary
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-type UpdatePostResponse struct {
+type CreateShelfRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *UpdatePostResponse) Reset() {
-	*x = UpdatePostResponse{}
+func (x *CreateShelfRequest) Reset() {
+	*x = CreateShelfRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2646,13 +3516,13 @@ func (x *UpdatePostResponse) Reset() {
 	}
 }
 
-func (x *UpdatePostResponse) String() string {
+func (x *CreateShelfRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePostResponse) ProtoMessage() {}
+func (*CreateShelfRequest) ProtoMessage() {}
 
-func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateShelfRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2664,30 +3534,28 @@ func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
-func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateShelfRequest.ProtoReflect.Descriptor instead.
+func (*CreateShelfRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *UpdatePostResponse) GetPost() *Post {
+func (x *CreateShelfRequest) GetName() string {
 	if x != nil {
-		return x.Post
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetCommentsForPostRequest struct {
+type CreateShelfResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Page   int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	Limit  int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Shelf *Shelf `protobuf:"bytes,1,opt,name=shelf,proto3" json:"shelf,omitempty"`
 }
 
-func (x *GetCommentsForPostRequest) Reset() {
-	*x = GetCommentsForPostRequest{}
+func (x *CreateShelfResponse) Reset() {
+	*x = CreateShelfResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2695,13 +3563,13 @@ func (x *GetCommentsForPostRequest) Reset() {
 	}
 }
 
-func (x *GetCommentsForPostRequest) String() string {
+func (x *CreateShelfResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsForPostRequest) ProtoMessage() {}
+func (*CreateShelfResponse) ProtoMessage() {}
 
-func (x *GetCommentsForPostRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateShelfResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2713,43 +3581,29 @@ func (x *GetCommentsForPostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsForPostRequest.ProtoReflect.Descriptor instead.
-func (*GetCommentsForPostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateShelfResponse.ProtoReflect.Descriptor instead.
+func (*CreateShelfResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetCommentsForPostRequest) GetPostId() string {
-	if x != nil {
-		return x.PostId
-	}
-	return ""
-}
-
-func (x *GetCommentsForPostRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetCommentsForPostRequest) GetLimit() int32 {
+func (x *CreateShelfResponse) GetShelf() *Shelf {
 	if x != nil {
-		return x.Limit
+		return x.Shelf
 	}
-	return 0
+	return nil
 }
 
-type GetCommentsForPostResponse struct {
+type UpdateShelfRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comments   []*Comment          `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
-	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ShelfId string `protobuf:"bytes,1,opt,name=shelf_id,json=shelfId,proto3" json:"shelf_id,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Optional: if provided, renames the shelf
 }
 
-func (x *GetCommentsForPostResponse) Reset() {
-	*x = GetCommentsForPostResponse{}
+func (x *UpdateShelfRequest) Reset() {
+	*x = UpdateShelfRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2757,13 +3611,13 @@ func (x *GetCommentsForPostResponse) Reset() {
 	}
 }
 
-func (x *GetCommentsForPostResponse) String() string {
+func (x *UpdateShelfRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentsForPostResponse) ProtoMessage() {}
+func (*UpdateShelfRequest) ProtoMessage() {}
 
-func (x *GetCommentsForPostResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateShelfRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2775,36 +3629,35 @@ func (x *GetCommentsForPostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentsForPostResponse.ProtoReflect.Descriptor instead.
-func (*GetCommentsForPostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateShelfRequest.ProtoReflect.Descriptor instead.
+func (*UpdateShelfRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetCommentsForPostResponse) GetComments() []*Comment {
+func (x *UpdateShelfRequest) GetShelfId() string {
 	if x != nil {
-		return x.Comments
+		return x.ShelfId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetCommentsForPostResponse) GetPagination() *PaginationMetadata {
+func (x *UpdateShelfRequest) GetName() string {
 	if x != nil {
-		return x.Pagination
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type AddCommentRequest struct {
+type UpdateShelfResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId  string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Shelf *Shelf `protobuf:"bytes,1,opt,name=shelf,proto3" json:"shelf,omitempty"`
 }
 
-func (x *AddCommentRequest) Reset() {
-	*x = AddCommentRequest{}
+func (x *UpdateShelfResponse) Reset() {
+	*x = UpdateShelfResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2812,13 +3665,13 @@ func (x *AddCommentRequest) Reset() {
 	}
 }
 
-func (x *AddCommentRequest) String() string {
+func (x *UpdateShelfResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCommentRequest) ProtoMessage() {}
+func (*UpdateShelfResponse) ProtoMessage() {}
 
-func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateShelfResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2830,35 +3683,28 @@ func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
-func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateShelfResponse.ProtoReflect.Descriptor instead.
+func (*UpdateShelfResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *AddCommentRequest) GetPostId() string {
-	if x != nil {
-		return x.PostId
-	}
-	return ""
-}
-
-func (x *AddCommentRequest) GetContent() string {
+func (x *UpdateShelfResponse) GetShelf() *Shelf {
 	if x != nil {
-		return x.Content
+		return x.Shelf
 	}
-	return ""
+	return nil
 }
 
-type AddCommentResponse struct {
+type DeleteShelfRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	ShelfId string `protobuf:"bytes,1,opt,name=shelf_id,json=shelfId,proto3" json:"shelf_id,omitempty"`
 }
 
-func (x *AddCommentResponse) Reset() {
-	*x = AddCommentResponse{}
+func (x *DeleteShelfRequest) Reset() {
+	*x = DeleteShelfRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2866,13 +3712,13 @@ func (x *AddCommentResponse) Reset() {
 	}
 }
 
-func (x *AddCommentResponse) String() string {
+func (x *DeleteShelfRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddCommentResponse) ProtoMessage() {}
+func (*DeleteShelfRequest) ProtoMessage() {}
 
-func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteShelfRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2884,29 +3730,26 @@ func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddCommentResponse.ProtoReflect.Descriptor instead.
-func (*AddCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteShelfRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShelfRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *AddCommentResponse) GetComment() *Comment {
+func (x *DeleteShelfRequest) GetShelfId() string {
 	if x != nil {
-		return x.Comment
+		return x.ShelfId
 	}
-	return nil
+	return ""
 }
 
-type DeleteCommentRequest struct {
+type DeleteShelfResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostId    string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
 }
 
-func (x *DeleteCommentRequest) Reset() {
-	*x = DeleteCommentRequest{}
+func (x *DeleteShelfResponse) Reset() {
+	*x = DeleteShelfResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2914,13 +3757,13 @@ func (x *DeleteCommentRequest) Reset() {
 	}
 }
 
-func (x *DeleteCommentRequest) String() string {
+func (x *DeleteShelfResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentRequest) ProtoMessage() {}
+func (*DeleteShelfResponse) ProtoMessage() {}
 
-func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteShelfResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2932,33 +3775,21 @@ func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteShelfResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShelfResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *DeleteCommentRequest) GetPostId() string {
-	if x != nil {
-		return x.PostId
-	}
-	return ""
-}
-
-func (x *DeleteCommentRequest) GetCommentId() string {
-	if x != nil {
-		return x.CommentId
-	}
-	return ""
-}
-
-type DeleteCommentResponse struct {
+type GetUserShelvesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *DeleteCommentResponse) Reset() {
-	*x = DeleteCommentResponse{}
+func (x *GetUserShelvesRequest) Reset() {
+	*x = GetUserShelvesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2966,13 +3797,13 @@ func (x *DeleteCommentResponse) Reset() {
 	}
 }
 
-func (x *DeleteCommentResponse) String() string {
+func (x *GetUserShelvesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCommentResponse) ProtoMessage() {}
+func (*GetUserShelvesRequest) ProtoMessage() {}
 
-func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+func (x *GetUserShelvesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2984,35 +3815,42 @@ func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserShelvesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserShelvesRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{45}
 }
 
-type LikePostRequest struct {
+func (x *GetUserShelvesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserShelvesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Shelves []*Shelf `protobuf:"bytes,1,rep,name=shelves,proto3" json:"shelves,omitempty"`
 }
 
-func (x *LikePostRequest) Reset() {
-	*x = LikePostRequest{}
-	if protoimpl.UnsafeEnabled {
+func (x *GetUserShelvesResponse) Reset() {
+	*x = GetUserShelvesResponse{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LikePostRequest) String() string {
+func (x *GetUserShelvesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostRequest) ProtoMessage() {}
+func (*GetUserShelvesResponse) ProtoMessage() {}
 
-func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUserShelvesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3024,26 +3862,32 @@ func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
-func (*LikePostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserShelvesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserShelvesResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *LikePostRequest) GetPostId() string {
+func (x *GetUserShelvesResponse) GetShelves() []*Shelf {
 	if x != nil {
-		return x.PostId
+		return x.Shelves
 	}
-	return ""
+	return nil
 }
 
-type LikePostResponse struct {
+type GetShelfBooksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	ShelfId   string         `protobuf:"bytes,1,opt,name=shelf_id,json=shelfId,proto3" json:"shelf_id,omitempty"`
+	Page      int32          `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int32          `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort      ShelfSortOrder `protobuf:"varint,4,opt,name=sort,proto3,enum=betterreads.ShelfSortOrder" json:"sort,omitempty"`
+	PageToken string         `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from a previous response; takes precedence over page
 }
 
-func (x *LikePostResponse) Reset() {
-	*x = LikePostResponse{}
+func (x *GetShelfBooksRequest) Reset() {
+	*x = GetShelfBooksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3051,13 +3895,13 @@ func (x *LikePostResponse) Reset() {
 	}
 }
 
-func (x *LikePostResponse) String() string {
+func (x *GetShelfBooksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostResponse) ProtoMessage() {}
+func (*GetShelfBooksRequest) ProtoMessage() {}
 
-func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
+func (x *GetShelfBooksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3069,21 +3913,57 @@ func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
-func (*LikePostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetShelfBooksRequest.ProtoReflect.Descriptor instead.
+func (*GetShelfBooksRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{47}
 }
 
-type UnlikePostRequest struct {
+func (x *GetShelfBooksRequest) GetShelfId() string {
+	if x != nil {
+		return x.ShelfId
+	}
+	return ""
+}
+
+func (x *GetShelfBooksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetShelfBooksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetShelfBooksRequest) GetSort() ShelfSortOrder {
+	if x != nil {
+		return x.Sort
+	}
+	return ShelfSortOrder_SHELF_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *GetShelfBooksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetShelfBooksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Books      []*LibraryBook      `protobuf:"bytes,1,rep,name=books,proto3" json:"books,omitempty"`
+	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *UnlikePostRequest) Reset() {
-	*x = UnlikePostRequest{}
+func (x *GetShelfBooksResponse) Reset() {
+	*x = GetShelfBooksResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3091,13 +3971,13 @@ func (x *UnlikePostRequest) Reset() {
 	}
 }
 
-func (x *UnlikePostRequest) String() string {
+func (x *GetShelfBooksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikePostRequest) ProtoMessage() {}
+func (*GetShelfBooksResponse) ProtoMessage() {}
 
-func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
+func (x *GetShelfBooksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3109,26 +3989,36 @@ func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikePostRequest.ProtoReflect.Descriptor instead.
-func (*UnlikePostRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetShelfBooksResponse.ProtoReflect.Descriptor instead.
+func (*GetShelfBooksResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *UnlikePostRequest) GetPostId() string {
+func (x *GetShelfBooksResponse) GetBooks() []*LibraryBook {
 	if x != nil {
-		return x.PostId
+		return x.Books
 	}
-	return ""
+	return nil
 }
 
-type UnlikePostResponse struct {
+func (x *GetShelfBooksResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type AddBookToShelfRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	BookId  string `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	ShelfId string `protobuf:"bytes,2,opt,name=shelf_id,json=shelfId,proto3" json:"shelf_id,omitempty"`
 }
 
-func (x *UnlikePostResponse) Reset() {
-	*x = UnlikePostResponse{}
+func (x *AddBookToShelfRequest) Reset() {
+	*x = AddBookToShelfRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3136,13 +4026,13 @@ func (x *UnlikePostResponse) Reset() {
 	}
 }
 
-func (x *UnlikePostResponse) String() string {
+func (x *AddBookToShelfRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikePostResponse) ProtoMessage() {}
+func (*AddBookToShelfRequest) ProtoMessage() {}
 
-func (x *UnlikePostResponse) ProtoReflect() protoreflect.Message {
+func (x *AddBookToShelfRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3154,19 +4044,33 @@ func (x *UnlikePostResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikePostResponse.ProtoReflect.Descriptor instead.
-func (*UnlikePostResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddBookToShelfRequest.ProtoReflect.Descriptor instead.
+func (*AddBookToShelfRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{49}
 }
 
-type DeleteUserProfileRequest struct {
+func (x *AddBookToShelfRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *AddBookToShelfRequest) GetShelfId() string {
+	if x != nil {
+		return x.ShelfId
+	}
+	return ""
+}
+
+type AddBookToShelfResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteUserProfileRequest) Reset() {
-	*x = DeleteUserProfileRequest{}
+func (x *AddBookToShelfResponse) Reset() {
+	*x = AddBookToShelfResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3174,13 +4078,13 @@ func (x *DeleteUserProfileRequest) Reset() {
 	}
 }
 
-func (x *DeleteUserProfileRequest) String() string {
+func (x *AddBookToShelfResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserProfileRequest) ProtoMessage() {}
+func (*AddBookToShelfResponse) ProtoMessage() {}
 
-func (x *DeleteUserProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *AddBookToShelfResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3192,19 +4096,22 @@ func (x *DeleteUserProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserProfileRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddBookToShelfResponse.ProtoReflect.Descriptor instead.
+func (*AddBookToShelfResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{50}
 }
 
-type DeleteUserProfileResponse struct {
+type RemoveBookFromShelfRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	BookId  string `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	ShelfId string `protobuf:"bytes,2,opt,name=shelf_id,json=shelfId,proto3" json:"shelf_id,omitempty"`
 }
 
-func (x *DeleteUserProfileResponse) Reset() {
-	*x = DeleteUserProfileResponse{}
+func (x *RemoveBookFromShelfRequest) Reset() {
+	*x = RemoveBookFromShelfRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3212,13 +4119,13 @@ func (x *DeleteUserProfileResponse) Reset() {
 	}
 }
 
-func (x *DeleteUserProfileResponse) String() string {
+func (x *RemoveBookFromShelfRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserProfileResponse) ProtoMessage() {}
+func (*RemoveBookFromShelfRequest) ProtoMessage() {}
 
-func (x *DeleteUserProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveBookFromShelfRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3230,19 +4137,33 @@ func (x *DeleteUserProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserProfileResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveBookFromShelfRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBookFromShelfRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{51}
 }
 
-type GetCurrentUserProfileRequest struct {
+func (x *RemoveBookFromShelfRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *RemoveBookFromShelfRequest) GetShelfId() string {
+	if x != nil {
+		return x.ShelfId
+	}
+	return ""
+}
+
+type RemoveBookFromShelfResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetCurrentUserProfileRequest) Reset() {
-	*x = GetCurrentUserProfileRequest{}
+func (x *RemoveBookFromShelfResponse) Reset() {
+	*x = RemoveBookFromShelfResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3250,13 +4171,13 @@ func (x *GetCurrentUserProfileRequest) Reset() {
 	}
 }
 
-func (x *GetCurrentUserProfileRequest) String() string {
+func (x *RemoveBookFromShelfResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentUserProfileRequest) ProtoMessage() {}
+func (*RemoveBookFromShelfResponse) ProtoMessage() {}
 
-func (x *GetCurrentUserProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveBookFromShelfResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3268,27 +4189,27 @@ func (x *GetCurrentUserProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentUserProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrentUserProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveBookFromShelfResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBookFromShelfResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{52}
 }
 
-type GetCurrentUserProfileResponse struct {
+type ReadingProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username        string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	FirstName       string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName        string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	ProfilePhotoUrl string                 `protobuf:"bytes,6,opt,name=profile_photo_url,json=profilePhotoUrl,proto3" json:"profile_photo_url,omitempty"` // URL to profile photo
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BookId     string                 `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Page       int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"` // 0 when progress was logged as a percentage
+	TotalPages int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	Percent    float64                `protobuf:"fixed64,5,opt,name=percent,proto3" json:"percent,omitempty"`
+	Note       string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *GetCurrentUserProfileResponse) Reset() {
-	*x = GetCurrentUserProfileResponse{}
+func (x *ReadingProgress) Reset() {
+	*x = ReadingProgress{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3296,13 +4217,13 @@ func (x *GetCurrentUserProfileResponse) Reset() {
 	}
 }
 
-func (x *GetCurrentUserProfileResponse) String() string {
+func (x *ReadingProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrentUserProfileResponse) ProtoMessage() {}
+func (*ReadingProgress) ProtoMessage() {}
 
-func (x *GetCurrentUserProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *ReadingProgress) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3314,74 +4235,74 @@ func (x *GetCurrentUserProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrentUserProfileResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrentUserProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadingProgress.ProtoReflect.Descriptor instead.
+func (*ReadingProgress) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetCurrentUserProfileResponse) GetId() string {
+func (x *ReadingProgress) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetCurrentUserProfileResponse) GetUsername() string {
+func (x *ReadingProgress) GetBookId() string {
 	if x != nil {
-		return x.Username
+		return x.BookId
 	}
 	return ""
 }
 
-func (x *GetCurrentUserProfileResponse) GetEmail() string {
+func (x *ReadingProgress) GetPage() int32 {
 	if x != nil {
-		return x.Email
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCurrentUserProfileResponse) GetFirstName() string {
+func (x *ReadingProgress) GetTotalPages() int32 {
 	if x != nil {
-		return x.FirstName
+		return x.TotalPages
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCurrentUserProfileResponse) GetLastName() string {
+func (x *ReadingProgress) GetPercent() float64 {
 	if x != nil {
-		return x.LastName
+		return x.Percent
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCurrentUserProfileResponse) GetProfilePhotoUrl() string {
+func (x *ReadingProgress) GetNote() string {
 	if x != nil {
-		return x.ProfilePhotoUrl
+		return x.Note
 	}
 	return ""
 }
 
-func (x *GetCurrentUserProfileResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ReadingProgress) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-type UpdateUserProfileRequest struct {
+type LogReadingProgressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email           string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	FirstName       string `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName        string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	ProfilePhotoUrl string `protobuf:"bytes,4,opt,name=profile_photo_url,json=profilePhotoUrl,proto3" json:"profile_photo_url,omitempty"` // URL to profile photo
-	Username        string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	BookId     string  `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Page       int32   `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                               // Current page; takes precedence over percent
+	TotalPages int32   `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"` // Optional: defaults to the page count of the latest entry that had one, then the book's page count
+	Percent    float64 `protobuf:"fixed64,4,opt,name=percent,proto3" json:"percent,omitempty"`                        // Used when page is not set, from 0 to 100
+	Note       string  `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
 }
 
-func (x *UpdateUserProfileRequest) Reset() {
-	*x = UpdateUserProfileRequest{}
+func (x *LogReadingProgressRequest) Reset() {
+	*x = LogReadingProgressRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3389,13 +4310,13 @@ func (x *UpdateUserProfileRequest) Reset() {
 	}
 }
 
-func (x *UpdateUserProfileRequest) String() string {
+func (x *LogReadingProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserProfileRequest) ProtoMessage() {}
+func (*LogReadingProgressRequest) ProtoMessage() {}
 
-func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *LogReadingProgressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3407,62 +4328,57 @@ func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogReadingProgressRequest.ProtoReflect.Descriptor instead.
+func (*LogReadingProgressRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *UpdateUserProfileRequest) GetEmail() string {
+func (x *LogReadingProgressRequest) GetBookId() string {
 	if x != nil {
-		return x.Email
+		return x.BookId
 	}
 	return ""
 }
 
-func (x *UpdateUserProfileRequest) GetFirstName() string {
+func (x *LogReadingProgressRequest) GetPage() int32 {
 	if x != nil {
-		return x.FirstName
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateUserProfileRequest) GetLastName() string {
+func (x *LogReadingProgressRequest) GetTotalPages() int32 {
 	if x != nil {
-		return x.LastName
+		return x.TotalPages
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateUserProfileRequest) GetProfilePhotoUrl() string {
+func (x *LogReadingProgressRequest) GetPercent() float64 {
 	if x != nil {
-		return x.ProfilePhotoUrl
+		return x.Percent
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateUserProfileRequest) GetUsername() string {
+func (x *LogReadingProgressRequest) GetNote() string {
 	if x != nil {
-		return x.Username
+		return x.Note
 	}
 	return ""
 }
 
-type UpdateUserProfileResponse struct {
+type LogReadingProgressResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username        string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	FirstName       string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName        string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	ProfilePhotoUrl string                 `protobuf:"bytes,6,opt,name=profile_photo_url,json=profilePhotoUrl,proto3" json:"profile_photo_url,omitempty"` // URL to profile photo
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Progress *ReadingProgress `protobuf:"bytes,1,opt,name=progress,proto3" json:"progress,omitempty"`
+	Book     *LibraryBook     `protobuf:"bytes,2,opt,name=book,proto3" json:"book,omitempty"` // The library entry after any status change
 }
 
-func (x *UpdateUserProfileResponse) Reset() {
-	*x = UpdateUserProfileResponse{}
+func (x *LogReadingProgressResponse) Reset() {
+	*x = LogReadingProgressResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3470,13 +4386,13 @@ func (x *UpdateUserProfileResponse) Reset() {
 	}
 }
 
-func (x *UpdateUserProfileResponse) String() string {
+func (x *LogReadingProgressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserProfileResponse) ProtoMessage() {}
+func (*LogReadingProgressResponse) ProtoMessage() {}
 
-func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *LogReadingProgressResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3488,74 +4404,39 @@ func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserProfileResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogReadingProgressResponse.ProtoReflect.Descriptor instead.
+func (*LogReadingProgressResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *UpdateUserProfileResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateUserProfileResponse) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *UpdateUserProfileResponse) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
-}
-
-func (x *UpdateUserProfileResponse) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
-}
-
-func (x *UpdateUserProfileResponse) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
-}
-
-func (x *UpdateUserProfileResponse) GetProfilePhotoUrl() string {
+func (x *LogReadingProgressResponse) GetProgress() *ReadingProgress {
 	if x != nil {
-		return x.ProfilePhotoUrl
+		return x.Progress
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateUserProfileResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *LogReadingProgressResponse) GetBook() *LibraryBook {
 	if x != nil {
-		return x.CreatedAt
+		return x.Book
 	}
 	return nil
 }
 
-type CreateUserProfileRequest struct {
+type CreateReadingSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email           string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	FirstName       string `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName        string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	ProfilePhotoUrl string `protobuf:"bytes,4,opt,name=profile_photo_url,json=profilePhotoUrl,proto3" json:"profile_photo_url,omitempty"` // URL to profile photo
-	Username        string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	BookId       string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	StartedAt    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	DidNotFinish bool                   `protobuf:"varint,4,opt,name=did_not_finish,json=didNotFinish,proto3" json:"did_not_finish,omitempty"`
+	Rating       BookRating             `protobuf:"varint,5,opt,name=rating,proto3,enum=betterreads.BookRating" json:"rating,omitempty"`
 }
 
-func (x *CreateUserProfileRequest) Reset() {
-	*x = CreateUserProfileRequest{}
+func (x *CreateReadingSessionRequest) Reset() {
+	*x = CreateReadingSessionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3563,13 +4444,13 @@ func (x *CreateUserProfileRequest) Reset() {
 	}
 }
 
-func (x *CreateUserProfileRequest) String() string {
+func (x *CreateReadingSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserProfileRequest) ProtoMessage() {}
+func (*CreateReadingSessionRequest) ProtoMessage() {}
 
-func (x *CreateUserProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateReadingSessionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3581,62 +4462,56 @@ func (x *CreateUserProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserProfileRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateReadingSessionRequest.ProtoReflect.Descriptor instead.
+func (*CreateReadingSessionRequest) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateUserProfileRequest) GetEmail() string {
+func (x *CreateReadingSessionRequest) GetBookId() string {
 	if x != nil {
-		return x.Email
+		return x.BookId
 	}
 	return ""
 }
 
-func (x *CreateUserProfileRequest) GetFirstName() string {
+func (x *CreateReadingSessionRequest) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FirstName
+		return x.StartedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateUserProfileRequest) GetLastName() string {
+func (x *CreateReadingSessionRequest) GetFinishedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastName
+		return x.FinishedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateUserProfileRequest) GetProfilePhotoUrl() string {
+func (x *CreateReadingSessionRequest) GetDidNotFinish() bool {
 	if x != nil {
-		return x.ProfilePhotoUrl
+		return x.DidNotFinish
 	}
-	return ""
+	return false
 }
 
-func (x *CreateUserProfileRequest) GetUsername() string {
+func (x *CreateReadingSessionRequest) GetRating() BookRating {
 	if x != nil {
-		return x.Username
+		return x.Rating
 	}
-	return ""
+	return BookRating_BOOK_RATING_UNSPECIFIED
 }
 
-type CreateUserProfileResponse struct {
+type CreateReadingSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username        string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	FirstName       string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName        string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	ProfilePhotoUrl string                 `protobuf:"bytes,6,opt,name=profile_photo_url,json=profilePhotoUrl,proto3" json:"profile_photo_url,omitempty"` // URL to profile photo
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Session *ReadingSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 }
 
-func (x *CreateUserProfileResponse) Reset() {
-	*x = CreateUserProfileResponse{}
+func (x *CreateReadingSessionResponse) Reset() {
+	*x = CreateReadingSessionResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_betterreads_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3644,13 +4519,13 @@ func (x *CreateUserProfileResponse) Reset() {
 	}
 }
 
-func (x *CreateUserProfileResponse) String() string {
+func (x *CreateReadingSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserProfileResponse) ProtoMessage() {}
+func (*CreateReadingSessionResponse) ProtoMessage() {}
 
-func (x *CreateUserProfileResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateReadingSessionResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_betterreads_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3662,85 +4537,130 @@ func (x *CreateUserProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserProfileResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserProfileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateReadingSessionResponse.ProtoReflect.Descriptor instead.
+func (*CreateReadingSessionResponse) Descriptor() ([]byte, []int) {
 	return file_betterreads_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CreateUserProfileResponse) GetId() string {
+func (x *CreateReadingSessionResponse) GetSession() *ReadingSession {
 	if x != nil {
-		return x.Id
+		return x.Session
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateUserProfileResponse) GetUsername() string {
+type UpdateReadingSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookId       string                 `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	SessionId    string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	StartedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	DidNotFinish bool                   `protobuf:"varint,5,opt,name=did_not_finish,json=didNotFinish,proto3" json:"did_not_finish,omitempty"`
+	Rating       BookRating             `protobuf:"varint,6,opt,name=rating,proto3,enum=betterreads.BookRating" json:"rating,omitempty"`
+}
+
+func (x *UpdateReadingSessionRequest) Reset() {
+	*x = UpdateReadingSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateReadingSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReadingSessionRequest) ProtoMessage() {}
+
+func (x *UpdateReadingSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReadingSessionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReadingSessionRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateReadingSessionRequest) GetBookId() string {
 	if x != nil {
-		return x.Username
+		return x.BookId
 	}
 	return ""
 }
 
-func (x *CreateUserProfileResponse) GetEmail() string {
+func (x *UpdateReadingSessionRequest) GetSessionId() string {
 	if x != nil {
-		return x.Email
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *CreateUserProfileResponse) GetFirstName() string {
+func (x *UpdateReadingSessionRequest) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FirstName
+		return x.StartedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateUserProfileResponse) GetLastName() string {
+func (x *UpdateReadingSessionRequest) GetFinishedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastName
+		return x.FinishedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateUserProfileResponse) GetProfilePhotoUrl() string {
+func (x *UpdateReadingSessionRequest) GetDidNotFinish() bool {
 	if x != nil {
-		return x.ProfilePhotoUrl
+		return x.DidNotFinish
 	}
-	return ""
+	return false
 }
 
-func (x *CreateUserProfileResponse) GetCreatedAt() *timestamppb.Timestamp {
+func (x *UpdateReadingSessionRequest) GetRating() BookRating {
 	if x != nil {
-		return x.CreatedAt
+		return x.Rating
 	}
-	return nil
+	return BookRating_BOOK_RATING_UNSPECIFIED
 }
 
-type GetUserByIdRequest struct {
+type UpdateReadingSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Session *ReadingSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
 }
 
-func (x *GetUserByIdRequest) Reset() {
-	*x = GetUserByIdRequest{}
+func (x *UpdateReadingSessionResponse) Reset() {
+	*x = UpdateReadingSessionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_betterreads_proto_msgTypes[58]
+		mi := &file_betterreads_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserByIdRequest) String() string {
+func (x *UpdateReadingSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserByIdRequest) ProtoMessage() {}
+func (*UpdateReadingSessionResponse) ProtoMessage() {}
 
-func (x *GetUserByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_betterreads_proto_msgTypes[58]
+func (x *UpdateReadingSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3751,47 +4671,44 @@ func (x *GetUserByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetUserByIdRequest) Descriptor() ([]byte, []int) {
-	return file_betterreads_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use UpdateReadingSessionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateReadingSessionResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetUserByIdRequest) GetUserId() string {
+func (x *UpdateReadingSessionResponse) GetSession() *ReadingSession {
 	if x != nil {
-		return x.UserId
+		return x.Session
 	}
-	return ""
+	return nil
 }
 
-type GetUserByIdResponse struct {
+type DeleteReadingSessionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username        string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	FirstName       string `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName        string `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	ProfilePhotoUrl string `protobuf:"bytes,5,opt,name=profile_photo_url,json=profilePhotoUrl,proto3" json:"profile_photo_url,omitempty"` // URL to profile photo
+	BookId    string `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	SessionId string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 }
 
-func (x *GetUserByIdResponse) Reset() {
-	*x = GetUserByIdResponse{}
+func (x *DeleteReadingSessionRequest) Reset() {
+	*x = DeleteReadingSessionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_betterreads_proto_msgTypes[59]
+		mi := &file_betterreads_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetUserByIdResponse) String() string {
+func (x *DeleteReadingSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserByIdResponse) ProtoMessage() {}
+func (*DeleteReadingSessionRequest) ProtoMessage() {}
 
-func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_betterreads_proto_msgTypes[59]
+func (x *DeleteReadingSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3802,71 +4719,48 @@ func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetUserByIdResponse) Descriptor() ([]byte, []int) {
-	return file_betterreads_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *GetUserByIdResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *GetUserByIdResponse) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *GetUserByIdResponse) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+// Deprecated: Use DeleteReadingSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReadingSessionRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetUserByIdResponse) GetLastName() string {
+func (x *DeleteReadingSessionRequest) GetBookId() string {
 	if x != nil {
-		return x.LastName
+		return x.BookId
 	}
 	return ""
 }
 
-func (x *GetUserByIdResponse) GetProfilePhotoUrl() string {
+func (x *DeleteReadingSessionRequest) GetSessionId() string {
 	if x != nil {
-		return x.ProfilePhotoUrl
+		return x.SessionId
 	}
 	return ""
 }
 
-type FollowUserRequest struct {
+type DeleteReadingSessionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 }
 
-func (x *FollowUserRequest) Reset() {
-	*x = FollowUserRequest{}
+func (x *DeleteReadingSessionResponse) Reset() {
+	*x = DeleteReadingSessionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_betterreads_proto_msgTypes[60]
+		mi := &file_betterreads_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FollowUserRequest) String() string {
+func (x *DeleteReadingSessionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowUserRequest) ProtoMessage() {}
+func (*DeleteReadingSessionResponse) ProtoMessage() {}
 
-func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_betterreads_proto_msgTypes[60]
+func (x *DeleteReadingSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3877,41 +4771,39 @@ func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
-func (*FollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_betterreads_proto_rawDescGZIP(), []int{60}
-}
-
-func (x *FollowUserRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use DeleteReadingSessionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReadingSessionResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{61}
 }
 
-type FollowUserResponse struct {
+type GetReadingProgressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	BookId    string `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Page      int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from a previous response; takes precedence over page
 }
 
-func (x *FollowUserResponse) Reset() {
-	*x = FollowUserResponse{}
+func (x *GetReadingProgressRequest) Reset() {
+	*x = GetReadingProgressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_betterreads_proto_msgTypes[61]
+		mi := &file_betterreads_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FollowUserResponse) String() string {
+func (x *GetReadingProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowUserResponse) ProtoMessage() {}
+func (*GetReadingProgressRequest) ProtoMessage() {}
 
-func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_betterreads_proto_msgTypes[61]
+func (x *GetReadingProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3922,36 +4814,65 @@ func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
-func (*FollowUserResponse) Descriptor() ([]byte, []int) {
-	return file_betterreads_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use GetReadingProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetReadingProgressRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{62}
 }
 
-type UnfollowUserRequest struct {
+func (x *GetReadingProgressRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *GetReadingProgressRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetReadingProgressRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetReadingProgressRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetReadingProgressResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Progress   []*ReadingProgress  `protobuf:"bytes,1,rep,name=progress,proto3" json:"progress,omitempty"`
+	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *UnfollowUserRequest) Reset() {
-	*x = UnfollowUserRequest{}
+func (x *GetReadingProgressResponse) Reset() {
+	*x = GetReadingProgressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_betterreads_proto_msgTypes[62]
+		mi := &file_betterreads_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnfollowUserRequest) String() string {
+func (x *GetReadingProgressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowUserRequest) ProtoMessage() {}
+func (*GetReadingProgressResponse) ProtoMessage() {}
 
-func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_betterreads_proto_msgTypes[62]
+func (x *GetReadingProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3962,977 +4883,6083 @@ func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
-func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_betterreads_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use GetReadingProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetReadingProgressResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *UnfollowUserRequest) GetUserId() string {
+func (x *GetReadingProgressResponse) GetProgress() []*ReadingProgress {
 	if x != nil {
-		return x.UserId
+		return x.Progress
 	}
-	return ""
+	return nil
 }
 
-type UnfollowUserResponse struct {
+func (x *GetReadingProgressResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// A target number of books, pages or both to read in a calendar year (UTC)
+type ReadingGoal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Year        int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	TargetBooks int32                  `protobuf:"varint,2,opt,name=target_books,json=targetBooks,proto3" json:"target_books,omitempty"` // 0 when the goal is pages only
+	TargetPages int32                  `protobuf:"varint,3,opt,name=target_pages,json=targetPages,proto3" json:"target_pages,omitempty"` // 0 when the goal is books only
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
-func (x *UnfollowUserResponse) Reset() {
-	*x = UnfollowUserResponse{}
+func (x *ReadingGoal) Reset() {
+	*x = ReadingGoal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_betterreads_proto_msgTypes[63]
+		mi := &file_betterreads_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnfollowUserResponse) String() string {
+func (x *ReadingGoal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowUserResponse) ProtoMessage() {}
+func (*ReadingGoal) ProtoMessage() {}
 
-func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_betterreads_proto_msgTypes[63]
+func (x *ReadingGoal) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingGoal.ProtoReflect.Descriptor instead.
+func (*ReadingGoal) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ReadingGoal) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ReadingGoal) GetTargetBooks() int32 {
+	if x != nil {
+		return x.TargetBooks
+	}
+	return 0
+}
+
+func (x *ReadingGoal) GetTargetPages() int32 {
+	if x != nil {
+		return x.TargetPages
+	}
+	return 0
+}
+
+func (x *ReadingGoal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReadingGoal) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// A finished read counted towards a goal
+type ReadingGoalBook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book       *LibraryBook           `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`
+	FinishedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	Pages      *int32                 `protobuf:"varint,3,opt,name=pages,proto3,oneof" json:"pages,omitempty"` // Unset when the page count is unknown
+}
+
+func (x *ReadingGoalBook) Reset() {
+	*x = ReadingGoalBook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadingGoalBook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingGoalBook) ProtoMessage() {}
+
+func (x *ReadingGoalBook) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
-func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
-	return file_betterreads_proto_rawDescGZIP(), []int{63}
-}
-
-var File_betterreads_proto protoreflect.FileDescriptor
-
-var file_betterreads_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
-	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
-	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x54, 0x0a, 0x12, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xbf, 0x02, 0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69,
-	0x73, 0x62, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x12,
-	0x25, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x79, 0x65, 0x61,
-	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x65, 0x64, 0x59, 0x65, 0x61, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d,
-	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x2f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x42, 0x6f, 0x6f, 0x6b,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75,
-	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55,
-	0x72, 0x6c, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74,
-	0x69, 0x6e, 0x67, 0x22, 0x98, 0x02, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
-	0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62,
-	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6c, 0x69, 0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
-	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23,
-	0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xa0,
-	0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a,
-	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x22, 0xba, 0x01, 0x0a, 0x05, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb0,
-	0x03, 0x0a, 0x0b, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x1f,
-	0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b,
-	0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f,
-	0x6f, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
-	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x65, 0x6c,
-	0x66, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x65,
-	0x6c, 0x66, 0x49, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65,
-	0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x08,
-	0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x61, 0x64, 0x64, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x41,
-	0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
-	0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x6a, 0x0a, 0x0e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x57, 0x69, 0x74, 0x68, 0x42, 0x6f,
-	0x6f, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
-	0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x2e, 0x0a,
-	0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62,
-	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61,
-	0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x9c, 0x01,
-	0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7f, 0x0a, 0x13,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x3f, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x0a,
-	0x1a, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x46, 0x65, 0x65, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
-	0x65, 0x61, 0x64, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73,
-	0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
-	0x64, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x57, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7f, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a, 0x18, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64,
-	0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
-	0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcb, 0x02,
-	0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42,
-	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62,
-	0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f,
-	0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x49, 0x64, 0x73,
-	0x12, 0x2f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
-	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1a, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65,
-	0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x35, 0x0a, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x53,
-	0x68, 0x65, 0x6c, 0x66, 0x57, 0x69, 0x74, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x07, 0x73,
-	0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0f, 0x75, 0x6e, 0x73, 0x68, 0x65, 0x6c,
-	0x76, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4c, 0x69,
-	0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x0e, 0x75, 0x6e, 0x73, 0x68, 0x65,
-	0x6c, 0x76, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68,
-	0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73,
-	0x68, 0x65, 0x6c, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x05,
-	0x73, 0x68, 0x65, 0x6c, 0x66, 0x22, 0x43, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
-	0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73,
-	0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
-	0x68, 0x65, 0x6c, 0x66, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x13, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x53,
-	0x68, 0x65, 0x6c, 0x66, 0x52, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x22, 0x2f, 0x0a, 0x12, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x68,
-	0x65, 0x6c, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x53, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2c, 0x0a, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x53,
-	0x68, 0x65, 0x6c, 0x66, 0x52, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x22, 0x8c, 0x01,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x49,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x73,
-	0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x65, 0x74, 0x74,
-	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x53, 0x6f, 0x72,
-	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x88, 0x01, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65,
-	0x61, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52,
-	0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x42, 0x6f,
-	0x6f, 0x6b, 0x54, 0x6f, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x65,
-	0x6c, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x65,
-	0x6c, 0x66, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x54,
-	0x6f, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50,
-	0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x46, 0x72, 0x6f, 0x6d,
-	0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
-	0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x49, 0x64,
-	0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x46, 0x72,
-	0x6f, 0x6d, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x7d, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x62, 0x6f,
-	0x6f, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x3b,
-	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x11, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x96, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2c,
-	0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62,
-	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x3b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
-	0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62,
-	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
-	0x65, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22,
-	0x44, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
-	0x65, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
-	0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a,
-	0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69,
-	0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c,
-	0x0a, 0x11, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12,
-	0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b,
-	0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x47,
-	0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x02, 0x0a, 0x1d,
-	0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50,
-	0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x68, 0x6f,
-	0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x19, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72,
-	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
-	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72,
-	0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb4, 0x01, 0x0a,
-	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50,
-	0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f,
-	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72,
-	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
-	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72,
-	0x6c, 0x22, 0x2c, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x14, 0x0a, 0x12, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x13, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x7d, 0x0a,
-	0x0a, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x42,
-	0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b,
-	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x5f, 0x42,
-	0x4f, 0x4f, 0x4b, 0x53, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x53,
-	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x4c, 0x49, 0x42, 0x52, 0x41,
-	0x52, 0x59, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x4f, 0x55,
-	0x52, 0x43, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x03, 0x2a, 0xa8, 0x01, 0x0a,
-	0x0d, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e,
-	0x0a, 0x1a, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17,
-	0x0a, 0x13, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x41, 0x44, 0x49,
-	0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x41, 0x4e, 0x54, 0x5f, 0x54,
-	0x4f, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x41, 0x44,
-	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x49,
-	0x4e, 0x47, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x49, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
-	0x49, 0x4e, 0x49, 0x53, 0x48, 0x10, 0x04, 0x2a, 0xaf, 0x01, 0x0a, 0x0a, 0x42, 0x6f, 0x6f, 0x6b,
-	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x52,
-	0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
-	0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x52, 0x41, 0x54, 0x49,
-	0x4e, 0x47, 0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x10, 0x01, 0x12, 0x18, 0x0a,
-	0x14, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x57, 0x4f,
-	0x5f, 0x53, 0x54, 0x41, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x4f, 0x4f, 0x4b, 0x5f,
-	0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x45, 0x5f, 0x53, 0x54, 0x41,
-	0x52, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x52, 0x41, 0x54, 0x49,
-	0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x55, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x10, 0x04, 0x12, 0x19,
-	0x0a, 0x15, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x49,
-	0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x10, 0x05, 0x2a, 0x81, 0x02, 0x0a, 0x0e, 0x53, 0x68,
-	0x65, 0x6c, 0x66, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x1c,
-	0x53, 0x48, 0x45, 0x4c, 0x46, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
-	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e,
-	0x0a, 0x1a, 0x53, 0x48, 0x45, 0x4c, 0x46, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44,
-	0x45, 0x52, 0x5f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x1f,
-	0x0a, 0x1b, 0x53, 0x48, 0x45, 0x4c, 0x46, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44,
-	0x45, 0x52, 0x5f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x12,
-	0x1f, 0x0a, 0x1b, 0x53, 0x48, 0x45, 0x4c, 0x46, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52,
-	0x44, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x03,
-	0x12, 0x20, 0x0a, 0x1c, 0x53, 0x48, 0x45, 0x4c, 0x46, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f,
-	0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x5f, 0x44, 0x45, 0x53, 0x43,
-	0x10, 0x04, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x48, 0x45, 0x4c, 0x46, 0x5f, 0x53, 0x4f, 0x52, 0x54,
-	0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45,
-	0x44, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x48, 0x45, 0x4c, 0x46,
-	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x45,
-	0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x06, 0x32, 0xc7, 0x1b,
-	0x0a, 0x12, 0x42, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x73, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6f,
-	0x6f, 0x6b, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
-	0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
-	0x64, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x7e, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x46, 0x65, 0x65, 0x64, 0x12, 0x27, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
-	0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x64, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
-	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x46, 0x65, 0x65, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12,
-	0x0c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x12, 0x70, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x12, 0x1f, 0x2e, 0x62,
-	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12,
-	0x7b, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
-	0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
-	0x64, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
-	0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x65,
-	0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a, 0x0f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x7e, 0x0a, 0x11,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f,
-	0x6b, 0x12, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
-	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62,
-	0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x1a, 0x0f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x7c, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x22,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12,
-	0x19, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
-	0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6c, 0x0a, 0x0b, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74,
-	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68,
-	0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
-	0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x12, 0x77, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
-	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c,
-	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
-	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65,
-	0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x32, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64,
-	0x7d, 0x12, 0x74, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66,
-	0x12, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x20, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x68,
-	0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x53, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74,
-	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53,
-	0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
-	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x53, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x68, 0x65,
-	0x6c, 0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
-	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x42, 0x6f,
-	0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x65, 0x6c,
-	0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x0e, 0x41, 0x64, 0x64,
-	0x42, 0x6f, 0x6f, 0x6b, 0x54, 0x6f, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x22, 0x2e, 0x62, 0x65,
-	0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f,
-	0x6b, 0x54, 0x6f, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64,
-	0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x54, 0x6f, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x22, 0x32, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x62, 0x6f,
-	0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x68,
-	0x65, 0x6c, 0x76, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x7d,
-	0x12, 0xa4, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x46,
-	0x72, 0x6f, 0x6d, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x27, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
-	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f,
-	0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68,
-	0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x34, 0x2a, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62,
-	0x72, 0x61, 0x72, 0x79, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x68,
-	0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x67, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65,
-	0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65,
-	0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01,
-	0x2a, 0x22, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73,
-	0x12, 0x6e, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d,
-	0x12, 0x71, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x1a, 0x17, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x5f,
-	0x69, 0x64, 0x7d, 0x12, 0x8f, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73,
-	0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x7a, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22,
-	0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b,
-	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x8d, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x21, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
-	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
-	0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2f, 0x2a, 0x2d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
-	0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x7d, 0x12, 0x6d, 0x0a, 0x08, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x2e,
-	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x6b, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x65,
-	0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x1e, 0x22, 0x1c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74,
-	0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x69, 0x6b, 0x65,
-	0x12, 0x75, 0x0a, 0x0a, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x6e, 0x6c,
-	0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x6e, 0x6c,
-	0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x2a, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x75, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x7b, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x62,
-	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
-	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x11, 0x2a, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x29,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x65, 0x74, 0x74,
-	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x7e,
-	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
-	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x32, 0x0f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x7e,
-	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
-	0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x71,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x2e,
-	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x7d, 0x12, 0x75, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x1e, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x7d, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
-	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x65, 0x74,
-	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x2a, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x75,
-	0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x97, 0x01, 0x92, 0x41, 0x53, 0x12, 0x29, 0x0a,
-	0x0f, 0x42, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x73, 0x20, 0x41, 0x50, 0x49,
-	0x12, 0x0f, 0x42, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x73, 0x20, 0x41, 0x50,
-	0x49, 0x32, 0x05, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10,
-	0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
-	0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x65, 0x6c,
-	0x65, 0x73, 0x74, 0x69, 0x61, 0x6c, 0x64, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x66, 0x6c, 0x79, 0x2f,
-	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
-	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_betterreads_proto_rawDescOnce sync.Once
-	file_betterreads_proto_rawDescData = file_betterreads_proto_rawDesc
-)
-
-func file_betterreads_proto_rawDescGZIP() []byte {
-	file_betterreads_proto_rawDescOnce.Do(func() {
-		file_betterreads_proto_rawDescData = protoimpl.X.CompressGZIP(file_betterreads_proto_rawDescData)
-	})
-	return file_betterreads_proto_rawDescData
-}
-
-var file_betterreads_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_betterreads_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
-var file_betterreads_proto_goTypes = []interface{}{
-	(BookSource)(0),                       // 0: betterreads.BookSource
-	(ReadingStatus)(0),                    // 1: betterreads.ReadingStatus
-	(BookRating)(0),                       // 2: betterreads.BookRating
-	(ShelfSortOrder)(0),                   // 3: betterreads.ShelfSortOrder
-	(*PaginationMetadata)(nil),            // 4: betterreads.PaginationMetadata
-	(*Book)(nil),                          // 5: betterreads.Book
-	(*BookDetails)(nil),                   // 6: betterreads.BookDetails
-	(*Post)(nil),                          // 7: betterreads.Post
-	(*Comment)(nil),                       // 8: betterreads.Comment
-	(*Shelf)(nil),                         // 9: betterreads.Shelf
-	(*LibraryBook)(nil),                   // 10: betterreads.LibraryBook
-	(*ShelfWithBooks)(nil),                // 11: betterreads.ShelfWithBooks
-	(*SearchBooksRequest)(nil),            // 12: betterreads.SearchBooksRequest
-	(*SearchBooksResponse)(nil),           // 13: betterreads.SearchBooksResponse
-	(*GetPersonalizedFeedRequest)(nil),    // 14: betterreads.GetPersonalizedFeedRequest
-	(*GetPersonalizedFeedResponse)(nil),   // 15: betterreads.GetPersonalizedFeedResponse
-	(*GetUserFeedRequest)(nil),            // 16: betterreads.GetUserFeedRequest
-	(*GetUserFeedResponse)(nil),           // 17: betterreads.GetUserFeedResponse
-	(*RemoveLibraryBookRequest)(nil),      // 18: betterreads.RemoveLibraryBookRequest
-	(*RemoveLibraryBookResponse)(nil),     // 19: betterreads.RemoveLibraryBookResponse
-	(*UpdateLibraryBookRequest)(nil),      // 20: betterreads.UpdateLibraryBookRequest
-	(*UpdateLibraryBookResponse)(nil),     // 21: betterreads.UpdateLibraryBookResponse
-	(*GetUserLibraryRequest)(nil),         // 22: betterreads.GetUserLibraryRequest
-	(*GetUserLibraryResponse)(nil),        // 23: betterreads.GetUserLibraryResponse
-	(*CreateShelfRequest)(nil),            // 24: betterreads.CreateShelfRequest
-	(*CreateShelfResponse)(nil),           // 25: betterreads.CreateShelfResponse
-	(*UpdateShelfRequest)(nil),            // 26: betterreads.UpdateShelfRequest
-	(*UpdateShelfResponse)(nil),           // 27: betterreads.UpdateShelfResponse
-	(*DeleteShelfRequest)(nil),            // 28: betterreads.DeleteShelfRequest
-	(*DeleteShelfResponse)(nil),           // 29: betterreads.DeleteShelfResponse
-	(*GetUserShelvesRequest)(nil),         // 30: betterreads.GetUserShelvesRequest
-	(*GetUserShelvesResponse)(nil),        // 31: betterreads.GetUserShelvesResponse
-	(*GetShelfBooksRequest)(nil),          // 32: betterreads.GetShelfBooksRequest
-	(*GetShelfBooksResponse)(nil),         // 33: betterreads.GetShelfBooksResponse
-	(*AddBookToShelfRequest)(nil),         // 34: betterreads.AddBookToShelfRequest
-	(*AddBookToShelfResponse)(nil),        // 35: betterreads.AddBookToShelfResponse
-	(*RemoveBookFromShelfRequest)(nil),    // 36: betterreads.RemoveBookFromShelfRequest
-	(*RemoveBookFromShelfResponse)(nil),   // 37: betterreads.RemoveBookFromShelfResponse
-	(*CreatePostRequest)(nil),             // 38: betterreads.CreatePostRequest
-	(*CreatePostResponse)(nil),            // 39: betterreads.CreatePostResponse
-	(*DeletePostRequest)(nil),             // 40: betterreads.DeletePostRequest
-	(*DeletePostResponse)(nil),            // 41: betterreads.DeletePostResponse
-	(*UpdatePostRequest)(nil),             // 42: betterreads.UpdatePostRequest
-	(*UpdatePostResponse)(nil),            // 43: betterreads.UpdatePostResponse
-	(*GetCommentsForPostRequest)(nil),     // 44: betterreads.GetCommentsForPostRequest
-	(*GetCommentsForPostResponse)(nil),    // 45: betterreads.GetCommentsForPostResponse
-	(*AddCommentRequest)(nil),             // 46: betterreads.AddCommentRequest
-	(*AddCommentResponse)(nil),            // 47: betterreads.AddCommentResponse
-	(*DeleteCommentRequest)(nil),          // 48: betterreads.DeleteCommentRequest
-	(*DeleteCommentResponse)(nil),         // 49: betterreads.DeleteCommentResponse
-	(*LikePostRequest)(nil),               // 50: betterreads.LikePostRequest
-	(*LikePostResponse)(nil),              // 51: betterreads.LikePostResponse
-	(*UnlikePostRequest)(nil),             // 52: betterreads.UnlikePostRequest
-	(*UnlikePostResponse)(nil),            // 53: betterreads.UnlikePostResponse
-	(*DeleteUserProfileRequest)(nil),      // 54: betterreads.DeleteUserProfileRequest
-	(*DeleteUserProfileResponse)(nil),     // 55: betterreads.DeleteUserProfileResponse
-	(*GetCurrentUserProfileRequest)(nil),  // 56: betterreads.GetCurrentUserProfileRequest
-	(*GetCurrentUserProfileResponse)(nil), // 57: betterreads.GetCurrentUserProfileResponse
-	(*UpdateUserProfileRequest)(nil),      // 58: betterreads.UpdateUserProfileRequest
-	(*UpdateUserProfileResponse)(nil),     // 59: betterreads.UpdateUserProfileResponse
-	(*CreateUserProfileRequest)(nil),      // 60: betterreads.CreateUserProfileRequest
-	(*CreateUserProfileResponse)(nil),     // 61: betterreads.CreateUserProfileResponse
-	(*GetUserByIdRequest)(nil),            // 62: betterreads.GetUserByIdRequest
-	(*GetUserByIdResponse)(nil),           // 63: betterreads.GetUserByIdResponse
-	(*FollowUserRequest)(nil),             // 64: betterreads.FollowUserRequest
-	(*FollowUserResponse)(nil),            // 65: betterreads.FollowUserResponse
-	(*UnfollowUserRequest)(nil),           // 66: betterreads.UnfollowUserRequest
-	(*UnfollowUserResponse)(nil),          // 67: betterreads.UnfollowUserResponse
-	(*timestamppb.Timestamp)(nil),         // 68: google.protobuf.Timestamp
-}
-var file_betterreads_proto_depIdxs = []int32{
-	0,  // 0: betterreads.Book.source:type_name -> betterreads.BookSource
-	2,  // 1: betterreads.BookDetails.rating:type_name -> betterreads.BookRating
-	6,  // 2: betterreads.Post.book:type_name -> betterreads.BookDetails
-	68, // 3: betterreads.Post.created_at:type_name -> google.protobuf.Timestamp
-	68, // 4: betterreads.Comment.created_at:type_name -> google.protobuf.Timestamp
-	68, // 5: betterreads.Shelf.created_at:type_name -> google.protobuf.Timestamp
-	68, // 6: betterreads.Shelf.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 7: betterreads.LibraryBook.rating:type_name -> betterreads.BookRating
-	0,  // 8: betterreads.LibraryBook.source:type_name -> betterreads.BookSource
-	68, // 9: betterreads.LibraryBook.added_at:type_name -> google.protobuf.Timestamp
-	68, // 10: betterreads.LibraryBook.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 11: betterreads.LibraryBook.reading_status:type_name -> betterreads.ReadingStatus
-	9,  // 12: betterreads.ShelfWithBooks.shelf:type_name -> betterreads.Shelf
-	10, // 13: betterreads.ShelfWithBooks.books:type_name -> betterreads.LibraryBook
-	5,  // 14: betterreads.SearchBooksResponse.books:type_name -> betterreads.Book
-	4,  // 15: betterreads.SearchBooksResponse.pagination:type_name -> betterreads.PaginationMetadata
-	68, // 16: betterreads.GetPersonalizedFeedRequest.since:type_name -> google.protobuf.Timestamp
-	7,  // 17: betterreads.GetPersonalizedFeedResponse.posts:type_name -> betterreads.Post
-	4,  // 18: betterreads.GetPersonalizedFeedResponse.pagination:type_name -> betterreads.PaginationMetadata
-	7,  // 19: betterreads.GetUserFeedResponse.posts:type_name -> betterreads.Post
-	4,  // 20: betterreads.GetUserFeedResponse.pagination:type_name -> betterreads.PaginationMetadata
-	0,  // 21: betterreads.UpdateLibraryBookRequest.source:type_name -> betterreads.BookSource
-	2,  // 22: betterreads.UpdateLibraryBookRequest.rating:type_name -> betterreads.BookRating
-	1,  // 23: betterreads.UpdateLibraryBookRequest.reading_status:type_name -> betterreads.ReadingStatus
-	11, // 24: betterreads.GetUserLibraryResponse.shelves:type_name -> betterreads.ShelfWithBooks
-	10, // 25: betterreads.GetUserLibraryResponse.unshelved_books:type_name -> betterreads.LibraryBook
-	4,  // 26: betterreads.GetUserLibraryResponse.pagination:type_name -> betterreads.PaginationMetadata
-	9,  // 27: betterreads.CreateShelfResponse.shelf:type_name -> betterreads.Shelf
-	9,  // 28: betterreads.UpdateShelfResponse.shelf:type_name -> betterreads.Shelf
-	9,  // 29: betterreads.GetUserShelvesResponse.shelves:type_name -> betterreads.Shelf
-	3,  // 30: betterreads.GetShelfBooksRequest.sort:type_name -> betterreads.ShelfSortOrder
-	10, // 31: betterreads.GetShelfBooksResponse.books:type_name -> betterreads.LibraryBook
-	4,  // 32: betterreads.GetShelfBooksResponse.pagination:type_name -> betterreads.PaginationMetadata
-	6,  // 33: betterreads.CreatePostRequest.book:type_name -> betterreads.BookDetails
-	7,  // 34: betterreads.CreatePostResponse.post:type_name -> betterreads.Post
-	6,  // 35: betterreads.UpdatePostRequest.book:type_name -> betterreads.BookDetails
-	7,  // 36: betterreads.UpdatePostResponse.post:type_name -> betterreads.Post
-	8,  // 37: betterreads.GetCommentsForPostResponse.comments:type_name -> betterreads.Comment
-	4,  // 38: betterreads.GetCommentsForPostResponse.pagination:type_name -> betterreads.PaginationMetadata
-	8,  // 39: betterreads.AddCommentResponse.comment:type_name -> betterreads.Comment
-	68, // 40: betterreads.GetCurrentUserProfileResponse.created_at:type_name -> google.protobuf.Timestamp
-	68, // 41: betterreads.UpdateUserProfileResponse.created_at:type_name -> google.protobuf.Timestamp
-	68, // 42: betterreads.CreateUserProfileResponse.created_at:type_name -> google.protobuf.Timestamp
-	12, // 43: betterreads.BetterReadsService.SearchBooks:input_type -> betterreads.SearchBooksRequest
-	14, // 44: betterreads.BetterReadsService.GetPersonalizedFeed:input_type -> betterreads.GetPersonalizedFeedRequest
-	16, // 45: betterreads.BetterReadsService.GetUserFeed:input_type -> betterreads.GetUserFeedRequest
-	18, // 46: betterreads.BetterReadsService.RemoveLibraryBook:input_type -> betterreads.RemoveLibraryBookRequest
-	20, // 47: betterreads.BetterReadsService.UpdateLibraryBook:input_type -> betterreads.UpdateLibraryBookRequest
-	22, // 48: betterreads.BetterReadsService.GetUserLibrary:input_type -> betterreads.GetUserLibraryRequest
-	24, // 49: betterreads.BetterReadsService.CreateShelf:input_type -> betterreads.CreateShelfRequest
-	26, // 50: betterreads.BetterReadsService.UpdateShelf:input_type -> betterreads.UpdateShelfRequest
-	28, // 51: betterreads.BetterReadsService.DeleteShelf:input_type -> betterreads.DeleteShelfRequest
-	30, // 52: betterreads.BetterReadsService.GetUserShelves:input_type -> betterreads.GetUserShelvesRequest
-	32, // 53: betterreads.BetterReadsService.GetShelfBooks:input_type -> betterreads.GetShelfBooksRequest
-	34, // 54: betterreads.BetterReadsService.AddBookToShelf:input_type -> betterreads.AddBookToShelfRequest
-	36, // 55: betterreads.BetterReadsService.RemoveBookFromShelf:input_type -> betterreads.RemoveBookFromShelfRequest
-	38, // 56: betterreads.BetterReadsService.CreatePost:input_type -> betterreads.CreatePostRequest
-	40, // 57: betterreads.BetterReadsService.DeletePost:input_type -> betterreads.DeletePostRequest
-	42, // 58: betterreads.BetterReadsService.UpdatePost:input_type -> betterreads.UpdatePostRequest
-	44, // 59: betterreads.BetterReadsService.GetCommentsForPost:input_type -> betterreads.GetCommentsForPostRequest
-	46, // 60: betterreads.BetterReadsService.AddComment:input_type -> betterreads.AddCommentRequest
-	48, // 61: betterreads.BetterReadsService.DeleteComment:input_type -> betterreads.DeleteCommentRequest
-	50, // 62: betterreads.BetterReadsService.LikePost:input_type -> betterreads.LikePostRequest
-	52, // 63: betterreads.BetterReadsService.UnlikePost:input_type -> betterreads.UnlikePostRequest
-	54, // 64: betterreads.BetterReadsService.DeleteUserProfile:input_type -> betterreads.DeleteUserProfileRequest
-	56, // 65: betterreads.BetterReadsService.GetCurrentUserProfile:input_type -> betterreads.GetCurrentUserProfileRequest
-	58, // 66: betterreads.BetterReadsService.UpdateUserProfile:input_type -> betterreads.UpdateUserProfileRequest
-	60, // 67: betterreads.BetterReadsService.CreateUserProfile:input_type -> betterreads.CreateUserProfileRequest
-	62, // 68: betterreads.BetterReadsService.GetUserById:input_type -> betterreads.GetUserByIdRequest
-	64, // 69: betterreads.BetterReadsService.FollowUser:input_type -> betterreads.FollowUserRequest
-	66, // 70: betterreads.BetterReadsService.UnfollowUser:input_type -> betterreads.UnfollowUserRequest
-	13, // 71: betterreads.BetterReadsService.SearchBooks:output_type -> betterreads.SearchBooksResponse
-	15, // 72: betterreads.BetterReadsService.GetPersonalizedFeed:output_type -> betterreads.GetPersonalizedFeedResponse
-	17, // 73: betterreads.BetterReadsService.GetUserFeed:output_type -> betterreads.GetUserFeedResponse
-	19, // 74: betterreads.BetterReadsService.RemoveLibraryBook:output_type -> betterreads.RemoveLibraryBookResponse
-	21, // 75: betterreads.BetterReadsService.UpdateLibraryBook:output_type -> betterreads.UpdateLibraryBookResponse
-	23, // 76: betterreads.BetterReadsService.GetUserLibrary:output_type -> betterreads.GetUserLibraryResponse
-	25, // 77: betterreads.BetterReadsService.CreateShelf:output_type -> betterreads.CreateShelfResponse
-	27, // 78: betterreads.BetterReadsService.UpdateShelf:output_type -> betterreads.UpdateShelfResponse
-	29, // 79: betterreads.BetterReadsService.DeleteShelf:output_type -> betterreads.DeleteShelfResponse
-	31, // 80: betterreads.BetterReadsService.GetUserShelves:output_type -> betterreads.GetUserShelvesResponse
-	33, // 81: betterreads.BetterReadsService.GetShelfBooks:output_type -> betterreads.GetShelfBooksResponse
-	35, // 82: betterreads.BetterReadsService.AddBookToShelf:output_type -> betterreads.AddBookToShelfResponse
-	37, // 83: betterreads.BetterReadsService.RemoveBookFromShelf:output_type -> betterreads.RemoveBookFromShelfResponse
-	39, // 84: betterreads.BetterReadsService.CreatePost:output_type -> betterreads.CreatePostResponse
-	41, // 85: betterreads.BetterReadsService.DeletePost:output_type -> betterreads.DeletePostResponse
-	43, // 86: betterreads.BetterReadsService.UpdatePost:output_type -> betterreads.UpdatePostResponse
-	45, // 87: betterreads.BetterReadsService.GetCommentsForPost:output_type -> betterreads.GetCommentsForPostResponse
-	47, // 88: betterreads.BetterReadsService.AddComment:output_type -> betterreads.AddCommentResponse
-	49, // 89: betterreads.BetterReadsService.DeleteComment:output_type -> betterreads.DeleteCommentResponse
-	51, // 90: betterreads.BetterReadsService.LikePost:output_type -> betterreads.LikePostResponse
-	53, // 91: betterreads.BetterReadsService.UnlikePost:output_type -> betterreads.UnlikePostResponse
-	55, // 92: betterreads.BetterReadsService.DeleteUserProfile:output_type -> betterreads.DeleteUserProfileResponse
-	57, // 93: betterreads.BetterReadsService.GetCurrentUserProfile:output_type -> betterreads.GetCurrentUserProfileResponse
-	59, // 94: betterreads.BetterReadsService.UpdateUserProfile:output_type -> betterreads.UpdateUserProfileResponse
-	61, // 95: betterreads.BetterReadsService.CreateUserProfile:output_type -> betterreads.CreateUserProfileResponse
-	63, // 96: betterreads.BetterReadsService.GetUserById:output_type -> betterreads.GetUserByIdResponse
-	65, // 97: betterreads.BetterReadsService.FollowUser:output_type -> betterreads.FollowUserResponse
-	67, // 98: betterreads.BetterReadsService.UnfollowUser:output_type -> betterreads.UnfollowUserResponse
-	71, // [71:99] is the sub-list for method output_type
-	43, // [43:71] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
-}
-
-func init() { file_betterreads_proto_init() }
-func file_betterreads_proto_init() {
-	if File_betterreads_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_betterreads_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaginationMetadata); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingGoalBook.ProtoReflect.Descriptor instead.
+func (*ReadingGoalBook) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ReadingGoalBook) GetBook() *LibraryBook {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+func (x *ReadingGoalBook) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *ReadingGoalBook) GetPages() int32 {
+	if x != nil && x.Pages != nil {
+		return *x.Pages
+	}
+	return 0
+}
+
+type ReadingGoalProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BooksRead         int32              `protobuf:"varint,1,opt,name=books_read,json=booksRead,proto3" json:"books_read,omitempty"`
+	PagesRead         int32              `protobuf:"varint,2,opt,name=pages_read,json=pagesRead,proto3" json:"pages_read,omitempty"`
+	Percent           float64            `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"`                                 // Of the books target, or the pages target for a pages-only goal; may exceed 100
+	ExpectedBooks     int32              `protobuf:"varint,4,opt,name=expected_books,json=expectedBooks,proto3" json:"expected_books,omitempty"` // Books that should be read by now to stay on pace
+	ExpectedPages     int32              `protobuf:"varint,5,opt,name=expected_pages,json=expectedPages,proto3" json:"expected_pages,omitempty"`
+	BooksAhead        int32              `protobuf:"varint,6,opt,name=books_ahead,json=booksAhead,proto3" json:"books_ahead,omitempty"` // Negative when behind pace
+	PagesAhead        int32              `protobuf:"varint,7,opt,name=pages_ahead,json=pagesAhead,proto3" json:"pages_ahead,omitempty"`
+	Pace              GoalPace           `protobuf:"varint,8,opt,name=pace,proto3,enum=betterreads.GoalPace" json:"pace,omitempty"`
+	Books             []*ReadingGoalBook `protobuf:"bytes,9,rep,name=books,proto3" json:"books,omitempty"`                                                      // Most recently finished first
+	BooksWithoutPages int32              `protobuf:"varint,10,opt,name=books_without_pages,json=booksWithoutPages,proto3" json:"books_without_pages,omitempty"` // Books in books_read whose page count is unknown, so pages_read leaves them out
+}
+
+func (x *ReadingGoalProgress) Reset() {
+	*x = ReadingGoalProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadingGoalProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingGoalProgress) ProtoMessage() {}
+
+func (x *ReadingGoalProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingGoalProgress.ProtoReflect.Descriptor instead.
+func (*ReadingGoalProgress) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ReadingGoalProgress) GetBooksRead() int32 {
+	if x != nil {
+		return x.BooksRead
+	}
+	return 0
+}
+
+func (x *ReadingGoalProgress) GetPagesRead() int32 {
+	if x != nil {
+		return x.PagesRead
+	}
+	return 0
+}
+
+func (x *ReadingGoalProgress) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *ReadingGoalProgress) GetExpectedBooks() int32 {
+	if x != nil {
+		return x.ExpectedBooks
+	}
+	return 0
+}
+
+func (x *ReadingGoalProgress) GetExpectedPages() int32 {
+	if x != nil {
+		return x.ExpectedPages
+	}
+	return 0
+}
+
+func (x *ReadingGoalProgress) GetBooksAhead() int32 {
+	if x != nil {
+		return x.BooksAhead
+	}
+	return 0
+}
+
+func (x *ReadingGoalProgress) GetPagesAhead() int32 {
+	if x != nil {
+		return x.PagesAhead
+	}
+	return 0
+}
+
+func (x *ReadingGoalProgress) GetPace() GoalPace {
+	if x != nil {
+		return x.Pace
+	}
+	return GoalPace_GOAL_PACE_UNSPECIFIED
+}
+
+func (x *ReadingGoalProgress) GetBooks() []*ReadingGoalBook {
+	if x != nil {
+		return x.Books
+	}
+	return nil
+}
+
+func (x *ReadingGoalProgress) GetBooksWithoutPages() int32 {
+	if x != nil {
+		return x.BooksWithoutPages
+	}
+	return 0
+}
+
+// A quote saved from a book in the owner's library
+type Highlight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId     string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BookId     string                 `protobuf:"bytes,3,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	BookTitle  string                 `protobuf:"bytes,4,opt,name=book_title,json=bookTitle,proto3" json:"book_title,omitempty"`
+	AuthorName string                 `protobuf:"bytes,5,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	BookImage  string                 `protobuf:"bytes,6,opt,name=book_image,json=bookImage,proto3" json:"book_image,omitempty"`
+	Quote      string                 `protobuf:"bytes,7,opt,name=quote,proto3" json:"quote,omitempty"`
+	Page       int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`        // 0 when unknown
+	Location   string                 `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"` // Free-form position, such as a Kindle location range
+	Comment    string                 `protobuf:"bytes,10,opt,name=comment,proto3" json:"comment,omitempty"`
+	IsPublic   bool                   `protobuf:"varint,11,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"` // Public highlights can be listed by other users
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Highlight) Reset() {
+	*x = Highlight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Highlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Highlight) ProtoMessage() {}
+
+func (x *Highlight) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Highlight.ProtoReflect.Descriptor instead.
+func (*Highlight) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Highlight) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Highlight) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Highlight) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *Highlight) GetBookTitle() string {
+	if x != nil {
+		return x.BookTitle
+	}
+	return ""
+}
+
+func (x *Highlight) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Highlight) GetBookImage() string {
+	if x != nil {
+		return x.BookImage
+	}
+	return ""
+}
+
+func (x *Highlight) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *Highlight) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *Highlight) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Highlight) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Highlight) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+func (x *Highlight) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Highlight) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateHighlightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BookId   string `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"`
+	Quote    string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"` // At most 5000 characters
+	Page     int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Location string `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"` // At most 100 characters
+	Comment  string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`   // At most 10000 characters
+	IsPublic bool   `protobuf:"varint,6,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+}
+
+func (x *CreateHighlightRequest) Reset() {
+	*x = CreateHighlightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateHighlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHighlightRequest) ProtoMessage() {}
+
+func (x *CreateHighlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHighlightRequest.ProtoReflect.Descriptor instead.
+func (*CreateHighlightRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CreateHighlightRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *CreateHighlightRequest) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *CreateHighlightRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CreateHighlightRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateHighlightRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreateHighlightRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+type CreateHighlightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Highlight *Highlight `protobuf:"bytes,1,opt,name=highlight,proto3" json:"highlight,omitempty"`
+}
+
+func (x *CreateHighlightResponse) Reset() {
+	*x = CreateHighlightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateHighlightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHighlightResponse) ProtoMessage() {}
+
+func (x *CreateHighlightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHighlightResponse.ProtoReflect.Descriptor instead.
+func (*CreateHighlightResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateHighlightResponse) GetHighlight() *Highlight {
+	if x != nil {
+		return x.Highlight
+	}
+	return nil
+}
+
+type UpdateHighlightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HighlightId string `protobuf:"bytes,1,opt,name=highlight_id,json=highlightId,proto3" json:"highlight_id,omitempty"`
+	Quote       string `protobuf:"bytes,2,opt,name=quote,proto3" json:"quote,omitempty"`
+	Page        int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Location    string `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Comment     string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	IsPublic    bool   `protobuf:"varint,6,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
+}
+
+func (x *UpdateHighlightRequest) Reset() {
+	*x = UpdateHighlightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHighlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHighlightRequest) ProtoMessage() {}
+
+func (x *UpdateHighlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHighlightRequest.ProtoReflect.Descriptor instead.
+func (*UpdateHighlightRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateHighlightRequest) GetHighlightId() string {
+	if x != nil {
+		return x.HighlightId
+	}
+	return ""
+}
+
+func (x *UpdateHighlightRequest) GetQuote() string {
+	if x != nil {
+		return x.Quote
+	}
+	return ""
+}
+
+func (x *UpdateHighlightRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *UpdateHighlightRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateHighlightRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *UpdateHighlightRequest) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
+type UpdateHighlightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Highlight *Highlight `protobuf:"bytes,1,opt,name=highlight,proto3" json:"highlight,omitempty"`
+}
+
+func (x *UpdateHighlightResponse) Reset() {
+	*x = UpdateHighlightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateHighlightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateHighlightResponse) ProtoMessage() {}
+
+func (x *UpdateHighlightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateHighlightResponse.ProtoReflect.Descriptor instead.
+func (*UpdateHighlightResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateHighlightResponse) GetHighlight() *Highlight {
+	if x != nil {
+		return x.Highlight
+	}
+	return nil
+}
+
+type DeleteHighlightRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HighlightId string `protobuf:"bytes,1,opt,name=highlight_id,json=highlightId,proto3" json:"highlight_id,omitempty"`
+}
+
+func (x *DeleteHighlightRequest) Reset() {
+	*x = DeleteHighlightRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteHighlightRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHighlightRequest) ProtoMessage() {}
+
+func (x *DeleteHighlightRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHighlightRequest.ProtoReflect.Descriptor instead.
+func (*DeleteHighlightRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteHighlightRequest) GetHighlightId() string {
+	if x != nil {
+		return x.HighlightId
+	}
+	return ""
+}
+
+type DeleteHighlightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteHighlightResponse) Reset() {
+	*x = DeleteHighlightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteHighlightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteHighlightResponse) ProtoMessage() {}
+
+func (x *DeleteHighlightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteHighlightResponse.ProtoReflect.Descriptor instead.
+func (*DeleteHighlightResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{73}
+}
+
+type ListHighlightsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Optional: defaults to the caller
+	BookId    string `protobuf:"bytes,2,opt,name=book_id,json=bookId,proto3" json:"book_id,omitempty"` // Optional: only highlights from this book
+	Page      int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from a previous response; takes precedence over page
+}
+
+func (x *ListHighlightsRequest) Reset() {
+	*x = ListHighlightsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHighlightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHighlightsRequest) ProtoMessage() {}
+
+func (x *ListHighlightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHighlightsRequest.ProtoReflect.Descriptor instead.
+func (*ListHighlightsRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListHighlightsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListHighlightsRequest) GetBookId() string {
+	if x != nil {
+		return x.BookId
+	}
+	return ""
+}
+
+func (x *ListHighlightsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListHighlightsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListHighlightsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListHighlightsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Highlights []*Highlight        `protobuf:"bytes,1,rep,name=highlights,proto3" json:"highlights,omitempty"` // Newest first
+	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListHighlightsResponse) Reset() {
+	*x = ListHighlightsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListHighlightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListHighlightsResponse) ProtoMessage() {}
+
+func (x *ListHighlightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListHighlightsResponse.ProtoReflect.Descriptor instead.
+func (*ListHighlightsResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListHighlightsResponse) GetHighlights() []*Highlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+func (x *ListHighlightsResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ImportKindleHighlightsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File   []byte `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`                    // Contents of the Kindle's "My Clippings.txt"
+	DryRun bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // Report what would be imported without writing anything
+}
+
+func (x *ImportKindleHighlightsRequest) Reset() {
+	*x = ImportKindleHighlightsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportKindleHighlightsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportKindleHighlightsRequest) ProtoMessage() {}
+
+func (x *ImportKindleHighlightsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportKindleHighlightsRequest.ProtoReflect.Descriptor instead.
+func (*ImportKindleHighlightsRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ImportKindleHighlightsRequest) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ImportKindleHighlightsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// Each row is one highlight; notes are imported as the comment on the highlight they were made on
+type ImportKindleHighlightsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImportedCount int32              `protobuf:"varint,1,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"` // With dry_run, the highlights that would be imported
+	SkippedCount  int32              `protobuf:"varint,2,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	FailedCount   int32              `protobuf:"varint,3,opt,name=failed_count,json=failedCount,proto3" json:"failed_count,omitempty"`
+	Rows          []*ImportRowResult `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	DryRun        bool               `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *ImportKindleHighlightsResponse) Reset() {
+	*x = ImportKindleHighlightsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportKindleHighlightsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportKindleHighlightsResponse) ProtoMessage() {}
+
+func (x *ImportKindleHighlightsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportKindleHighlightsResponse.ProtoReflect.Descriptor instead.
+func (*ImportKindleHighlightsResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ImportKindleHighlightsResponse) GetImportedCount() int32 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportKindleHighlightsResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *ImportKindleHighlightsResponse) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *ImportKindleHighlightsResponse) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportKindleHighlightsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SetReadingGoalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year        int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	TargetBooks int32 `protobuf:"varint,2,opt,name=target_books,json=targetBooks,proto3" json:"target_books,omitempty"`
+	TargetPages int32 `protobuf:"varint,3,opt,name=target_pages,json=targetPages,proto3" json:"target_pages,omitempty"`
+}
+
+func (x *SetReadingGoalRequest) Reset() {
+	*x = SetReadingGoalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReadingGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadingGoalRequest) ProtoMessage() {}
+
+func (x *SetReadingGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadingGoalRequest.ProtoReflect.Descriptor instead.
+func (*SetReadingGoalRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SetReadingGoalRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SetReadingGoalRequest) GetTargetBooks() int32 {
+	if x != nil {
+		return x.TargetBooks
+	}
+	return 0
+}
+
+func (x *SetReadingGoalRequest) GetTargetPages() int32 {
+	if x != nil {
+		return x.TargetPages
+	}
+	return 0
+}
+
+type SetReadingGoalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Goal *ReadingGoal `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+}
+
+func (x *SetReadingGoalResponse) Reset() {
+	*x = SetReadingGoalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReadingGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadingGoalResponse) ProtoMessage() {}
+
+func (x *SetReadingGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadingGoalResponse.ProtoReflect.Descriptor instead.
+func (*SetReadingGoalResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SetReadingGoalResponse) GetGoal() *ReadingGoal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type GetReadingGoalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+}
+
+func (x *GetReadingGoalRequest) Reset() {
+	*x = GetReadingGoalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReadingGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingGoalRequest) ProtoMessage() {}
+
+func (x *GetReadingGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingGoalRequest.ProtoReflect.Descriptor instead.
+func (*GetReadingGoalRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetReadingGoalRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GetReadingGoalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Goal     *ReadingGoal         `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	Progress *ReadingGoalProgress `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
+}
+
+func (x *GetReadingGoalResponse) Reset() {
+	*x = GetReadingGoalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReadingGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingGoalResponse) ProtoMessage() {}
+
+func (x *GetReadingGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingGoalResponse.ProtoReflect.Descriptor instead.
+func (*GetReadingGoalResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetReadingGoalResponse) GetGoal() *ReadingGoal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+func (x *GetReadingGoalResponse) GetProgress() *ReadingGoalProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type DeleteReadingGoalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+}
+
+func (x *DeleteReadingGoalRequest) Reset() {
+	*x = DeleteReadingGoalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteReadingGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReadingGoalRequest) ProtoMessage() {}
+
+func (x *DeleteReadingGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReadingGoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReadingGoalRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DeleteReadingGoalRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type DeleteReadingGoalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteReadingGoalResponse) Reset() {
+	*x = DeleteReadingGoalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteReadingGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReadingGoalResponse) ProtoMessage() {}
+
+func (x *DeleteReadingGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReadingGoalResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReadingGoalResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{83}
+}
+
+type RatingCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rating BookRating `protobuf:"varint,1,opt,name=rating,proto3,enum=betterreads.BookRating" json:"rating,omitempty"` // BOOK_RATING_UNSPECIFIED counts unrated reads
+	Books  int32      `protobuf:"varint,2,opt,name=books,proto3" json:"books,omitempty"`
+}
+
+func (x *RatingCount) Reset() {
+	*x = RatingCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RatingCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingCount) ProtoMessage() {}
+
+func (x *RatingCount) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingCount.ProtoReflect.Descriptor instead.
+func (*RatingCount) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RatingCount) GetRating() BookRating {
+	if x != nil {
+		return x.Rating
+	}
+	return BookRating_BOOK_RATING_UNSPECIFIED
+}
+
+func (x *RatingCount) GetBooks() int32 {
+	if x != nil {
+		return x.Books
+	}
+	return 0
+}
+
+type AuthorCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorName string `protobuf:"bytes,1,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Books      int32  `protobuf:"varint,2,opt,name=books,proto3" json:"books,omitempty"`
+}
+
+func (x *AuthorCount) Reset() {
+	*x = AuthorCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorCount) ProtoMessage() {}
+
+func (x *AuthorCount) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorCount.ProtoReflect.Descriptor instead.
+func (*AuthorCount) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AuthorCount) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *AuthorCount) GetBooks() int32 {
+	if x != nil {
+		return x.Books
+	}
+	return 0
+}
+
+type MonthCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year  int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"` // 1 to 12
+	Books int32 `protobuf:"varint,3,opt,name=books,proto3" json:"books,omitempty"`
+	Pages int32 `protobuf:"varint,4,opt,name=pages,proto3" json:"pages,omitempty"`
+}
+
+func (x *MonthCount) Reset() {
+	*x = MonthCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonthCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthCount) ProtoMessage() {}
+
+func (x *MonthCount) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthCount.ProtoReflect.Descriptor instead.
+func (*MonthCount) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *MonthCount) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *MonthCount) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *MonthCount) GetBooks() int32 {
+	if x != nil {
+		return x.Books
+	}
+	return 0
+}
+
+func (x *MonthCount) GetPages() int32 {
+	if x != nil {
+		return x.Pages
+	}
+	return 0
+}
+
+// Reads are counted by the date they ended (UTC), so a book read twice counts twice
+type ReadingStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BooksRead           int32          `protobuf:"varint,1,opt,name=books_read,json=booksRead,proto3" json:"books_read,omitempty"`
+	PagesRead           int32          `protobuf:"varint,2,opt,name=pages_read,json=pagesRead,proto3" json:"pages_read,omitempty"` // Only books whose page count is known contribute
+	BooksNotFinished    int32          `protobuf:"varint,3,opt,name=books_not_finished,json=booksNotFinished,proto3" json:"books_not_finished,omitempty"`
+	DnfRate             float64        `protobuf:"fixed64,4,opt,name=dnf_rate,json=dnfRate,proto3" json:"dnf_rate,omitempty"`                                         // books_not_finished over all reads that ended, from 0 to 1
+	AverageDaysToFinish float64        `protobuf:"fixed64,5,opt,name=average_days_to_finish,json=averageDaysToFinish,proto3" json:"average_days_to_finish,omitempty"` // Over finished reads with a start date; 0 when there are none
+	Ratings             []*RatingCount `protobuf:"bytes,6,rep,name=ratings,proto3" json:"ratings,omitempty"`                                                          // One entry per BookRating, unrated first
+	TopAuthors          []*AuthorCount `protobuf:"bytes,7,rep,name=top_authors,json=topAuthors,proto3" json:"top_authors,omitempty"`                                  // Most read first
+	BooksPerMonth       []*MonthCount  `protobuf:"bytes,8,rep,name=books_per_month,json=booksPerMonth,proto3" json:"books_per_month,omitempty"`                       // Oldest first, including months with no reads
+}
+
+func (x *ReadingStats) Reset() {
+	*x = ReadingStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadingStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingStats) ProtoMessage() {}
+
+func (x *ReadingStats) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingStats.ProtoReflect.Descriptor instead.
+func (*ReadingStats) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ReadingStats) GetBooksRead() int32 {
+	if x != nil {
+		return x.BooksRead
+	}
+	return 0
+}
+
+func (x *ReadingStats) GetPagesRead() int32 {
+	if x != nil {
+		return x.PagesRead
+	}
+	return 0
+}
+
+func (x *ReadingStats) GetBooksNotFinished() int32 {
+	if x != nil {
+		return x.BooksNotFinished
+	}
+	return 0
+}
+
+func (x *ReadingStats) GetDnfRate() float64 {
+	if x != nil {
+		return x.DnfRate
+	}
+	return 0
+}
+
+func (x *ReadingStats) GetAverageDaysToFinish() float64 {
+	if x != nil {
+		return x.AverageDaysToFinish
+	}
+	return 0
+}
+
+func (x *ReadingStats) GetRatings() []*RatingCount {
+	if x != nil {
+		return x.Ratings
+	}
+	return nil
+}
+
+func (x *ReadingStats) GetTopAuthors() []*AuthorCount {
+	if x != nil {
+		return x.TopAuthors
+	}
+	return nil
+}
+
+func (x *ReadingStats) GetBooksPerMonth() []*MonthCount {
+	if x != nil {
+		return x.BooksPerMonth
+	}
+	return nil
+}
+
+type GetReadingStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // Optional: inclusive; unset for the start of the library
+	To   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`     // Optional: exclusive; unset for now
+}
+
+func (x *GetReadingStatsRequest) Reset() {
+	*x = GetReadingStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReadingStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingStatsRequest) ProtoMessage() {}
+
+func (x *GetReadingStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetReadingStatsRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetReadingStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetReadingStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetReadingStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats *ReadingStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetReadingStatsResponse) Reset() {
+	*x = GetReadingStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetReadingStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReadingStatsResponse) ProtoMessage() {}
+
+func (x *GetReadingStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReadingStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetReadingStatsResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetReadingStatsResponse) GetStats() *ReadingStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type CreatePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Book        *BookDetails `protobuf:"bytes,1,opt,name=book,proto3" json:"book,omitempty"`                                  // Optional when sharing a highlight: defaults to the highlight's book, and must be that book if set
+	Description string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                    // At most 20000 characters
+	Summary     string       `protobuf:"bytes,3,opt,name=summary,proto3" json:"summary,omitempty"`                            // At most 1000 characters
+	HighlightId string       `protobuf:"bytes,4,opt,name=highlight_id,json=highlightId,proto3" json:"highlight_id,omitempty"` // Optional: one of the caller's public highlights to share
+}
+
+func (x *CreatePostRequest) Reset() {
+	*x = CreatePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostRequest) ProtoMessage() {}
+
+func (x *CreatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostRequest.ProtoReflect.Descriptor instead.
+func (*CreatePostRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CreatePostRequest) GetBook() *BookDetails {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+func (x *CreatePostRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *CreatePostRequest) GetHighlightId() string {
+	if x != nil {
+		return x.HighlightId
+	}
+	return ""
+}
+
+type CreatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *CreatePostResponse) Reset() {
+	*x = CreatePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostResponse) ProtoMessage() {}
+
+func (x *CreatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostResponse.ProtoReflect.Descriptor instead.
+func (*CreatePostResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreatePostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type DeletePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *DeletePostRequest) Reset() {
+	*x = DeletePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostRequest) ProtoMessage() {}
+
+func (x *DeletePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostRequest.ProtoReflect.Descriptor instead.
+func (*DeletePostRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DeletePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type DeletePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePostResponse) Reset() {
+	*x = DeletePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResponse) ProtoMessage() {}
+
+func (x *DeletePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResponse.ProtoReflect.Descriptor instead.
+func (*DeletePostResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{93}
+}
+
+type UpdatePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId      string       `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"` // Path param
+	Book        *BookDetails `protobuf:"bytes,2,opt,name=book,proto3" json:"book,omitempty"`
+	Description string       `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // At most 20000 characters
+	Summary     string       `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`         // At most 1000 characters
+}
+
+func (x *UpdatePostRequest) Reset() {
+	*x = UpdatePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostRequest) ProtoMessage() {}
+
+func (x *UpdatePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePostRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *UpdatePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetBook() *BookDetails {
+	if x != nil {
+		return x.Book
+	}
+	return nil
+}
+
+func (x *UpdatePostRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdatePostRequest) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+type UpdatePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+}
+
+func (x *UpdatePostResponse) Reset() {
+	*x = UpdatePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostResponse) ProtoMessage() {}
+
+func (x *UpdatePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePostResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *UpdatePostResponse) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type GetCommentsForPostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId    string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Page      int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // next_page_token from a previous response; takes precedence over page
+}
+
+func (x *GetCommentsForPostRequest) Reset() {
+	*x = GetCommentsForPostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentsForPostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsForPostRequest) ProtoMessage() {}
+
+func (x *GetCommentsForPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsForPostRequest.ProtoReflect.Descriptor instead.
+func (*GetCommentsForPostRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetCommentsForPostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetCommentsForPostRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCommentsForPostRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCommentsForPostRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetCommentsForPostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comments   []*Comment          `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Pagination *PaginationMetadata `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetCommentsForPostResponse) Reset() {
+	*x = GetCommentsForPostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentsForPostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentsForPostResponse) ProtoMessage() {}
+
+func (x *GetCommentsForPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentsForPostResponse.ProtoReflect.Descriptor instead.
+func (*GetCommentsForPostResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetCommentsForPostResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetCommentsForPostResponse) GetPagination() *PaginationMetadata {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type AddCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId  string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"` // At most 5000 characters
+}
+
+func (x *AddCommentRequest) Reset() {
+	*x = AddCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentRequest) ProtoMessage() {}
+
+func (x *AddCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentRequest.ProtoReflect.Descriptor instead.
+func (*AddCommentRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AddCommentRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *AddCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type AddCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Comment *Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *AddCommentResponse) Reset() {
+	*x = AddCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCommentResponse) ProtoMessage() {}
+
+func (x *AddCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCommentResponse.ProtoReflect.Descriptor instead.
+func (*AddCommentResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AddCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId    string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CommentId string `protobuf:"bytes,2,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DeleteCommentRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *DeleteCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{101}
+}
+
+type LikePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *LikePostRequest) Reset() {
+	*x = LikePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostRequest) ProtoMessage() {}
+
+func (x *LikePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostRequest.ProtoReflect.Descriptor instead.
+func (*LikePostRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *LikePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type LikePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LikePostResponse) Reset() {
+	*x = LikePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostResponse) ProtoMessage() {}
+
+func (x *LikePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostResponse.ProtoReflect.Descriptor instead.
+func (*LikePostResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{103}
+}
+
+type UnlikePostRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostId string `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+}
+
+func (x *UnlikePostRequest) Reset() {
+	*x = UnlikePostRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlikePostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikePostRequest) ProtoMessage() {}
+
+func (x *UnlikePostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikePostRequest.ProtoReflect.Descriptor instead.
+func (*UnlikePostRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *UnlikePostRequest) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+type UnlikePostResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnlikePostResponse) Reset() {
+	*x = UnlikePostResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlikePostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikePostResponse) ProtoMessage() {}
+
+func (x *UnlikePostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikePostResponse.ProtoReflect.Descriptor instead.
+func (*UnlikePostResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{105}
+}
+
+type DeleteUserProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteUserProfileRequest) Reset() {
+	*x = DeleteUserProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserProfileRequest) ProtoMessage() {}
+
+func (x *DeleteUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{106}
+}
+
+type DeleteUserProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteUserProfileResponse) Reset() {
+	*x = DeleteUserProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserProfileResponse) ProtoMessage() {}
+
+func (x *DeleteUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{107}
+}
+
+type GetCurrentUserProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCurrentUserProfileRequest) Reset() {
+	*x = GetCurrentUserProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentUserProfileRequest) ProtoMessage() {}
+
+func (x *GetCurrentUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrentUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{108}
+}
+
+type GetCurrentUserProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username             string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email                string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName            string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName             string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	ProfilePhotoUrl      string                 `protobuf:"bytes,6,opt,name=profile_photo_url,json=profilePhotoUrl,proto3" json:"profile_photo_url,omitempty"` // URL to profile photo
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ActivityPostsEnabled bool                   `protobuf:"varint,8,opt,name=activity_posts_enabled,json=activityPostsEnabled,proto3" json:"activity_posts_enabled,omitempty"`
+}
+
+func (x *GetCurrentUserProfileResponse) Reset() {
+	*x = GetCurrentUserProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCurrentUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCurrentUserProfileResponse) ProtoMessage() {}
+
+func (x *GetCurrentUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCurrentUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrentUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetCurrentUserProfileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetCurrentUserProfileResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetCurrentUserProfileResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *GetCurrentUserProfileResponse) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *GetCurrentUserProfileResponse) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *GetCurrentUserProfileResponse) GetProfilePhotoUrl() string {
+	if x != nil {
+		return x.ProfilePhotoUrl
+	}
+	return ""
+}
+
+func (x *GetCurrentUserProfileResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *GetCurrentUserProfileResponse) GetActivityPostsEnabled() bool {
+	if x != nil {
+		return x.ActivityPostsEnabled
+	}
+	return false
+}
+
+type UpdateUserProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email           string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName       string `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName        string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	ProfilePhotoUrl string `protobuf:"bytes,4,opt,name=profile_photo_url,json=profilePhotoUrl,proto3" json:"profile_photo_url,omitempty"` // URL to profile photo
+	Username        string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *UpdateUserProfileRequest) Reset() {
+	*x = UpdateUserProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserProfileRequest) ProtoMessage() {}
+
+func (x *UpdateUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UpdateUserProfileRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileRequest) GetProfilePhotoUrl() string {
+	if x != nil {
+		return x.ProfilePhotoUrl
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UpdateUserProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username        string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName       string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName        string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	ProfilePhotoUrl string                 `protobuf:"bytes,6,opt,name=profile_photo_url,json=profilePhotoUrl,proto3" json:"profile_photo_url,omitempty"` // URL to profile photo
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *UpdateUserProfileResponse) Reset() {
+	*x = UpdateUserProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserProfileResponse) ProtoMessage() {}
+
+func (x *UpdateUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UpdateUserProfileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileResponse) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileResponse) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileResponse) GetProfilePhotoUrl() string {
+	if x != nil {
+		return x.ProfilePhotoUrl
+	}
+	return ""
+}
+
+func (x *UpdateUserProfileResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type UpdateActivitySettingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityPostsEnabled bool `protobuf:"varint,1,opt,name=activity_posts_enabled,json=activityPostsEnabled,proto3" json:"activity_posts_enabled,omitempty"`
+}
+
+func (x *UpdateActivitySettingsRequest) Reset() {
+	*x = UpdateActivitySettingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateActivitySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivitySettingsRequest) ProtoMessage() {}
+
+func (x *UpdateActivitySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivitySettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateActivitySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *UpdateActivitySettingsRequest) GetActivityPostsEnabled() bool {
+	if x != nil {
+		return x.ActivityPostsEnabled
+	}
+	return false
+}
+
+type UpdateActivitySettingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ActivityPostsEnabled bool `protobuf:"varint,1,opt,name=activity_posts_enabled,json=activityPostsEnabled,proto3" json:"activity_posts_enabled,omitempty"`
+}
+
+func (x *UpdateActivitySettingsResponse) Reset() {
+	*x = UpdateActivitySettingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateActivitySettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivitySettingsResponse) ProtoMessage() {}
+
+func (x *UpdateActivitySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivitySettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateActivitySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *UpdateActivitySettingsResponse) GetActivityPostsEnabled() bool {
+	if x != nil {
+		return x.ActivityPostsEnabled
+	}
+	return false
+}
+
+type CreateUserProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email           string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName       string `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName        string `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	ProfilePhotoUrl string `protobuf:"bytes,4,opt,name=profile_photo_url,json=profilePhotoUrl,proto3" json:"profile_photo_url,omitempty"` // URL to profile photo
+	Username        string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *CreateUserProfileRequest) Reset() {
+	*x = CreateUserProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserProfileRequest) ProtoMessage() {}
+
+func (x *CreateUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CreateUserProfileRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserProfileRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CreateUserProfileRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CreateUserProfileRequest) GetProfilePhotoUrl() string {
+	if x != nil {
+		return x.ProfilePhotoUrl
+	}
+	return ""
+}
+
+func (x *CreateUserProfileRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type CreateUserProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username        string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName       string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName        string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	ProfilePhotoUrl string                 `protobuf:"bytes,6,opt,name=profile_photo_url,json=profilePhotoUrl,proto3" json:"profile_photo_url,omitempty"` // URL to profile photo
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *CreateUserProfileResponse) Reset() {
+	*x = CreateUserProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserProfileResponse) ProtoMessage() {}
+
+func (x *CreateUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CreateUserProfileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateUserProfileResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateUserProfileResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserProfileResponse) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CreateUserProfileResponse) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CreateUserProfileResponse) GetProfilePhotoUrl() string {
+	if x != nil {
+		return x.ProfilePhotoUrl
+	}
+	return ""
+}
+
+func (x *CreateUserProfileResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetUserByIdRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *GetUserByIdRequest) Reset() {
+	*x = GetUserByIdRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByIdRequest) ProtoMessage() {}
+
+func (x *GetUserByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetUserByIdRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetUserByIdRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserByIdResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username        string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName       string `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName        string `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	ProfilePhotoUrl string `protobuf:"bytes,5,opt,name=profile_photo_url,json=profilePhotoUrl,proto3" json:"profile_photo_url,omitempty"` // URL to profile photo
+}
+
+func (x *GetUserByIdResponse) Reset() {
+	*x = GetUserByIdResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserByIdResponse) ProtoMessage() {}
+
+func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetUserByIdResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetUserByIdResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetUserByIdResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetUserByIdResponse) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *GetUserByIdResponse) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *GetUserByIdResponse) GetProfilePhotoUrl() string {
+	if x != nil {
+		return x.ProfilePhotoUrl
+	}
+	return ""
+}
+
+type FollowUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *FollowUserRequest) Reset() {
+	*x = FollowUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserRequest) ProtoMessage() {}
+
+func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
+func (*FollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *FollowUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type FollowUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FollowUserResponse) Reset() {
+	*x = FollowUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserResponse) ProtoMessage() {}
+
+func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
+func (*FollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{119}
+}
+
+type UnfollowUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *UnfollowUserRequest) Reset() {
+	*x = UnfollowUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserRequest) ProtoMessage() {}
+
+func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *UnfollowUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnfollowUserResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnfollowUserResponse) Reset() {
+	*x = UnfollowUserResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_betterreads_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserResponse) ProtoMessage() {}
+
+func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_betterreads_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_betterreads_proto_rawDescGZIP(), []int{121}
+}
+
+var File_betterreads_proto protoreflect.FileDescriptor
+
+var file_betterreads_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e,
+	0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x7c, 0x0a, 0x12, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xbf, 0x02,
+	0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x59, 0x65, 0x61, 0x72, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22,
+	0x89, 0x02, 0x0a, 0x07, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
+	0x73, 0x62, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x6d, 0x61, 0x67,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x68, 0x79,
+	0x73, 0x69, 0x63, 0x61, 0x6c, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x06,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x69,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x62, 0x69, 0x6f, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x72,
+	0x74, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x69, 0x72, 0x74, 0x68, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x61, 0x74,
+	0x68, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65,
+	0x61, 0x74, 0x68, 0x44, 0x61, 0x74, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x26, 0x0a,
+	0x0f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42,
+	0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x42, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c,
+	0x12, 0x2f, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x22, 0xfc, 0x02, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x04,
+	0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69,
+	0x6b, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x29, 0x0a, 0x10,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68,
+	0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x6f, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65,
+	0x22, 0xa0, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x05, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xef, 0x04, 0x0a, 0x0b, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68,
+	0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x68, 0x65, 0x6c, 0x66, 0x49, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x35,
+	0x0a, 0x08, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x70, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x61, 0x73,
+	0x53, 0x70, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x22, 0xfe, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x39,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x69, 0x64, 0x5f, 0x6e, 0x6f,
+	0x74, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x64, 0x69, 0x64, 0x4e, 0x6f, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x06,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x6a, 0x0a, 0x0e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x57, 0x69, 0x74, 0x68,
+	0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x12,
+	0x2e, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x22,
+	0xcf, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x31,
+	0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x22, 0x7f, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b,
+	0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x29, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x22, 0x83, 0x03,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6b, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x64, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x75, 0x62, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x08,
+	0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x45, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x6e, 0x75, 0x61, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x73, 0x62, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
+	0x73, 0x62, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x79, 0x65, 0x61,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x59, 0x65, 0x61, 0x72, 0x22, 0x7a, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6b, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xb0, 0x01,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x2d, 0x0a, 0x05, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x52, 0x05, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12,
+	0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x97, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x46, 0x65,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7f, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x33, 0x0a,
+	0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f,
+	0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b,
+	0x49, 0x64, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0xac, 0x04, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b,
+	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x49,
+	0x64, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d,
+	0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x73, 0x62, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x62,
+	0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x88, 0x01, 0x01, 0x12, 0x33,
+	0x0a, 0x13, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x70, 0x6f,
+	0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x11, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x61, 0x73, 0x53, 0x70, 0x6f, 0x69, 0x6c, 0x65, 0x72, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x54, 0x61, 0x67,
+	0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x42, 0x16, 0x0a, 0x14,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x68, 0x61, 0x73, 0x5f, 0x73, 0x70, 0x6f, 0x69,
+	0x6c, 0x65, 0x72, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x1b,
+	0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42,
+	0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x1d, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x72, 0x65, 0x61, 0x64, 0x73, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x63, 0x73, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x73, 0x76, 0x22, 0xc1,
+	0x01, 0x0a, 0x1e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x30, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x04, 0x72, 0x6f,
+	0x77, 0x73, 0x22, 0x76, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0xf2, 0x01, 0x0a, 0x15, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x53, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x22,
+	0x49, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x2b, 0x0a, 0x15, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xb2, 0x02, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c,
+	0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x34,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1a, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x72, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x79, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x62,
+	0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd3, 0x01, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x76,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x57, 0x69, 0x74, 0x68,
+	0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x12, 0x41,
+	0x0a, 0x0f, 0x75, 0x6e, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x6f, 0x6f, 0x6b,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f,
+	0x6b, 0x52, 0x0e, 0x75, 0x6e, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b,
+	0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x28, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x13,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x22, 0x43, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x3f, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c,
+	0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x68, 0x65,
+	0x6c, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x05, 0x73, 0x68,
+	0x65, 0x6c, 0x66, 0x22, 0x2f, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x65,
+	0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x65,
+	0x6c, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x65,
+	0x6c, 0x66, 0x49, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68,
+	0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x46, 0x0a,
+	0x16, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x76,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x07, 0x73, 0x68,
+	0x65, 0x6c, 0x76, 0x65, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x68, 0x65,
+	0x6c, 0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x53, 0x68, 0x65, 0x6c, 0x66, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x68, 0x65, 0x6c, 0x66,
+	0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a,
+	0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x3f, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b,
+	0x0a, 0x15, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x54, 0x6f, 0x53, 0x68, 0x65, 0x6c, 0x66,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x49, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x41,
+	0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x54, 0x6f, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x50, 0x0a, 0x1a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42,
+	0x6f, 0x6f, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x68, 0x65, 0x6c, 0x66, 0x49, 0x64, 0x22, 0x1d, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f,
+	0x6b, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x97, 0x01, 0x0a, 0x19, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x84, 0x01, 0x0a, 0x1a,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x04, 0x62, 0x6f,
+	0x6f, 0x6b, 0x22, 0x85, 0x02, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x69, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x66,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x64,
+	0x4e, 0x6f, 0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x55, 0x0a, 0x1c, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xa4, 0x02, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x64, 0x69, 0x64, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x64, 0x69, 0x64, 0x4e, 0x6f,
+	0x74, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x55, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0x55, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x1e, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x97, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xdd, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61, 0x6c, 0x12,
+	0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79,
+	0x65, 0x61, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6f,
+	0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xa1, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61, 0x6c, 0x42,
+	0x6f, 0x6f, 0x6b, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x04, 0x62, 0x6f, 0x6f,
+	0x6b, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19,
+	0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52,
+	0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x22, 0x8c, 0x03, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47,
+	0x6f, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x6f, 0x6f, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x67, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x5f, 0x61, 0x68, 0x65, 0x61, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x41, 0x68, 0x65,
+	0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x61, 0x68, 0x65, 0x61,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x73, 0x41, 0x68,
+	0x65, 0x61, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x70, 0x61, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x47, 0x6f, 0x61, 0x6c, 0x50, 0x61, 0x63, 0x65, 0x52, 0x04, 0x70, 0x61, 0x63, 0x65, 0x12, 0x32,
+	0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x62, 0x6f, 0x6f,
+	0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68,
+	0x6f, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x11, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x50, 0x61, 0x67,
+	0x65, 0x73, 0x22, 0x9f, 0x03, 0x0a, 0x09, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0xae, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48,
+	0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x22, 0x4f, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48,
+	0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x34, 0x0a, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x2e, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x09, 0x68, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x22, 0x4f, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09,
+	0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x48, 0x69,
+	0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x09, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x3b, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x69, 0x67, 0x68,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x22,
+	0x19, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x91, 0x01, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x68, 0x69,
+	0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x48, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x1d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4b, 0x69, 0x6e,
+	0x64, 0x6c, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f,
+	0x72, 0x75, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x22, 0xda, 0x01, 0x0a, 0x1e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4b, 0x69, 0x6e, 0x64,
+	0x6c, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x71,
+	0x0a, 0x15, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x73, 0x22, 0x46, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47,
+	0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x04, 0x67,
+	0x6f, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47,
+	0x6f, 0x61, 0x6c, 0x52, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x22, 0x84, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2c, 0x0a, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x04, 0x67, 0x6f, 0x61, 0x6c, 0x12,
+	0x3c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2e, 0x0a,
+	0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x22, 0x1b, 0x0a,
+	0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x0b, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x72, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f,
+	0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73,
+	0x22, 0x44, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0x62, 0x0a, 0x0a, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x62,
+	0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x61, 0x67, 0x65, 0x73, 0x22, 0xfa, 0x02, 0x0a, 0x0c, 0x52,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x6f, 0x6f, 0x6b, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x6f, 0x6f,
+	0x6b, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x4e, 0x6f, 0x74, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x6e, 0x66, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x64, 0x6e, 0x66, 0x52, 0x61,
+	0x74, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x13, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44, 0x61, 0x79, 0x73, 0x54,
+	0x6f, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x74,
+	0x6f, 0x70, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a, 0x74, 0x6f, 0x70, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x50,
+	0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x22, 0x74, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x4a, 0x0a,
+	0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x11, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2c, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x2c, 0x0a, 0x11, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04,
+	0x62, 0x6f, 0x6f, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x22, 0x3b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04,
+	0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70,
+	0x6f, 0x73, 0x74, 0x22, 0x7d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x8f, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x46, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x44, 0x0a, 0x12,
+	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x4e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x0f, 0x4c,
+	0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x6b, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x55,
+	0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x6e, 0x6c,
+	0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xba, 0x02, 0x0a, 0x1d, 0x47, 0x65, 0x74,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x34, 0x0a, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x73, 0x74,
+	0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xb4, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x80, 0x02, 0x0a,
+	0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74,
+	0x6f, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x55, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x34, 0x0a, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x56, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xb4,
+	0x01, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a,
+	0x11, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x68, 0x6f,
+	0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f, 0x55, 0x72, 0x6c, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xa9, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x70, 0x68, 0x6f, 0x74, 0x6f, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x68, 0x6f, 0x74, 0x6f,
+	0x55, 0x72, 0x6c, 0x22, 0x2c, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x14, 0x0a, 0x12, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x0a, 0x13, 0x55, 0x6e, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x6e, 0x66, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a,
+	0x7d, 0x0a, 0x0a, 0x42, 0x6f, 0x6f, 0x6b, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x17, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f,
+	0x4f, 0x4b, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45,
+	0x5f, 0x42, 0x4f, 0x4f, 0x4b, 0x53, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x4f, 0x4f, 0x4b,
+	0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x4c, 0x49, 0x42,
+	0x52, 0x41, 0x52, 0x59, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x53,
+	0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x10, 0x03, 0x2a, 0xa8,
+	0x01, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x41,
+	0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x41, 0x4e, 0x54,
+	0x5f, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45,
+	0x41, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x41,
+	0x44, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x49, 0x44, 0x5f, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x10, 0x04, 0x2a, 0xaf, 0x01, 0x0a, 0x0a, 0x42, 0x6f,
+	0x6f, 0x6b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x4f, 0x4f, 0x4b,
+	0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x52, 0x41,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54,
+	0x57, 0x4f, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x4f, 0x4f,
+	0x4b, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x52, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x52, 0x41,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x46, 0x4f, 0x55, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x10, 0x04,
+	0x12, 0x19, 0x0a, 0x15, 0x42, 0x4f, 0x4f, 0x4b, 0x5f, 0x52, 0x41, 0x54, 0x49, 0x4e, 0x47, 0x5f,
+	0x46, 0x49, 0x56, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x10, 0x05, 0x2a, 0x91, 0x01, 0x0a, 0x0f,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x21, 0x0a, 0x1d, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10,
+	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x4f, 0x57, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a,
+	0x88, 0x01, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1d, 0x0a, 0x19, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1b, 0x0a, 0x17, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x47, 0x4f, 0x4f, 0x44, 0x52, 0x45, 0x41, 0x44, 0x53, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x53, 0x54,
+	0x4f, 0x52, 0x59, 0x47, 0x52, 0x41, 0x50, 0x48, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x4c, 0x49, 0x42, 0x52,
+	0x41, 0x52, 0x59, 0x54, 0x48, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x2a, 0x88, 0x01, 0x0a, 0x0c, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45,
+	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e,
+	0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x47, 0x4f, 0x4f, 0x44, 0x52, 0x45, 0x41, 0x44, 0x53, 0x5f, 0x43, 0x53,
+	0x56, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x47, 0x52, 0x41, 0x50, 0x48, 0x5f,
+	0x43, 0x53, 0x56, 0x10, 0x03, 0x2a, 0x81, 0x02, 0x0a, 0x0e, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x53,
+	0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x48, 0x45, 0x4c,
+	0x46, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x48,
+	0x45, 0x4c, 0x46, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54,
+	0x49, 0x54, 0x4c, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x48,
+	0x45, 0x4c, 0x46, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x54,
+	0x49, 0x54, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x53,
+	0x48, 0x45, 0x4c, 0x46, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c,
+	0x53, 0x48, 0x45, 0x4c, 0x46, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x41, 0x55, 0x54, 0x48, 0x4f, 0x52, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x04, 0x12, 0x23,
+	0x0a, 0x1f, 0x53, 0x48, 0x45, 0x4c, 0x46, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x5f, 0x41, 0x53,
+	0x43, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x48, 0x45, 0x4c, 0x46, 0x5f, 0x53, 0x4f, 0x52,
+	0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x44, 0x44,
+	0x45, 0x44, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x06, 0x2a, 0x81, 0x01, 0x0a, 0x08, 0x47, 0x6f,
+	0x61, 0x6c, 0x50, 0x61, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x50,
+	0x41, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x4f,
+	0x4e, 0x5f, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x4f, 0x41,
+	0x4c, 0x5f, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x41, 0x48, 0x45, 0x41, 0x44, 0x10, 0x02, 0x12, 0x14,
+	0x0a, 0x10, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x42, 0x45, 0x48, 0x49,
+	0x4e, 0x44, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x4f, 0x41, 0x4c, 0x5f, 0x50, 0x41, 0x43,
+	0x45, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x32, 0x95, 0x33,
+	0x0a, 0x12, 0x42, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x67, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6f,
+	0x6f, 0x6b, 0x73, 0x12, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x65, 0x0a,
+	0x07, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x1b, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x79, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x6e, 0x75, 0x61, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e,
+	0x75, 0x61, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a,
+	0x22, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12,
+	0x6f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x1d, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0x7e, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x64, 0x46, 0x65, 0x65, 0x64, 0x12, 0x27, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x46, 0x65,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64,
+	0x12, 0x70, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x12,
+	0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0x7b, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x69, 0x62, 0x72,
+	0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a, 0x0f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12,
+	0x7e, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x42, 0x6f, 0x6f, 0x6b, 0x12, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x42, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x1a, 0x0f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12,
+	0x9e, 0x01, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x2a, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47,
+	0x6f, 0x6f, 0x64, 0x72, 0x65, 0x61, 0x64, 0x73, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x67, 0x6f, 0x6f, 0x64, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x12, 0x79, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72,
+	0x79, 0x12, 0x21, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62,
+	0x72, 0x61, 0x72, 0x79, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x5a, 0x0a, 0x0d, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x7c, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x7b, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x76, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x69, 0x62, 0x72, 0x61,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
+	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x6c, 0x0a,
+	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x1f, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x12, 0x77, 0x0a, 0x0b, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x1f, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x32, 0x1a, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x68, 0x65, 0x6c, 0x66,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x74, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68,
+	0x65, 0x6c, 0x66, 0x12, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x2f,
+	0x7b, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x7c, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x53, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x2f, 0x7b,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x53, 0x68, 0x65, 0x6c, 0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x65, 0x6c,
+	0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x68, 0x65, 0x6c, 0x66, 0x42, 0x6f, 0x6f, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x68, 0x65, 0x6c,
+	0x66, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x0e,
+	0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x54, 0x6f, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x22,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x42, 0x6f, 0x6f, 0x6b, 0x54, 0x6f, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x42, 0x6f, 0x6f, 0x6b, 0x54, 0x6f, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x22,
+	0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79,
+	0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x2f, 0x7b, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f,
+	0x69, 0x64, 0x7d, 0x12, 0xa4, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f,
+	0x6f, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x12, 0x27, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x42, 0x6f, 0x6f, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6f, 0x6f, 0x6b, 0x46, 0x72, 0x6f,
+	0x6d, 0x53, 0x68, 0x65, 0x6c, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x2a, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x62,
+	0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x68, 0x65, 0x6c, 0x76, 0x65, 0x73, 0x2f,
+	0x7b, 0x73, 0x68, 0x65, 0x6c, 0x66, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x9a, 0x01, 0x0a, 0x12, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x26, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x4c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x61, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x62,
+	0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b,
+	0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0xa0, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x62, 0x6f, 0x6f, 0x6b,
+	0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0xad, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x3a, 0x01, 0x2a, 0x1a, 0x35, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x62,
+	0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0xaa, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64,
+	0x69, 0x6e, 0x67, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x2a, 0x35, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x62, 0x6f, 0x6f, 0x6b,
+	0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x7d, 0x12, 0x93, 0x01, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x69, 0x67, 0x68,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x48,
+	0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x2f, 0x62, 0x6f, 0x6f,
+	0x6b, 0x73, 0x2f, 0x7b, 0x62, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x68, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x8a, 0x01, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01,
+	0x2a, 0x1a, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x2f, 0x7b, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x87, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48,
+	0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x48, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x2a, 0x21, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2f,
+	0x7b, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x75,
+	0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x12, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x14, 0x12, 0x12, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x9e, 0x01, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4b, 0x69, 0x6e, 0x64, 0x6c, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x12, 0x2a, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x6c, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x4b, 0x69, 0x6e, 0x64, 0x6c, 0x65, 0x48, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x69,
+	0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2f,
+	0x6b, 0x69, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x7a, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x1a, 0x14, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x79, 0x65, 0x61,
+	0x72, 0x7d, 0x12, 0x77, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x47, 0x6f, 0x61, 0x6c, 0x12, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61,
+	0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e,
+	0x67, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x6f, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x79, 0x65, 0x61, 0x72, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61,
+	0x6c, 0x12, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x47, 0x6f, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x67, 0x6f, 0x61, 0x6c, 0x73, 0x2f, 0x7b, 0x79, 0x65, 0x61, 0x72, 0x7d, 0x12, 0x73,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x23, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0f, 0x12, 0x0d, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x67, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x1e, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x6e, 0x0a, 0x0a,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x71, 0x0a, 0x0a,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x1a, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x8f, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46,
+	0x6f, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x46, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
+	0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b,
+	0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x7a, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a, 0x22, 0x20, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x8d, 0x01,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x21, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x2a, 0x2d,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x2f, 0x7b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x6d, 0x0a,
+	0x08, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x2e, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
+	0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x1c,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x70,
+	0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x69, 0x6b, 0x65, 0x12, 0x75, 0x0a, 0x0a,
+	0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x20, 0x2a, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x73,
+	0x74, 0x73, 0x2f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x6e, 0x6c,
+	0x69, 0x6b, 0x65, 0x12, 0x7b, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x2a,
+	0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x87, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x29, 0x2e, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x7e, 0x0a, 0x11, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x32, 0x0f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x9f, 0x01, 0x0a, 0x16, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2a, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65,
+	0x61, 0x64, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
+	0x74, 0x79, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x32, 0x21, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x2d, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x7e, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x25, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x71, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x12, 0x1f, 0x2e, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x75, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f,
+	0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x12, 0x7d, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x22, 0x2a, 0x20, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x75, 0x6e, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x97, 0x01, 0x92, 0x41, 0x53, 0x12, 0x29, 0x0a, 0x0f, 0x42,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x73, 0x20, 0x41, 0x50, 0x49, 0x12, 0x0f,
+	0x42, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x61, 0x64, 0x73, 0x20, 0x41, 0x50, 0x49, 0x32,
+	0x05, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x2a, 0x02, 0x01, 0x02, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x3f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x65, 0x6c, 0x65, 0x73,
+	0x74, 0x69, 0x61, 0x6c, 0x64, 0x72, 0x61, 0x67, 0x6f, 0x6e, 0x66, 0x6c, 0x79, 0x2f, 0x62, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x3b, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x72, 0x65, 0x61, 0x64, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_betterreads_proto_rawDescOnce sync.Once
+	file_betterreads_proto_rawDescData = file_betterreads_proto_rawDesc
+)
+
+func file_betterreads_proto_rawDescGZIP() []byte {
+	file_betterreads_proto_rawDescOnce.Do(func() {
+		file_betterreads_proto_rawDescData = protoimpl.X.CompressGZIP(file_betterreads_proto_rawDescData)
+	})
+	return file_betterreads_proto_rawDescData
+}
+
+var file_betterreads_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_betterreads_proto_msgTypes = make([]protoimpl.MessageInfo, 122)
+var file_betterreads_proto_goTypes = []interface{}{
+	(BookSource)(0),                        // 0: betterreads.BookSource
+	(ReadingStatus)(0),                     // 1: betterreads.ReadingStatus
+	(BookRating)(0),                        // 2: betterreads.BookRating
+	(ImportRowStatus)(0),                   // 3: betterreads.ImportRowStatus
+	(ImportSource)(0),                      // 4: betterreads.ImportSource
+	(ExportFormat)(0),                      // 5: betterreads.ExportFormat
+	(ShelfSortOrder)(0),                    // 6: betterreads.ShelfSortOrder
+	(GoalPace)(0),                          // 7: betterreads.GoalPace
+	(*PaginationMetadata)(nil),             // 8: betterreads.PaginationMetadata
+	(*Book)(nil),                           // 9: betterreads.Book
+	(*Edition)(nil),                        // 10: betterreads.Edition
+	(*Author)(nil),                         // 11: betterreads.Author
+	(*AuthorWork)(nil),                     // 12: betterreads.AuthorWork
+	(*BookDetails)(nil),                    // 13: betterreads.BookDetails
+	(*Post)(nil),                           // 14: betterreads.Post
+	(*Comment)(nil),                        // 15: betterreads.Comment
+	(*Shelf)(nil),                          // 16: betterreads.Shelf
+	(*LibraryBook)(nil),                    // 17: betterreads.LibraryBook
+	(*ReadingSession)(nil),                 // 18: betterreads.ReadingSession
+	(*ShelfWithBooks)(nil),                 // 19: betterreads.ShelfWithBooks
+	(*SearchBooksRequest)(nil),             // 20: betterreads.SearchBooksRequest
+	(*SearchBooksResponse)(nil),            // 21: betterreads.SearchBooksResponse
+	(*GetBookRequest)(nil),                 // 22: betterreads.GetBookRequest
+	(*GetBookResponse)(nil),                // 23: betterreads.GetBookResponse
+	(*CreateManualBookRequest)(nil),        // 24: betterreads.CreateManualBookRequest
+	(*CreateManualBookResponse)(nil),       // 25: betterreads.CreateManualBookResponse
+	(*GetAuthorRequest)(nil),               // 26: betterreads.GetAuthorRequest
+	(*GetAuthorResponse)(nil),              // 27: betterreads.GetAuthorResponse
+	(*GetPersonalizedFeedRequest)(nil),     // 28: betterreads.GetPersonalizedFeedRequest
+	(*GetPersonalizedFeedResponse)(nil),    // 29: betterreads.GetPersonalizedFeedResponse
+	(*GetUserFeedRequest)(nil),             // 30: betterreads.GetUserFeedRequest
+	(*GetUserFeedResponse)(nil),            // 31: betterreads.GetUserFeedResponse
+	(*RemoveLibraryBookRequest)(nil),       // 32: betterreads.RemoveLibraryBookRequest
+	(*RemoveLibraryBookResponse)(nil),      // 33: betterreads.RemoveLibraryBookResponse
+	(*UpdateLibraryBookRequest)(nil),       // 34: betterreads.UpdateLibraryBookRequest
+	(*UpdateLibraryBookResponse)(nil),      // 35: betterreads.UpdateLibraryBookResponse
+	(*ImportGoodreadsLibraryRequest)(nil),  // 36: betterreads.ImportGoodreadsLibraryRequest
+	(*ImportGoodreadsLibraryResponse)(nil), // 37: betterreads.ImportGoodreadsLibraryResponse
+	(*ImportLibraryRequest)(nil),           // 38: betterreads.ImportLibraryRequest
+	(*ImportLibraryResponse)(nil),          // 39: betterreads.ImportLibraryResponse
+	(*ExportLibraryRequest)(nil),           // 40: betterreads.ExportLibraryRequest
+	(*ExportLibraryResponse)(nil),          // 41: betterreads.ExportLibraryResponse
+	(*ImportRowResult)(nil),                // 42: betterreads.ImportRowResult
+	(*GetUserLibraryRequest)(nil),          // 43: betterreads.GetUserLibraryRequest
+	(*SearchLibraryRequest)(nil),           // 44: betterreads.SearchLibraryRequest
+	(*SearchLibraryResponse)(nil),          // 45: betterreads.SearchLibraryResponse
+	(*GetUserLibraryResponse)(nil),         // 46: betterreads.GetUserLibraryResponse
+	(*CreateShelfRequest)(nil),             // 47: betterreads.CreateShelfRequest
+	(*CreateShelfResponse)(nil),            // 48: betterreads.CreateShelfResponse
+	(*UpdateShelfRequest)(nil),             // 49: betterreads.UpdateShelfRequest
+	(*UpdateShelfResponse)(nil),            // 50: betterreads.UpdateShelfResponse
+	(*DeleteShelfRequest)(nil),             // 51: betterreads.DeleteShelfRequest
+	(*DeleteShelfResponse)(nil),            // 52: betterreads.DeleteShelfResponse
+	(*GetUserShelvesRequest)(nil),          // 53: betterreads.GetUserShelvesRequest
+	(*GetUserShelvesResponse)(nil),         // 54: betterreads.GetUserShelvesResponse
+	(*GetShelfBooksRequest)(nil),           // 55: betterreads.GetShelfBooksRequest
+	(*GetShelfBooksResponse)(nil),          // 56: betterreads.GetShelfBooksResponse
+	(*AddBookToShelfRequest)(nil),          // 57: betterreads.AddBookToShelfRequest
+	(*AddBookToShelfResponse)(nil),         // 58: betterreads.AddBookToShelfResponse
+	(*RemoveBookFromShelfRequest)(nil),     // 59: betterreads.RemoveBookFromShelfRequest
+	(*RemoveBookFromShelfResponse)(nil),    // 60: betterreads.RemoveBookFromShelfResponse
+	(*ReadingProgress)(nil),                // 61: betterreads.ReadingProgress
+	(*LogReadingProgressRequest)(nil),      // 62: betterreads.LogReadingProgressRequest
+	(*LogReadingProgressResponse)(nil),     // 63: betterreads.LogReadingProgressResponse
+	(*CreateReadingSessionRequest)(nil),    // 64: betterreads.CreateReadingSessionRequest
+	(*CreateReadingSessionResponse)(nil),   // 65: betterreads.CreateReadingSessionResponse
+	(*UpdateReadingSessionRequest)(nil),    // 66: betterreads.UpdateReadingSessionRequest
+	(*UpdateReadingSessionResponse)(nil),   // 67: betterreads.UpdateReadingSessionResponse
+	(*DeleteReadingSessionRequest)(nil),    // 68: betterreads.DeleteReadingSessionRequest
+	(*DeleteReadingSessionResponse)(nil),   // 69: betterreads.DeleteReadingSessionResponse
+	(*GetReadingProgressRequest)(nil),      // 70: betterreads.GetReadingProgressRequest
+	(*GetReadingProgressResponse)(nil),     // 71: betterreads.GetReadingProgressResponse
+	(*ReadingGoal)(nil),                    // 72: betterreads.ReadingGoal
+	(*ReadingGoalBook)(nil),                // 73: betterreads.ReadingGoalBook
+	(*ReadingGoalProgress)(nil),            // 74: betterreads.ReadingGoalProgress
+	(*Highlight)(nil),                      // 75: betterreads.Highlight
+	(*CreateHighlightRequest)(nil),         // 76: betterreads.CreateHighlightRequest
+	(*CreateHighlightResponse)(nil),        // 77: betterreads.CreateHighlightResponse
+	(*UpdateHighlightRequest)(nil),         // 78: betterreads.UpdateHighlightRequest
+	(*UpdateHighlightResponse)(nil),        // 79: betterreads.UpdateHighlightResponse
+	(*DeleteHighlightRequest)(nil),         // 80: betterreads.DeleteHighlightRequest
+	(*DeleteHighlightResponse)(nil),        // 81: betterreads.DeleteHighlightResponse
+	(*ListHighlightsRequest)(nil),          // 82: betterreads.ListHighlightsRequest
+	(*ListHighlightsResponse)(nil),         // 83: betterreads.ListHighlightsResponse
+	(*ImportKindleHighlightsRequest)(nil),  // 84: betterreads.ImportKindleHighlightsRequest
+	(*ImportKindleHighlightsResponse)(nil), // 85: betterreads.ImportKindleHighlightsResponse
+	(*SetReadingGoalRequest)(nil),          // 86: betterreads.SetReadingGoalRequest
+	(*SetReadingGoalResponse)(nil),         // 87: betterreads.SetReadingGoalResponse
+	(*GetReadingGoalRequest)(nil),          // 88: betterreads.GetReadingGoalRequest
+	(*GetReadingGoalResponse)(nil),         // 89: betterreads.GetReadingGoalResponse
+	(*DeleteReadingGoalRequest)(nil),       // 90: betterreads.DeleteReadingGoalRequest
+	(*DeleteReadingGoalResponse)(nil),      // 91: betterreads.DeleteReadingGoalResponse
+	(*RatingCount)(nil),                    // 92: betterreads.RatingCount
+	(*AuthorCount)(nil),                    // 93: betterreads.AuthorCount
+	(*MonthCount)(nil),                     // 94: betterreads.MonthCount
+	(*ReadingStats)(nil),                   // 95: betterreads.ReadingStats
+	(*GetReadingStatsRequest)(nil),         // 96: betterreads.GetReadingStatsRequest
+	(*GetReadingStatsResponse)(nil),        // 97: betterreads.GetReadingStatsResponse
+	(*CreatePostRequest)(nil),              // 98: betterreads.CreatePostRequest
+	(*CreatePostResponse)(nil),             // 99: betterreads.CreatePostResponse
+	(*DeletePostRequest)(nil),              // 100: betterreads.DeletePostRequest
+	(*DeletePostResponse)(nil),             // 101: betterreads.DeletePostResponse
+	(*UpdatePostRequest)(nil),              // 102: betterreads.UpdatePostRequest
+	(*UpdatePostResponse)(nil),             // 103: betterreads.UpdatePostResponse
+	(*GetCommentsForPostRequest)(nil),      // 104: betterreads.GetCommentsForPostRequest
+	(*GetCommentsForPostResponse)(nil),     // 105: betterreads.GetCommentsForPostResponse
+	(*AddCommentRequest)(nil),              // 106: betterreads.AddCommentRequest
+	(*AddCommentResponse)(nil),             // 107: betterreads.AddCommentResponse
+	(*DeleteCommentRequest)(nil),           // 108: betterreads.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),          // 109: betterreads.DeleteCommentResponse
+	(*LikePostRequest)(nil),                // 110: betterreads.LikePostRequest
+	(*LikePostResponse)(nil),               // 111: betterreads.LikePostResponse
+	(*UnlikePostRequest)(nil),              // 112: betterreads.UnlikePostRequest
+	(*UnlikePostResponse)(nil),             // 113: betterreads.UnlikePostResponse
+	(*DeleteUserProfileRequest)(nil),       // 114: betterreads.DeleteUserProfileRequest
+	(*DeleteUserProfileResponse)(nil),      // 115: betterreads.DeleteUserProfileResponse
+	(*GetCurrentUserProfileRequest)(nil),   // 116: betterreads.GetCurrentUserProfileRequest
+	(*GetCurrentUserProfileResponse)(nil),  // 117: betterreads.GetCurrentUserProfileResponse
+	(*UpdateUserProfileRequest)(nil),       // 118: betterreads.UpdateUserProfileRequest
+	(*UpdateUserProfileResponse)(nil),      // 119: betterreads.UpdateUserProfileResponse
+	(*UpdateActivitySettingsRequest)(nil),  // 120: betterreads.UpdateActivitySettingsRequest
+	(*UpdateActivitySettingsResponse)(nil), // 121: betterreads.UpdateActivitySettingsResponse
+	(*CreateUserProfileRequest)(nil),       // 122: betterreads.CreateUserProfileRequest
+	(*CreateUserProfileResponse)(nil),      // 123: betterreads.CreateUserProfileResponse
+	(*GetUserByIdRequest)(nil),             // 124: betterreads.GetUserByIdRequest
+	(*GetUserByIdResponse)(nil),            // 125: betterreads.GetUserByIdResponse
+	(*FollowUserRequest)(nil),              // 126: betterreads.FollowUserRequest
+	(*FollowUserResponse)(nil),             // 127: betterreads.FollowUserResponse
+	(*UnfollowUserRequest)(nil),            // 128: betterreads.UnfollowUserRequest
+	(*UnfollowUserResponse)(nil),           // 129: betterreads.UnfollowUserResponse
+	(*timestamppb.Timestamp)(nil),          // 130: google.protobuf.Timestamp
+}
+var file_betterreads_proto_depIdxs = []int32{
+	0,   // 0: betterreads.Book.source:type_name -> betterreads.BookSource
+	2,   // 1: betterreads.BookDetails.rating:type_name -> betterreads.BookRating
+	13,  // 2: betterreads.Post.book:type_name -> betterreads.BookDetails
+	130, // 3: betterreads.Post.created_at:type_name -> google.protobuf.Timestamp
+	130, // 4: betterreads.Comment.created_at:type_name -> google.protobuf.Timestamp
+	130, // 5: betterreads.Shelf.created_at:type_name -> google.protobuf.Timestamp
+	130, // 6: betterreads.Shelf.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 7: betterreads.LibraryBook.rating:type_name -> betterreads.BookRating
+	0,   // 8: betterreads.LibraryBook.source:type_name -> betterreads.BookSource
+	130, // 9: betterreads.LibraryBook.added_at:type_name -> google.protobuf.Timestamp
+	130, // 10: betterreads.LibraryBook.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 11: betterreads.LibraryBook.reading_status:type_name -> betterreads.ReadingStatus
+	18,  // 12: betterreads.LibraryBook.sessions:type_name -> betterreads.ReadingSession
+	130, // 13: betterreads.ReadingSession.started_at:type_name -> google.protobuf.Timestamp
+	130, // 14: betterreads.ReadingSession.finished_at:type_name -> google.protobuf.Timestamp
+	2,   // 15: betterreads.ReadingSession.rating:type_name -> betterreads.BookRating
+	130, // 16: betterreads.ReadingSession.created_at:type_name -> google.protobuf.Timestamp
+	130, // 17: betterreads.ReadingSession.updated_at:type_name -> google.protobuf.Timestamp
+	16,  // 18: betterreads.ShelfWithBooks.shelf:type_name -> betterreads.Shelf
+	17,  // 19: betterreads.ShelfWithBooks.books:type_name -> betterreads.LibraryBook
+	0,   // 20: betterreads.SearchBooksRequest.sources:type_name -> betterreads.BookSource
+	9,   // 21: betterreads.SearchBooksResponse.books:type_name -> betterreads.Book
+	8,   // 22: betterreads.SearchBooksResponse.pagination:type_name -> betterreads.PaginationMetadata
+	9,   // 23: betterreads.GetBookResponse.book:type_name -> betterreads.Book
+	10,  // 24: betterreads.GetBookResponse.editions:type_name -> betterreads.Edition
+	9,   // 25: betterreads.CreateManualBookResponse.book:type_name -> betterreads.Book
+	11,  // 26: betterreads.GetAuthorResponse.author:type_name -> betterreads.Author
+	12,  // 27: betterreads.GetAuthorResponse.works:type_name -> betterreads.AuthorWork
+	8,   // 28: betterreads.GetAuthorResponse.pagination:type_name -> betterreads.PaginationMetadata
+	130, // 29: betterreads.GetPersonalizedFeedRequest.since:type_name -> google.protobuf.Timestamp
+	14,  // 30: betterreads.GetPersonalizedFeedResponse.posts:type_name -> betterreads.Post
+	8,   // 31: betterreads.GetPersonalizedFeedResponse.pagination:type_name -> betterreads.PaginationMetadata
+	14,  // 32: betterreads.GetUserFeedResponse.posts:type_name -> betterreads.Post
+	8,   // 33: betterreads.GetUserFeedResponse.pagination:type_name -> betterreads.PaginationMetadata
+	0,   // 34: betterreads.UpdateLibraryBookRequest.source:type_name -> betterreads.BookSource
+	2,   // 35: betterreads.UpdateLibraryBookRequest.rating:type_name -> betterreads.BookRating
+	1,   // 36: betterreads.UpdateLibraryBookRequest.reading_status:type_name -> betterreads.ReadingStatus
+	42,  // 37: betterreads.ImportGoodreadsLibraryResponse.rows:type_name -> betterreads.ImportRowResult
+	4,   // 38: betterreads.ImportLibraryRequest.source:type_name -> betterreads.ImportSource
+	42,  // 39: betterreads.ImportLibraryResponse.rows:type_name -> betterreads.ImportRowResult
+	5,   // 40: betterreads.ExportLibraryRequest.format:type_name -> betterreads.ExportFormat
+	3,   // 41: betterreads.ImportRowResult.status:type_name -> betterreads.ImportRowStatus
+	1,   // 42: betterreads.ImportRowResult.reading_status:type_name -> betterreads.ReadingStatus
+	2,   // 43: betterreads.ImportRowResult.rating:type_name -> betterreads.BookRating
+	17,  // 44: betterreads.SearchLibraryResponse.books:type_name -> betterreads.LibraryBook
+	8,   // 45: betterreads.SearchLibraryResponse.pagination:type_name -> betterreads.PaginationMetadata
+	19,  // 46: betterreads.GetUserLibraryResponse.shelves:type_name -> betterreads.ShelfWithBooks
+	17,  // 47: betterreads.GetUserLibraryResponse.unshelved_books:type_name -> betterreads.LibraryBook
+	8,   // 48: betterreads.GetUserLibraryResponse.pagination:type_name -> betterreads.PaginationMetadata
+	16,  // 49: betterreads.CreateShelfResponse.shelf:type_name -> betterreads.Shelf
+	16,  // 50: betterreads.UpdateShelfResponse.shelf:type_name -> betterreads.Shelf
+	16,  // 51: betterreads.GetUserShelvesResponse.shelves:type_name -> betterreads.Shelf
+	6,   // 52: betterreads.GetShelfBooksRequest.sort:type_name -> betterreads.ShelfSortOrder
+	17,  // 53: betterreads.GetShelfBooksResponse.books:type_name -> betterreads.LibraryBook
+	8,   // 54: betterreads.GetShelfBooksResponse.pagination:type_name -> betterreads.PaginationMetadata
+	130, // 55: betterreads.ReadingProgress.created_at:type_name -> google.protobuf.Timestamp
+	61,  // 56: betterreads.LogReadingProgressResponse.progress:type_name -> betterreads.ReadingProgress
+	17,  // 57: betterreads.LogReadingProgressResponse.book:type_name -> betterreads.LibraryBook
+	130, // 58: betterreads.CreateReadingSessionRequest.started_at:type_name -> google.protobuf.Timestamp
+	130, // 59: betterreads.CreateReadingSessionRequest.finished_at:type_name -> google.protobuf.Timestamp
+	2,   // 60: betterreads.CreateReadingSessionRequest.rating:type_name -> betterreads.BookRating
+	18,  // 61: betterreads.CreateReadingSessionResponse.session:type_name -> betterreads.ReadingSession
+	130, // 62: betterreads.UpdateReadingSessionRequest.started_at:type_name -> google.protobuf.Timestamp
+	130, // 63: betterreads.UpdateReadingSessionRequest.finished_at:type_name -> google.protobuf.Timestamp
+	2,   // 64: betterreads.UpdateReadingSessionRequest.rating:type_name -> betterreads.BookRating
+	18,  // 65: betterreads.UpdateReadingSessionResponse.session:type_name -> betterreads.ReadingSession
+	61,  // 66: betterreads.GetReadingProgressResponse.progress:type_name -> betterreads.ReadingProgress
+	8,   // 67: betterreads.GetReadingProgressResponse.pagination:type_name -> betterreads.PaginationMetadata
+	130, // 68: betterreads.ReadingGoal.created_at:type_name -> google.protobuf.Timestamp
+	130, // 69: betterreads.ReadingGoal.updated_at:type_name -> google.protobuf.Timestamp
+	17,  // 70: betterreads.ReadingGoalBook.book:type_name -> betterreads.LibraryBook
+	130, // 71: betterreads.ReadingGoalBook.finished_at:type_name -> google.protobuf.Timestamp
+	7,   // 72: betterreads.ReadingGoalProgress.pace:type_name -> betterreads.GoalPace
+	73,  // 73: betterreads.ReadingGoalProgress.books:type_name -> betterreads.ReadingGoalBook
+	130, // 74: betterreads.Highlight.created_at:type_name -> google.protobuf.Timestamp
+	130, // 75: betterreads.Highlight.updated_at:type_name -> google.protobuf.Timestamp
+	75,  // 76: betterreads.CreateHighlightResponse.highlight:type_name -> betterreads.Highlight
+	75,  // 77: betterreads.UpdateHighlightResponse.highlight:type_name -> betterreads.Highlight
+	75,  // 78: betterreads.ListHighlightsResponse.highlights:type_name -> betterreads.Highlight
+	8,   // 79: betterreads.ListHighlightsResponse.pagination:type_name -> betterreads.PaginationMetadata
+	42,  // 80: betterreads.ImportKindleHighlightsResponse.rows:type_name -> betterreads.ImportRowResult
+	72,  // 81: betterreads.SetReadingGoalResponse.goal:type_name -> betterreads.ReadingGoal
+	72,  // 82: betterreads.GetReadingGoalResponse.goal:type_name -> betterreads.ReadingGoal
+	74,  // 83: betterreads.GetReadingGoalResponse.progress:type_name -> betterreads.ReadingGoalProgress
+	2,   // 84: betterreads.RatingCount.rating:type_name -> betterreads.BookRating
+	92,  // 85: betterreads.ReadingStats.ratings:type_name -> betterreads.RatingCount
+	93,  // 86: betterreads.ReadingStats.top_authors:type_name -> betterreads.AuthorCount
+	94,  // 87: betterreads.ReadingStats.books_per_month:type_name -> betterreads.MonthCount
+	130, // 88: betterreads.GetReadingStatsRequest.from:type_name -> google.protobuf.Timestamp
+	130, // 89: betterreads.GetReadingStatsRequest.to:type_name -> google.protobuf.Timestamp
+	95,  // 90: betterreads.GetReadingStatsResponse.stats:type_name -> betterreads.ReadingStats
+	13,  // 91: betterreads.CreatePostRequest.book:type_name -> betterreads.BookDetails
+	14,  // 92: betterreads.CreatePostResponse.post:type_name -> betterreads.Post
+	13,  // 93: betterreads.UpdatePostRequest.book:type_name -> betterreads.BookDetails
+	14,  // 94: betterreads.UpdatePostResponse.post:type_name -> betterreads.Post
+	15,  // 95: betterreads.GetCommentsForPostResponse.comments:type_name -> betterreads.Comment
+	8,   // 96: betterreads.GetCommentsForPostResponse.pagination:type_name -> betterreads.PaginationMetadata
+	15,  // 97: betterreads.AddCommentResponse.comment:type_name -> betterreads.Comment
+	130, // 98: betterreads.GetCurrentUserProfileResponse.created_at:type_name -> google.protobuf.Timestamp
+	130, // 99: betterreads.UpdateUserProfileResponse.created_at:type_name -> google.protobuf.Timestamp
+	130, // 100: betterreads.CreateUserProfileResponse.created_at:type_name -> google.protobuf.Timestamp
+	20,  // 101: betterreads.BetterReadsService.SearchBooks:input_type -> betterreads.SearchBooksRequest
+	22,  // 102: betterreads.BetterReadsService.GetBook:input_type -> betterreads.GetBookRequest
+	24,  // 103: betterreads.BetterReadsService.CreateManualBook:input_type -> betterreads.CreateManualBookRequest
+	26,  // 104: betterreads.BetterReadsService.GetAuthor:input_type -> betterreads.GetAuthorRequest
+	28,  // 105: betterreads.BetterReadsService.GetPersonalizedFeed:input_type -> betterreads.GetPersonalizedFeedRequest
+	30,  // 106: betterreads.BetterReadsService.GetUserFeed:input_type -> betterreads.GetUserFeedRequest
+	32,  // 107: betterreads.BetterReadsService.RemoveLibraryBook:input_type -> betterreads.RemoveLibraryBookRequest
+	34,  // 108: betterreads.BetterReadsService.UpdateLibraryBook:input_type -> betterreads.UpdateLibraryBookRequest
+	36,  // 109: betterreads.BetterReadsService.ImportGoodreadsLibrary:input_type -> betterreads.ImportGoodreadsLibraryRequest
+	38,  // 110: betterreads.BetterReadsService.ImportLibrary:input_type -> betterreads.ImportLibraryRequest
+	40,  // 111: betterreads.BetterReadsService.ExportLibrary:input_type -> betterreads.ExportLibraryRequest
+	43,  // 112: betterreads.BetterReadsService.GetUserLibrary:input_type -> betterreads.GetUserLibraryRequest
+	44,  // 113: betterreads.BetterReadsService.SearchLibrary:input_type -> betterreads.SearchLibraryRequest
+	47,  // 114: betterreads.BetterReadsService.CreateShelf:input_type -> betterreads.CreateShelfRequest
+	49,  // 115: betterreads.BetterReadsService.UpdateShelf:input_type -> betterreads.UpdateShelfRequest
+	51,  // 116: betterreads.BetterReadsService.DeleteShelf:input_type -> betterreads.DeleteShelfRequest
+	53,  // 117: betterreads.BetterReadsService.GetUserShelves:input_type -> betterreads.GetUserShelvesRequest
+	55,  // 118: betterreads.BetterReadsService.GetShelfBooks:input_type -> betterreads.GetShelfBooksRequest
+	57,  // 119: betterreads.BetterReadsService.AddBookToShelf:input_type -> betterreads.AddBookToShelfRequest
+	59,  // 120: betterreads.BetterReadsService.RemoveBookFromShelf:input_type -> betterreads.RemoveBookFromShelfRequest
+	62,  // 121: betterreads.BetterReadsService.LogReadingProgress:input_type -> betterreads.LogReadingProgressRequest
+	70,  // 122: betterreads.BetterReadsService.GetReadingProgress:input_type -> betterreads.GetReadingProgressRequest
+	64,  // 123: betterreads.BetterReadsService.CreateReadingSession:input_type -> betterreads.CreateReadingSessionRequest
+	66,  // 124: betterreads.BetterReadsService.UpdateReadingSession:input_type -> betterreads.UpdateReadingSessionRequest
+	68,  // 125: betterreads.BetterReadsService.DeleteReadingSession:input_type -> betterreads.DeleteReadingSessionRequest
+	76,  // 126: betterreads.BetterReadsService.CreateHighlight:input_type -> betterreads.CreateHighlightRequest
+	78,  // 127: betterreads.BetterReadsService.UpdateHighlight:input_type -> betterreads.UpdateHighlightRequest
+	80,  // 128: betterreads.BetterReadsService.DeleteHighlight:input_type -> betterreads.DeleteHighlightRequest
+	82,  // 129: betterreads.BetterReadsService.ListHighlights:input_type -> betterreads.ListHighlightsRequest
+	84,  // 130: betterreads.BetterReadsService.ImportKindleHighlights:input_type -> betterreads.ImportKindleHighlightsRequest
+	86,  // 131: betterreads.BetterReadsService.SetReadingGoal:input_type -> betterreads.SetReadingGoalRequest
+	88,  // 132: betterreads.BetterReadsService.GetReadingGoal:input_type -> betterreads.GetReadingGoalRequest
+	90,  // 133: betterreads.BetterReadsService.DeleteReadingGoal:input_type -> betterreads.DeleteReadingGoalRequest
+	96,  // 134: betterreads.BetterReadsService.GetReadingStats:input_type -> betterreads.GetReadingStatsRequest
+	98,  // 135: betterreads.BetterReadsService.CreatePost:input_type -> betterreads.CreatePostRequest
+	100, // 136: betterreads.BetterReadsService.DeletePost:input_type -> betterreads.DeletePostRequest
+	102, // 137: betterreads.BetterReadsService.UpdatePost:input_type -> betterreads.UpdatePostRequest
+	104, // 138: betterreads.BetterReadsService.GetCommentsForPost:input_type -> betterreads.GetCommentsForPostRequest
+	106, // 139: betterreads.BetterReadsService.AddComment:input_type -> betterreads.AddCommentRequest
+	108, // 140: betterreads.BetterReadsService.DeleteComment:input_type -> betterreads.DeleteCommentRequest
+	110, // 141: betterreads.BetterReadsService.LikePost:input_type -> betterreads.LikePostRequest
+	112, // 142: betterreads.BetterReadsService.UnlikePost:input_type -> betterreads.UnlikePostRequest
+	114, // 143: betterreads.BetterReadsService.DeleteUserProfile:input_type -> betterreads.DeleteUserProfileRequest
+	116, // 144: betterreads.BetterReadsService.GetCurrentUserProfile:input_type -> betterreads.GetCurrentUserProfileRequest
+	118, // 145: betterreads.BetterReadsService.UpdateUserProfile:input_type -> betterreads.UpdateUserProfileRequest
+	120, // 146: betterreads.BetterReadsService.UpdateActivitySettings:input_type -> betterreads.UpdateActivitySettingsRequest
+	122, // 147: betterreads.BetterReadsService.CreateUserProfile:input_type -> betterreads.CreateUserProfileRequest
+	124, // 148: betterreads.BetterReadsService.GetUserById:input_type -> betterreads.GetUserByIdRequest
+	126, // 149: betterreads.BetterReadsService.FollowUser:input_type -> betterreads.FollowUserRequest
+	128, // 150: betterreads.BetterReadsService.UnfollowUser:input_type -> betterreads.UnfollowUserRequest
+	21,  // 151: betterreads.BetterReadsService.SearchBooks:output_type -> betterreads.SearchBooksResponse
+	23,  // 152: betterreads.BetterReadsService.GetBook:output_type -> betterreads.GetBookResponse
+	25,  // 153: betterreads.BetterReadsService.CreateManualBook:output_type -> betterreads.CreateManualBookResponse
+	27,  // 154: betterreads.BetterReadsService.GetAuthor:output_type -> betterreads.GetAuthorResponse
+	29,  // 155: betterreads.BetterReadsService.GetPersonalizedFeed:output_type -> betterreads.GetPersonalizedFeedResponse
+	31,  // 156: betterreads.BetterReadsService.GetUserFeed:output_type -> betterreads.GetUserFeedResponse
+	33,  // 157: betterreads.BetterReadsService.RemoveLibraryBook:output_type -> betterreads.RemoveLibraryBookResponse
+	35,  // 158: betterreads.BetterReadsService.UpdateLibraryBook:output_type -> betterreads.UpdateLibraryBookResponse
+	37,  // 159: betterreads.BetterReadsService.ImportGoodreadsLibrary:output_type -> betterreads.ImportGoodreadsLibraryResponse
+	39,  // 160: betterreads.BetterReadsService.ImportLibrary:output_type -> betterreads.ImportLibraryResponse
+	41,  // 161: betterreads.BetterReadsService.ExportLibrary:output_type -> betterreads.ExportLibraryResponse
+	46,  // 162: betterreads.BetterReadsService.GetUserLibrary:output_type -> betterreads.GetUserLibraryResponse
+	45,  // 163: betterreads.BetterReadsService.SearchLibrary:output_type -> betterreads.SearchLibraryResponse
+	48,  // 164: betterreads.BetterReadsService.CreateShelf:output_type -> betterreads.CreateShelfResponse
+	50,  // 165: betterreads.BetterReadsService.UpdateShelf:output_type -> betterreads.UpdateShelfResponse
+	52,  // 166: betterreads.BetterReadsService.DeleteShelf:output_type -> betterreads.DeleteShelfResponse
+	54,  // 167: betterreads.BetterReadsService.GetUserShelves:output_type -> betterreads.GetUserShelvesResponse
+	56,  // 168: betterreads.BetterReadsService.GetShelfBooks:output_type -> betterreads.GetShelfBooksResponse
+	58,  // 169: betterreads.BetterReadsService.AddBookToShelf:output_type -> betterreads.AddBookToShelfResponse
+	60,  // 170: betterreads.BetterReadsService.RemoveBookFromShelf:output_type -> betterreads.RemoveBookFromShelfResponse
+	63,  // 171: betterreads.BetterReadsService.LogReadingProgress:output_type -> betterreads.LogReadingProgressResponse
+	71,  // 172: betterreads.BetterReadsService.GetReadingProgress:output_type -> betterreads.GetReadingProgressResponse
+	65,  // 173: betterreads.BetterReadsService.CreateReadingSession:output_type -> betterreads.CreateReadingSessionResponse
+	67,  // 174: betterreads.BetterReadsService.UpdateReadingSession:output_type -> betterreads.UpdateReadingSessionResponse
+	69,  // 175: betterreads.BetterReadsService.DeleteReadingSession:output_type -> betterreads.DeleteReadingSessionResponse
+	77,  // 176: betterreads.BetterReadsService.CreateHighlight:output_type -> betterreads.CreateHighlightResponse
+	79,  // 177: betterreads.BetterReadsService.UpdateHighlight:output_type -> betterreads.UpdateHighlightResponse
+	81,  // 178: betterreads.BetterReadsService.DeleteHighlight:output_type -> betterreads.DeleteHighlightResponse
+	83,  // 179: betterreads.BetterReadsService.ListHighlights:output_type -> betterreads.ListHighlightsResponse
+	85,  // 180: betterreads.BetterReadsService.ImportKindleHighlights:output_type -> betterreads.ImportKindleHighlightsResponse
+	87,  // 181: betterreads.BetterReadsService.SetReadingGoal:output_type -> betterreads.SetReadingGoalResponse
+	89,  // 182: betterreads.BetterReadsService.GetReadingGoal:output_type -> betterreads.GetReadingGoalResponse
+	91,  // 183: betterreads.BetterReadsService.DeleteReadingGoal:output_type -> betterreads.DeleteReadingGoalResponse
+	97,  // 184: betterreads.BetterReadsService.GetReadingStats:output_type -> betterreads.GetReadingStatsResponse
+	99,  // 185: betterreads.BetterReadsService.CreatePost:output_type -> betterreads.CreatePostResponse
+	101, // 186: betterreads.BetterReadsService.DeletePost:output_type -> betterreads.DeletePostResponse
+	103, // 187: betterreads.BetterReadsService.UpdatePost:output_type -> betterreads.UpdatePostResponse
+	105, // 188: betterreads.BetterReadsService.GetCommentsForPost:output_type -> betterreads.GetCommentsForPostResponse
+	107, // 189: betterreads.BetterReadsService.AddComment:output_type -> betterreads.AddCommentResponse
+	109, // 190: betterreads.BetterReadsService.DeleteComment:output_type -> betterreads.DeleteCommentResponse
+	111, // 191: betterreads.BetterReadsService.LikePost:output_type -> betterreads.LikePostResponse
+	113, // 192: betterreads.BetterReadsService.UnlikePost:output_type -> betterreads.UnlikePostResponse
+	115, // 193: betterreads.BetterReadsService.DeleteUserProfile:output_type -> betterreads.DeleteUserProfileResponse
+	117, // 194: betterreads.BetterReadsService.GetCurrentUserProfile:output_type -> betterreads.GetCurrentUserProfileResponse
+	119, // 195: betterreads.BetterReadsService.UpdateUserProfile:output_type -> betterreads.UpdateUserProfileResponse
+	121, // 196: betterreads.BetterReadsService.UpdateActivitySettings:output_type -> betterreads.UpdateActivitySettingsResponse
+	123, // 197: betterreads.BetterReadsService.CreateUserProfile:output_type -> betterreads.CreateUserProfileResponse
+	125, // 198: betterreads.BetterReadsService.GetUserById:output_type -> betterreads.GetUserByIdResponse
+	127, // 199: betterreads.BetterReadsService.FollowUser:output_type -> betterreads.FollowUserResponse
+	129, // 200: betterreads.BetterReadsService.UnfollowUser:output_type -> betterreads.UnfollowUserResponse
+	151, // [151:201] is the sub-list for method output_type
+	101, // [101:151] is the sub-list for method input_type
+	101, // [101:101] is the sub-list for extension type_name
+	101, // [101:101] is the sub-list for extension extendee
+	0,   // [0:101] is the sub-list for field type_name
+}
+
+func init() { file_betterreads_proto_init() }
+func file_betterreads_proto_init() {
+	if File_betterreads_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_betterreads_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaginationMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Book); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Edition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Author); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorWork); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Post); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shelf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LibraryBook); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadingSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShelfWithBooks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchBooksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchBooksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateManualBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateManualBookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAuthorResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPersonalizedFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPersonalizedFeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserFeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveLibraryBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveLibraryBookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLibraryBookRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLibraryBookResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportGoodreadsLibraryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportGoodreadsLibraryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportLibraryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportLibraryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportLibraryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportLibraryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRowResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserLibraryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchLibraryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchLibraryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserLibraryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShelfRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShelfResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateShelfRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateShelfResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteShelfRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteShelfResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserShelvesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserShelvesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetShelfBooksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetShelfBooksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBookToShelfRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddBookToShelfResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveBookFromShelfRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveBookFromShelfResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadingProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogReadingProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogReadingProgressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4943,8 +10970,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Book); i {
+		file_betterreads_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReadingSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4955,8 +10982,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BookDetails); i {
+		file_betterreads_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateReadingSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4967,8 +10994,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Post); i {
+		file_betterreads_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReadingSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4979,8 +11006,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Comment); i {
+		file_betterreads_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateReadingSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4991,8 +11018,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Shelf); i {
+		file_betterreads_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReadingSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5003,8 +11030,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LibraryBook); i {
+		file_betterreads_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReadingSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5015,8 +11042,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShelfWithBooks); i {
+		file_betterreads_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReadingProgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5027,8 +11054,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchBooksRequest); i {
+		file_betterreads_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReadingProgressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5039,8 +11066,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchBooksResponse); i {
+		file_betterreads_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadingGoal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5051,8 +11078,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPersonalizedFeedRequest); i {
+		file_betterreads_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadingGoalBook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5063,8 +11090,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPersonalizedFeedResponse); i {
+		file_betterreads_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadingGoalProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5075,8 +11102,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserFeedRequest); i {
+		file_betterreads_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Highlight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5087,8 +11114,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserFeedResponse); i {
+		file_betterreads_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateHighlightRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5099,8 +11126,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveLibraryBookRequest); i {
+		file_betterreads_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateHighlightResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5111,8 +11138,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveLibraryBookResponse); i {
+		file_betterreads_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHighlightRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5123,8 +11150,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLibraryBookRequest); i {
+		file_betterreads_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateHighlightResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5135,8 +11162,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateLibraryBookResponse); i {
+		file_betterreads_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteHighlightRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5147,8 +11174,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserLibraryRequest); i {
+		file_betterreads_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteHighlightResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5159,8 +11186,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserLibraryResponse); i {
+		file_betterreads_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHighlightsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5171,8 +11198,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateShelfRequest); i {
+		file_betterreads_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListHighlightsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5183,8 +11210,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateShelfResponse); i {
+		file_betterreads_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportKindleHighlightsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5195,8 +11222,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateShelfRequest); i {
+		file_betterreads_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportKindleHighlightsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5207,8 +11234,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateShelfResponse); i {
+		file_betterreads_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReadingGoalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5219,8 +11246,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteShelfRequest); i {
+		file_betterreads_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReadingGoalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5231,8 +11258,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteShelfResponse); i {
+		file_betterreads_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReadingGoalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5243,8 +11270,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserShelvesRequest); i {
+		file_betterreads_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReadingGoalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5255,8 +11282,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserShelvesResponse); i {
+		file_betterreads_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReadingGoalRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5267,8 +11294,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetShelfBooksRequest); i {
+		file_betterreads_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReadingGoalResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5279,8 +11306,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetShelfBooksResponse); i {
+		file_betterreads_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RatingCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5291,8 +11318,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddBookToShelfRequest); i {
+		file_betterreads_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5303,8 +11330,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddBookToShelfResponse); i {
+		file_betterreads_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonthCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5315,8 +11342,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveBookFromShelfRequest); i {
+		file_betterreads_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadingStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5327,8 +11354,8 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveBookFromShelfResponse); i {
+		file_betterreads_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReadingStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5339,7 +11366,19 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReadingStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePostRequest); i {
 			case 0:
 				return &v.state
@@ -5351,7 +11390,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePostResponse); i {
 			case 0:
 				return &v.state
@@ -5363,7 +11402,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeletePostRequest); i {
 			case 0:
 				return &v.state
@@ -5375,7 +11414,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeletePostResponse); i {
 			case 0:
 				return &v.state
@@ -5387,7 +11426,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdatePostRequest); i {
 			case 0:
 				return &v.state
@@ -5399,7 +11438,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdatePostResponse); i {
 			case 0:
 				return &v.state
@@ -5411,7 +11450,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCommentsForPostRequest); i {
 			case 0:
 				return &v.state
@@ -5423,7 +11462,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCommentsForPostResponse); i {
 			case 0:
 				return &v.state
@@ -5435,7 +11474,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddCommentRequest); i {
 			case 0:
 				return &v.state
@@ -5447,7 +11486,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddCommentResponse); i {
 			case 0:
 				return &v.state
@@ -5459,7 +11498,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteCommentRequest); i {
 			case 0:
 				return &v.state
@@ -5471,7 +11510,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteCommentResponse); i {
 			case 0:
 				return &v.state
@@ -5483,7 +11522,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LikePostRequest); i {
 			case 0:
 				return &v.state
@@ -5495,7 +11534,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LikePostResponse); i {
 			case 0:
 				return &v.state
@@ -5507,7 +11546,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnlikePostRequest); i {
 			case 0:
 				return &v.state
@@ -5519,7 +11558,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnlikePostResponse); i {
 			case 0:
 				return &v.state
@@ -5531,7 +11570,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteUserProfileRequest); i {
 			case 0:
 				return &v.state
@@ -5543,7 +11582,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteUserProfileResponse); i {
 			case 0:
 				return &v.state
@@ -5555,7 +11594,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCurrentUserProfileRequest); i {
 			case 0:
 				return &v.state
@@ -5567,7 +11606,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetCurrentUserProfileResponse); i {
 			case 0:
 				return &v.state
@@ -5579,7 +11618,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateUserProfileRequest); i {
 			case 0:
 				return &v.state
@@ -5591,7 +11630,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateUserProfileResponse); i {
 			case 0:
 				return &v.state
@@ -5603,7 +11642,31 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateActivitySettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateActivitySettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_betterreads_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateUserProfileRequest); i {
 			case 0:
 				return &v.state
@@ -5615,7 +11678,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateUserProfileResponse); i {
 			case 0:
 				return &v.state
@@ -5627,7 +11690,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserByIdRequest); i {
 			case 0:
 				return &v.state
@@ -5639,7 +11702,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserByIdResponse); i {
 			case 0:
 				return &v.state
@@ -5651,7 +11714,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FollowUserRequest); i {
 			case 0:
 				return &v.state
@@ -5663,7 +11726,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FollowUserResponse); i {
 			case 0:
 				return &v.state
@@ -5675,7 +11738,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnfollowUserRequest); i {
 			case 0:
 				return &v.state
@@ -5687,7 +11750,7 @@ func file_betterreads_proto_init() {
 				return nil
 			}
 		}
-		file_betterreads_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_betterreads_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnfollowUserResponse); i {
 			case 0:
 				return &v.state
@@ -5700,13 +11763,15 @@ func file_betterreads_proto_init() {
 			}
 		}
 	}
+	file_betterreads_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_betterreads_proto_msgTypes[65].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_betterreads_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   64,
+			NumEnums:      8,
+			NumMessages:   122,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
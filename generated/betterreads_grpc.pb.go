@@ -19,34 +19,56 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	BetterReadsService_SearchBooks_FullMethodName           = "/betterreads.BetterReadsService/SearchBooks"
-	BetterReadsService_GetPersonalizedFeed_FullMethodName   = "/betterreads.BetterReadsService/GetPersonalizedFeed"
-	BetterReadsService_GetUserFeed_FullMethodName           = "/betterreads.BetterReadsService/GetUserFeed"
-	BetterReadsService_RemoveLibraryBook_FullMethodName     = "/betterreads.BetterReadsService/RemoveLibraryBook"
-	BetterReadsService_UpdateLibraryBook_FullMethodName     = "/betterreads.BetterReadsService/UpdateLibraryBook"
-	BetterReadsService_GetUserLibrary_FullMethodName        = "/betterreads.BetterReadsService/GetUserLibrary"
-	BetterReadsService_CreateShelf_FullMethodName           = "/betterreads.BetterReadsService/CreateShelf"
-	BetterReadsService_UpdateShelf_FullMethodName           = "/betterreads.BetterReadsService/UpdateShelf"
-	BetterReadsService_DeleteShelf_FullMethodName           = "/betterreads.BetterReadsService/DeleteShelf"
-	BetterReadsService_GetUserShelves_FullMethodName        = "/betterreads.BetterReadsService/GetUserShelves"
-	BetterReadsService_GetShelfBooks_FullMethodName         = "/betterreads.BetterReadsService/GetShelfBooks"
-	BetterReadsService_AddBookToShelf_FullMethodName        = "/betterreads.BetterReadsService/AddBookToShelf"
-	BetterReadsService_RemoveBookFromShelf_FullMethodName   = "/betterreads.BetterReadsService/RemoveBookFromShelf"
-	BetterReadsService_CreatePost_FullMethodName            = "/betterreads.BetterReadsService/CreatePost"
-	BetterReadsService_DeletePost_FullMethodName            = "/betterreads.BetterReadsService/DeletePost"
-	BetterReadsService_UpdatePost_FullMethodName            = "/betterreads.BetterReadsService/UpdatePost"
-	BetterReadsService_GetCommentsForPost_FullMethodName    = "/betterreads.BetterReadsService/GetCommentsForPost"
-	BetterReadsService_AddComment_FullMethodName            = "/betterreads.BetterReadsService/AddComment"
-	BetterReadsService_DeleteComment_FullMethodName         = "/betterreads.BetterReadsService/DeleteComment"
-	BetterReadsService_LikePost_FullMethodName              = "/betterreads.BetterReadsService/LikePost"
-	BetterReadsService_UnlikePost_FullMethodName            = "/betterreads.BetterReadsService/UnlikePost"
-	BetterReadsService_DeleteUserProfile_FullMethodName     = "/betterreads.BetterReadsService/DeleteUserProfile"
-	BetterReadsService_GetCurrentUserProfile_FullMethodName = "/betterreads.BetterReadsService/GetCurrentUserProfile"
-	BetterReadsService_UpdateUserProfile_FullMethodName     = "/betterreads.BetterReadsService/UpdateUserProfile"
-	BetterReadsService_CreateUserProfile_FullMethodName     = "/betterreads.BetterReadsService/CreateUserProfile"
-	BetterReadsService_GetUserById_FullMethodName           = "/betterreads.BetterReadsService/GetUserById"
-	BetterReadsService_FollowUser_FullMethodName            = "/betterreads.BetterReadsService/FollowUser"
-	BetterReadsService_UnfollowUser_FullMethodName          = "/betterreads.BetterReadsService/UnfollowUser"
+	BetterReadsService_SearchBooks_FullMethodName            = "/betterreads.BetterReadsService/SearchBooks"
+	BetterReadsService_GetBook_FullMethodName                = "/betterreads.BetterReadsService/GetBook"
+	BetterReadsService_CreateManualBook_FullMethodName       = "/betterreads.BetterReadsService/CreateManualBook"
+	BetterReadsService_GetAuthor_FullMethodName              = "/betterreads.BetterReadsService/GetAuthor"
+	BetterReadsService_GetPersonalizedFeed_FullMethodName    = "/betterreads.BetterReadsService/GetPersonalizedFeed"
+	BetterReadsService_GetUserFeed_FullMethodName            = "/betterreads.BetterReadsService/GetUserFeed"
+	BetterReadsService_RemoveLibraryBook_FullMethodName      = "/betterreads.BetterReadsService/RemoveLibraryBook"
+	BetterReadsService_UpdateLibraryBook_FullMethodName      = "/betterreads.BetterReadsService/UpdateLibraryBook"
+	BetterReadsService_ImportGoodreadsLibrary_FullMethodName = "/betterreads.BetterReadsService/ImportGoodreadsLibrary"
+	BetterReadsService_ImportLibrary_FullMethodName          = "/betterreads.BetterReadsService/ImportLibrary"
+	BetterReadsService_ExportLibrary_FullMethodName          = "/betterreads.BetterReadsService/ExportLibrary"
+	BetterReadsService_GetUserLibrary_FullMethodName         = "/betterreads.BetterReadsService/GetUserLibrary"
+	BetterReadsService_SearchLibrary_FullMethodName          = "/betterreads.BetterReadsService/SearchLibrary"
+	BetterReadsService_CreateShelf_FullMethodName            = "/betterreads.BetterReadsService/CreateShelf"
+	BetterReadsService_UpdateShelf_FullMethodName            = "/betterreads.BetterReadsService/UpdateShelf"
+	BetterReadsService_DeleteShelf_FullMethodName            = "/betterreads.BetterReadsService/DeleteShelf"
+	BetterReadsService_GetUserShelves_FullMethodName         = "/betterreads.BetterReadsService/GetUserShelves"
+	BetterReadsService_GetShelfBooks_FullMethodName          = "/betterreads.BetterReadsService/GetShelfBooks"
+	BetterReadsService_AddBookToShelf_FullMethodName         = "/betterreads.BetterReadsService/AddBookToShelf"
+	BetterReadsService_RemoveBookFromShelf_FullMethodName    = "/betterreads.BetterReadsService/RemoveBookFromShelf"
+	BetterReadsService_LogReadingProgress_FullMethodName     = "/betterreads.BetterReadsService/LogReadingProgress"
+	BetterReadsService_GetReadingProgress_FullMethodName     = "/betterreads.BetterReadsService/GetReadingProgress"
+	BetterReadsService_CreateReadingSession_FullMethodName   = "/betterreads.BetterReadsService/CreateReadingSession"
+	BetterReadsService_UpdateReadingSession_FullMethodName   = "/betterreads.BetterReadsService/UpdateReadingSession"
+	BetterReadsService_DeleteReadingSession_FullMethodName   = "/betterreads.BetterReadsService/DeleteReadingSession"
+	BetterReadsService_CreateHighlight_FullMethodName        = "/betterreads.BetterReadsService/CreateHighlight"
+	BetterReadsService_UpdateHighlight_FullMethodName        = "/betterreads.BetterReadsService/UpdateHighlight"
+	BetterReadsService_DeleteHighlight_FullMethodName        = "/betterreads.BetterReadsService/DeleteHighlight"
+	BetterReadsService_ListHighlights_FullMethodName         = "/betterreads.BetterReadsService/ListHighlights"
+	BetterReadsService_ImportKindleHighlights_FullMethodName = "/betterreads.BetterReadsService/ImportKindleHighlights"
+	BetterReadsService_SetReadingGoal_FullMethodName         = "/betterreads.BetterReadsService/SetReadingGoal"
+	BetterReadsService_GetReadingGoal_FullMethodName         = "/betterreads.BetterReadsService/GetReadingGoal"
+	BetterReadsService_DeleteReadingGoal_FullMethodName      = "/betterreads.BetterReadsService/DeleteReadingGoal"
+	BetterReadsService_GetReadingStats_FullMethodName        = "/betterreads.BetterReadsService/GetReadingStats"
+	BetterReadsService_CreatePost_FullMethodName             = "/betterreads.BetterReadsService/CreatePost"
+	BetterReadsService_DeletePost_FullMethodName             = "/betterreads.BetterReadsService/DeletePost"
+	BetterReadsService_UpdatePost_FullMethodName             = "/betterreads.BetterReadsService/UpdatePost"
+	BetterReadsService_GetCommentsForPost_FullMethodName     = "/betterreads.BetterReadsService/GetCommentsForPost"
+	BetterReadsService_AddComment_FullMethodName             = "/betterreads.BetterReadsService/AddComment"
+	BetterReadsService_DeleteComment_FullMethodName          = "/betterreads.BetterReadsService/DeleteComment"
+	BetterReadsService_LikePost_FullMethodName               = "/betterreads.BetterReadsService/LikePost"
+	BetterReadsService_UnlikePost_FullMethodName             = "/betterreads.BetterReadsService/UnlikePost"
+	BetterReadsService_DeleteUserProfile_FullMethodName      = "/betterreads.BetterReadsService/DeleteUserProfile"
+	BetterReadsService_GetCurrentUserProfile_FullMethodName  = "/betterreads.BetterReadsService/GetCurrentUserProfile"
+	BetterReadsService_UpdateUserProfile_FullMethodName      = "/betterreads.BetterReadsService/UpdateUserProfile"
+	BetterReadsService_UpdateActivitySettings_FullMethodName = "/betterreads.BetterReadsService/UpdateActivitySettings"
+	BetterReadsService_CreateUserProfile_FullMethodName      = "/betterreads.BetterReadsService/CreateUserProfile"
+	BetterReadsService_GetUserById_FullMethodName            = "/betterreads.BetterReadsService/GetUserById"
+	BetterReadsService_FollowUser_FullMethodName             = "/betterreads.BetterReadsService/FollowUser"
+	BetterReadsService_UnfollowUser_FullMethodName           = "/betterreads.BetterReadsService/UnfollowUser"
 )
 
 // BetterReadsServiceClient is the client API for BetterReadsService service.
@@ -55,6 +77,12 @@ const (
 type BetterReadsServiceClient interface {
 	// Search for books
 	SearchBooks(ctx context.Context, in *SearchBooksRequest, opts ...grpc.CallOption) (*SearchBooksResponse, error)
+	// Get one book's details
+	GetBook(ctx context.Context, in *GetBookRequest, opts ...grpc.CallOption) (*GetBookResponse, error)
+	// Add a book that no source has to the catalog, so it can be shelved
+	CreateManualBook(ctx context.Context, in *CreateManualBookRequest, opts ...grpc.CallOption) (*CreateManualBookResponse, error)
+	// Get an author and a page of their bibliography
+	GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpc.CallOption) (*GetAuthorResponse, error)
 	// Get personalized feed
 	GetPersonalizedFeed(ctx context.Context, in *GetPersonalizedFeedRequest, opts ...grpc.CallOption) (*GetPersonalizedFeedResponse, error)
 	// Get user's public posts
@@ -63,8 +91,16 @@ type BetterReadsServiceClient interface {
 	RemoveLibraryBook(ctx context.Context, in *RemoveLibraryBookRequest, opts ...grpc.CallOption) (*RemoveLibraryBookResponse, error)
 	// Add or update book in library
 	UpdateLibraryBook(ctx context.Context, in *UpdateLibraryBookRequest, opts ...grpc.CallOption) (*UpdateLibraryBookResponse, error)
+	// Import a Goodreads library export CSV
+	ImportGoodreadsLibrary(ctx context.Context, in *ImportGoodreadsLibraryRequest, opts ...grpc.CallOption) (*ImportGoodreadsLibraryResponse, error)
+	// Import a library export from Goodreads, StoryGraph or LibraryThing; with dry_run set nothing is written
+	ImportLibrary(ctx context.Context, in *ImportLibraryRequest, opts ...grpc.CallOption) (*ImportLibraryResponse, error)
+	// Stream the caller's library as a file; served over HTTP by GET /api/v1/library/export
+	ExportLibrary(ctx context.Context, in *ExportLibraryRequest, opts ...grpc.CallOption) (BetterReadsService_ExportLibraryClient, error)
 	// Get user's library (all books grouped by shelf)
 	GetUserLibrary(ctx context.Context, in *GetUserLibraryRequest, opts ...grpc.CallOption) (*GetUserLibraryResponse, error)
+	// Search the caller's library by title, author, review, notes and tags
+	SearchLibrary(ctx context.Context, in *SearchLibraryRequest, opts ...grpc.CallOption) (*SearchLibraryResponse, error)
 	// Create a new shelf
 	CreateShelf(ctx context.Context, in *CreateShelfRequest, opts ...grpc.CallOption) (*CreateShelfResponse, error)
 	// Update shelf (rename or reorder)
@@ -79,6 +115,34 @@ type BetterReadsServiceClient interface {
 	AddBookToShelf(ctx context.Context, in *AddBookToShelfRequest, opts ...grpc.CallOption) (*AddBookToShelfResponse, error)
 	// Remove book from specific shelves (book remains in library if on other shelves)
 	RemoveBookFromShelf(ctx context.Context, in *RemoveBookFromShelfRequest, opts ...grpc.CallOption) (*RemoveBookFromShelfResponse, error)
+	// Log how far the caller has read a book in their library; moves it to READING on the first entry unless it is READ, and to READ at 100%
+	LogReadingProgress(ctx context.Context, in *LogReadingProgressRequest, opts ...grpc.CallOption) (*LogReadingProgressResponse, error)
+	// Get the caller's progress history for a book in their library, newest first
+	GetReadingProgress(ctx context.Context, in *GetReadingProgressRequest, opts ...grpc.CallOption) (*GetReadingProgressResponse, error)
+	// Record a past or current read of a book in the caller's library
+	CreateReadingSession(ctx context.Context, in *CreateReadingSessionRequest, opts ...grpc.CallOption) (*CreateReadingSessionResponse, error)
+	// Replace the dates, DNF flag and rating of a reading session
+	UpdateReadingSession(ctx context.Context, in *UpdateReadingSessionRequest, opts ...grpc.CallOption) (*UpdateReadingSessionResponse, error)
+	// Delete a reading session
+	DeleteReadingSession(ctx context.Context, in *DeleteReadingSessionRequest, opts ...grpc.CallOption) (*DeleteReadingSessionResponse, error)
+	// Save a quote from a book in the caller's library
+	CreateHighlight(ctx context.Context, in *CreateHighlightRequest, opts ...grpc.CallOption) (*CreateHighlightResponse, error)
+	// Replace the quote, position, comment and visibility of a highlight
+	UpdateHighlight(ctx context.Context, in *UpdateHighlightRequest, opts ...grpc.CallOption) (*UpdateHighlightResponse, error)
+	// Delete a highlight
+	DeleteHighlight(ctx context.Context, in *DeleteHighlightRequest, opts ...grpc.CallOption) (*DeleteHighlightResponse, error)
+	// List a user's highlights across their library or for one book; other users' private highlights are left out
+	ListHighlights(ctx context.Context, in *ListHighlightsRequest, opts ...grpc.CallOption) (*ListHighlightsResponse, error)
+	// Import highlights and notes from a Kindle "My Clippings.txt" into books already in the caller's library
+	ImportKindleHighlights(ctx context.Context, in *ImportKindleHighlightsRequest, opts ...grpc.CallOption) (*ImportKindleHighlightsResponse, error)
+	// Set the caller's reading goal for a year, replacing any existing one
+	SetReadingGoal(ctx context.Context, in *SetReadingGoalRequest, opts ...grpc.CallOption) (*SetReadingGoalResponse, error)
+	// Get the caller's reading goal for a year with the progress made towards it
+	GetReadingGoal(ctx context.Context, in *GetReadingGoalRequest, opts ...grpc.CallOption) (*GetReadingGoalResponse, error)
+	// Delete the caller's reading goal for a year
+	DeleteReadingGoal(ctx context.Context, in *DeleteReadingGoalRequest, opts ...grpc.CallOption) (*DeleteReadingGoalResponse, error)
+	// Summarize the reads the caller finished or abandoned in a time range of at most 10 years, for profile and year-in-review screens
+	GetReadingStats(ctx context.Context, in *GetReadingStatsRequest, opts ...grpc.CallOption) (*GetReadingStatsResponse, error)
 	// Create a new post
 	CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error)
 	// Delete a post
@@ -101,6 +165,8 @@ type BetterReadsServiceClient interface {
 	GetCurrentUserProfile(ctx context.Context, in *GetCurrentUserProfileRequest, opts ...grpc.CallOption) (*GetCurrentUserProfileResponse, error)
 	// Update user profile
 	UpdateUserProfile(ctx context.Context, in *UpdateUserProfileRequest, opts ...grpc.CallOption) (*UpdateUserProfileResponse, error)
+	// Opt in or out of automatic posts generated from library changes
+	UpdateActivitySettings(ctx context.Context, in *UpdateActivitySettingsRequest, opts ...grpc.CallOption) (*UpdateActivitySettingsResponse, error)
 	// Create user profile
 	CreateUserProfile(ctx context.Context, in *CreateUserProfileRequest, opts ...grpc.CallOption) (*CreateUserProfileResponse, error)
 	// Get user information
@@ -128,6 +194,33 @@ func (c *betterReadsServiceClient) SearchBooks(ctx context.Context, in *SearchBo
 	return out, nil
 }
 
+func (c *betterReadsServiceClient) GetBook(ctx context.Context, in *GetBookRequest, opts ...grpc.CallOption) (*GetBookResponse, error) {
+	out := new(GetBookResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_GetBook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) CreateManualBook(ctx context.Context, in *CreateManualBookRequest, opts ...grpc.CallOption) (*CreateManualBookResponse, error) {
+	out := new(CreateManualBookResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_CreateManualBook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) GetAuthor(ctx context.Context, in *GetAuthorRequest, opts ...grpc.CallOption) (*GetAuthorResponse, error) {
+	out := new(GetAuthorResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_GetAuthor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *betterReadsServiceClient) GetPersonalizedFeed(ctx context.Context, in *GetPersonalizedFeedRequest, opts ...grpc.CallOption) (*GetPersonalizedFeedResponse, error) {
 	out := new(GetPersonalizedFeedResponse)
 	err := c.cc.Invoke(ctx, BetterReadsService_GetPersonalizedFeed_FullMethodName, in, out, opts...)
@@ -164,6 +257,56 @@ func (c *betterReadsServiceClient) UpdateLibraryBook(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *betterReadsServiceClient) ImportGoodreadsLibrary(ctx context.Context, in *ImportGoodreadsLibraryRequest, opts ...grpc.CallOption) (*ImportGoodreadsLibraryResponse, error) {
+	out := new(ImportGoodreadsLibraryResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_ImportGoodreadsLibrary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) ImportLibrary(ctx context.Context, in *ImportLibraryRequest, opts ...grpc.CallOption) (*ImportLibraryResponse, error) {
+	out := new(ImportLibraryResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_ImportLibrary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) ExportLibrary(ctx context.Context, in *ExportLibraryRequest, opts ...grpc.CallOption) (BetterReadsService_ExportLibraryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BetterReadsService_ServiceDesc.Streams[0], BetterReadsService_ExportLibrary_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &betterReadsServiceExportLibraryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BetterReadsService_ExportLibraryClient interface {
+	Recv() (*ExportLibraryResponse, error)
+	grpc.ClientStream
+}
+
+type betterReadsServiceExportLibraryClient struct {
+	grpc.ClientStream
+}
+
+func (x *betterReadsServiceExportLibraryClient) Recv() (*ExportLibraryResponse, error) {
+	m := new(ExportLibraryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *betterReadsServiceClient) GetUserLibrary(ctx context.Context, in *GetUserLibraryRequest, opts ...grpc.CallOption) (*GetUserLibraryResponse, error) {
 	out := new(GetUserLibraryResponse)
 	err := c.cc.Invoke(ctx, BetterReadsService_GetUserLibrary_FullMethodName, in, out, opts...)
@@ -173,6 +316,15 @@ func (c *betterReadsServiceClient) GetUserLibrary(ctx context.Context, in *GetUs
 	return out, nil
 }
 
+func (c *betterReadsServiceClient) SearchLibrary(ctx context.Context, in *SearchLibraryRequest, opts ...grpc.CallOption) (*SearchLibraryResponse, error) {
+	out := new(SearchLibraryResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_SearchLibrary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *betterReadsServiceClient) CreateShelf(ctx context.Context, in *CreateShelfRequest, opts ...grpc.CallOption) (*CreateShelfResponse, error) {
 	out := new(CreateShelfResponse)
 	err := c.cc.Invoke(ctx, BetterReadsService_CreateShelf_FullMethodName, in, out, opts...)
@@ -236,6 +388,132 @@ func (c *betterReadsServiceClient) RemoveBookFromShelf(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *betterReadsServiceClient) LogReadingProgress(ctx context.Context, in *LogReadingProgressRequest, opts ...grpc.CallOption) (*LogReadingProgressResponse, error) {
+	out := new(LogReadingProgressResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_LogReadingProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) GetReadingProgress(ctx context.Context, in *GetReadingProgressRequest, opts ...grpc.CallOption) (*GetReadingProgressResponse, error) {
+	out := new(GetReadingProgressResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_GetReadingProgress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) CreateReadingSession(ctx context.Context, in *CreateReadingSessionRequest, opts ...grpc.CallOption) (*CreateReadingSessionResponse, error) {
+	out := new(CreateReadingSessionResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_CreateReadingSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) UpdateReadingSession(ctx context.Context, in *UpdateReadingSessionRequest, opts ...grpc.CallOption) (*UpdateReadingSessionResponse, error) {
+	out := new(UpdateReadingSessionResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_UpdateReadingSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) DeleteReadingSession(ctx context.Context, in *DeleteReadingSessionRequest, opts ...grpc.CallOption) (*DeleteReadingSessionResponse, error) {
+	out := new(DeleteReadingSessionResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_DeleteReadingSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) CreateHighlight(ctx context.Context, in *CreateHighlightRequest, opts ...grpc.CallOption) (*CreateHighlightResponse, error) {
+	out := new(CreateHighlightResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_CreateHighlight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) UpdateHighlight(ctx context.Context, in *UpdateHighlightRequest, opts ...grpc.CallOption) (*UpdateHighlightResponse, error) {
+	out := new(UpdateHighlightResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_UpdateHighlight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) DeleteHighlight(ctx context.Context, in *DeleteHighlightRequest, opts ...grpc.CallOption) (*DeleteHighlightResponse, error) {
+	out := new(DeleteHighlightResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_DeleteHighlight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) ListHighlights(ctx context.Context, in *ListHighlightsRequest, opts ...grpc.CallOption) (*ListHighlightsResponse, error) {
+	out := new(ListHighlightsResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_ListHighlights_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) ImportKindleHighlights(ctx context.Context, in *ImportKindleHighlightsRequest, opts ...grpc.CallOption) (*ImportKindleHighlightsResponse, error) {
+	out := new(ImportKindleHighlightsResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_ImportKindleHighlights_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) SetReadingGoal(ctx context.Context, in *SetReadingGoalRequest, opts ...grpc.CallOption) (*SetReadingGoalResponse, error) {
+	out := new(SetReadingGoalResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_SetReadingGoal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) GetReadingGoal(ctx context.Context, in *GetReadingGoalRequest, opts ...grpc.CallOption) (*GetReadingGoalResponse, error) {
+	out := new(GetReadingGoalResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_GetReadingGoal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) DeleteReadingGoal(ctx context.Context, in *DeleteReadingGoalRequest, opts ...grpc.CallOption) (*DeleteReadingGoalResponse, error) {
+	out := new(DeleteReadingGoalResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_DeleteReadingGoal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *betterReadsServiceClient) GetReadingStats(ctx context.Context, in *GetReadingStatsRequest, opts ...grpc.CallOption) (*GetReadingStatsResponse, error) {
+	out := new(GetReadingStatsResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_GetReadingStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *betterReadsServiceClient) CreatePost(ctx context.Context, in *CreatePostRequest, opts ...grpc.CallOption) (*CreatePostResponse, error) {
 	out := new(CreatePostResponse)
 	err := c.cc.Invoke(ctx, BetterReadsService_CreatePost_FullMethodName, in, out, opts...)
@@ -335,6 +613,15 @@ func (c *betterReadsServiceClient) UpdateUserProfile(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *betterReadsServiceClient) UpdateActivitySettings(ctx context.Context, in *UpdateActivitySettingsRequest, opts ...grpc.CallOption) (*UpdateActivitySettingsResponse, error) {
+	out := new(UpdateActivitySettingsResponse)
+	err := c.cc.Invoke(ctx, BetterReadsService_UpdateActivitySettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *betterReadsServiceClient) CreateUserProfile(ctx context.Context, in *CreateUserProfileRequest, opts ...grpc.CallOption) (*CreateUserProfileResponse, error) {
 	out := new(CreateUserProfileResponse)
 	err := c.cc.Invoke(ctx, BetterReadsService_CreateUserProfile_FullMethodName, in, out, opts...)
@@ -377,6 +664,12 @@ func (c *betterReadsServiceClient) UnfollowUser(ctx context.Context, in *Unfollo
 type BetterReadsServiceServer interface {
 	// Search for books
 	SearchBooks(context.Context, *SearchBooksRequest) (*SearchBooksResponse, error)
+	// Get one book's details
+	GetBook(context.Context, *GetBookRequest) (*GetBookResponse, error)
+	// Add a book that no source has to the catalog, so it can be shelved
+	CreateManualBook(context.Context, *CreateManualBookRequest) (*CreateManualBookResponse, error)
+	// Get an author and a page of their bibliography
+	GetAuthor(context.Context, *GetAuthorRequest) (*GetAuthorResponse, error)
 	// Get personalized feed
 	GetPersonalizedFeed(context.Context, *GetPersonalizedFeedRequest) (*GetPersonalizedFeedResponse, error)
 	// Get user's public posts
@@ -385,8 +678,16 @@ type BetterReadsServiceServer interface {
 	RemoveLibraryBook(context.Context, *RemoveLibraryBookRequest) (*RemoveLibraryBookResponse, error)
 	// Add or update book in library
 	UpdateLibraryBook(context.Context, *UpdateLibraryBookRequest) (*UpdateLibraryBookResponse, error)
+	// Import a Goodreads library export CSV
+	ImportGoodreadsLibrary(context.Context, *ImportGoodreadsLibraryRequest) (*ImportGoodreadsLibraryResponse, error)
+	// Import a library export from Goodreads, StoryGraph or LibraryThing; with dry_run set nothing is written
+	ImportLibrary(context.Context, *ImportLibraryRequest) (*ImportLibraryResponse, error)
+	// Stream the caller's library as a file; served over HTTP by GET /api/v1/library/export
+	ExportLibrary(*ExportLibraryRequest, BetterReadsService_ExportLibraryServer) error
 	// Get user's library (all books grouped by shelf)
 	GetUserLibrary(context.Context, *GetUserLibraryRequest) (*GetUserLibraryResponse, error)
+	// Search the caller's library by title, author, review, notes and tags
+	SearchLibrary(context.Context, *SearchLibraryRequest) (*SearchLibraryResponse, error)
 	// Create a new shelf
 	CreateShelf(context.Context, *CreateShelfRequest) (*CreateShelfResponse, error)
 	// Update shelf (rename or reorder)
@@ -401,6 +702,34 @@ type BetterReadsServiceServer interface {
 	AddBookToShelf(context.Context, *AddBookToShelfRequest) (*AddBookToShelfResponse, error)
 	// Remove book from specific shelves (book remains in library if on other shelves)
 	RemoveBookFromShelf(context.Context, *RemoveBookFromShelfRequest) (*RemoveBookFromShelfResponse, error)
+	// Log how far the caller has read a book in their library; moves it to READING on the first entry unless it is READ, and to READ at 100%
+	LogReadingProgress(context.Context, *LogReadingProgressRequest) (*LogReadingProgressResponse, error)
+	// Get the caller's progress history for a book in their library, newest first
+	GetReadingProgress(context.Context, *GetReadingProgressRequest) (*GetReadingProgressResponse, error)
+	// Record a past or current read of a book in the caller's library
+	CreateReadingSession(context.Context, *CreateReadingSessionRequest) (*CreateReadingSessionResponse, error)
+	// Replace the dates, DNF flag and rating of a reading session
+	UpdateReadingSession(context.Context, *UpdateReadingSessionRequest) (*UpdateReadingSessionResponse, error)
+	// Delete a reading session
+	DeleteReadingSession(context.Context, *DeleteReadingSessionRequest) (*DeleteReadingSessionResponse, error)
+	// Save a quote from a book in the caller's library
+	CreateHighlight(context.Context, *CreateHighlightRequest) (*CreateHighlightResponse, error)
+	// Replace the quote, position, comment and visibility of a highlight
+	UpdateHighlight(context.Context, *UpdateHighlightRequest) (*UpdateHighlightResponse, error)
+	// Delete a highlight
+	DeleteHighlight(context.Context, *DeleteHighlightRequest) (*DeleteHighlightResponse, error)
+	// List a user's highlights across their library or for one book; other users' private highlights are left out
+	ListHighlights(context.Context, *ListHighlightsRequest) (*ListHighlightsResponse, error)
+	// Import highlights and notes from a Kindle "My Clippings.txt" into books already in the caller's library
+	ImportKindleHighlights(context.Context, *ImportKindleHighlightsRequest) (*ImportKindleHighlightsResponse, error)
+	// Set the caller's reading goal for a year, replacing any existing one
+	SetReadingGoal(context.Context, *SetReadingGoalRequest) (*SetReadingGoalResponse, error)
+	// Get the caller's reading goal for a year with the progress made towards it
+	GetReadingGoal(context.Context, *GetReadingGoalRequest) (*GetReadingGoalResponse, error)
+	// Delete the caller's reading goal for a year
+	DeleteReadingGoal(context.Context, *DeleteReadingGoalRequest) (*DeleteReadingGoalResponse, error)
+	// Summarize the reads the caller finished or abandoned in a time range of at most 10 years, for profile and year-in-review screens
+	GetReadingStats(context.Context, *GetReadingStatsRequest) (*GetReadingStatsResponse, error)
 	// Create a new post
 	CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error)
 	// Delete a post
@@ -423,6 +752,8 @@ type BetterReadsServiceServer interface {
 	GetCurrentUserProfile(context.Context, *GetCurrentUserProfileRequest) (*GetCurrentUserProfileResponse, error)
 	// Update user profile
 	UpdateUserProfile(context.Context, *UpdateUserProfileRequest) (*UpdateUserProfileResponse, error)
+	// Opt in or out of automatic posts generated from library changes
+	UpdateActivitySettings(context.Context, *UpdateActivitySettingsRequest) (*UpdateActivitySettingsResponse, error)
 	// Create user profile
 	CreateUserProfile(context.Context, *CreateUserProfileRequest) (*CreateUserProfileResponse, error)
 	// Get user information
@@ -441,6 +772,15 @@ type UnimplementedBetterReadsServiceServer struct {
 func (UnimplementedBetterReadsServiceServer) SearchBooks(context.Context, *SearchBooksRequest) (*SearchBooksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchBooks not implemented")
 }
+func (UnimplementedBetterReadsServiceServer) GetBook(context.Context, *GetBookRequest) (*GetBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBook not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) CreateManualBook(context.Context, *CreateManualBookRequest) (*CreateManualBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateManualBook not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) GetAuthor(context.Context, *GetAuthorRequest) (*GetAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuthor not implemented")
+}
 func (UnimplementedBetterReadsServiceServer) GetPersonalizedFeed(context.Context, *GetPersonalizedFeedRequest) (*GetPersonalizedFeedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPersonalizedFeed not implemented")
 }
@@ -453,9 +793,21 @@ func (UnimplementedBetterReadsServiceServer) RemoveLibraryBook(context.Context,
 func (UnimplementedBetterReadsServiceServer) UpdateLibraryBook(context.Context, *UpdateLibraryBookRequest) (*UpdateLibraryBookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateLibraryBook not implemented")
 }
+func (UnimplementedBetterReadsServiceServer) ImportGoodreadsLibrary(context.Context, *ImportGoodreadsLibraryRequest) (*ImportGoodreadsLibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGoodreadsLibrary not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) ImportLibrary(context.Context, *ImportLibraryRequest) (*ImportLibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportLibrary not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) ExportLibrary(*ExportLibraryRequest, BetterReadsService_ExportLibraryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportLibrary not implemented")
+}
 func (UnimplementedBetterReadsServiceServer) GetUserLibrary(context.Context, *GetUserLibraryRequest) (*GetUserLibraryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserLibrary not implemented")
 }
+func (UnimplementedBetterReadsServiceServer) SearchLibrary(context.Context, *SearchLibraryRequest) (*SearchLibraryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLibrary not implemented")
+}
 func (UnimplementedBetterReadsServiceServer) CreateShelf(context.Context, *CreateShelfRequest) (*CreateShelfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateShelf not implemented")
 }
@@ -477,6 +829,48 @@ func (UnimplementedBetterReadsServiceServer) AddBookToShelf(context.Context, *Ad
 func (UnimplementedBetterReadsServiceServer) RemoveBookFromShelf(context.Context, *RemoveBookFromShelfRequest) (*RemoveBookFromShelfResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveBookFromShelf not implemented")
 }
+func (UnimplementedBetterReadsServiceServer) LogReadingProgress(context.Context, *LogReadingProgressRequest) (*LogReadingProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogReadingProgress not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) GetReadingProgress(context.Context, *GetReadingProgressRequest) (*GetReadingProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadingProgress not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) CreateReadingSession(context.Context, *CreateReadingSessionRequest) (*CreateReadingSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReadingSession not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) UpdateReadingSession(context.Context, *UpdateReadingSessionRequest) (*UpdateReadingSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReadingSession not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) DeleteReadingSession(context.Context, *DeleteReadingSessionRequest) (*DeleteReadingSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReadingSession not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) CreateHighlight(context.Context, *CreateHighlightRequest) (*CreateHighlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHighlight not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) UpdateHighlight(context.Context, *UpdateHighlightRequest) (*UpdateHighlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHighlight not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) DeleteHighlight(context.Context, *DeleteHighlightRequest) (*DeleteHighlightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHighlight not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) ListHighlights(context.Context, *ListHighlightsRequest) (*ListHighlightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHighlights not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) ImportKindleHighlights(context.Context, *ImportKindleHighlightsRequest) (*ImportKindleHighlightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKindleHighlights not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) SetReadingGoal(context.Context, *SetReadingGoalRequest) (*SetReadingGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReadingGoal not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) GetReadingGoal(context.Context, *GetReadingGoalRequest) (*GetReadingGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadingGoal not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) DeleteReadingGoal(context.Context, *DeleteReadingGoalRequest) (*DeleteReadingGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReadingGoal not implemented")
+}
+func (UnimplementedBetterReadsServiceServer) GetReadingStats(context.Context, *GetReadingStatsRequest) (*GetReadingStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReadingStats not implemented")
+}
 func (UnimplementedBetterReadsServiceServer) CreatePost(context.Context, *CreatePostRequest) (*CreatePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
 }
@@ -510,6 +904,9 @@ func (UnimplementedBetterReadsServiceServer) GetCurrentUserProfile(context.Conte
 func (UnimplementedBetterReadsServiceServer) UpdateUserProfile(context.Context, *UpdateUserProfileRequest) (*UpdateUserProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserProfile not implemented")
 }
+func (UnimplementedBetterReadsServiceServer) UpdateActivitySettings(context.Context, *UpdateActivitySettingsRequest) (*UpdateActivitySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActivitySettings not implemented")
+}
 func (UnimplementedBetterReadsServiceServer) CreateUserProfile(context.Context, *CreateUserProfileRequest) (*CreateUserProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUserProfile not implemented")
 }
@@ -553,6 +950,60 @@ func _BetterReadsService_SearchBooks_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BetterReadsService_GetBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).GetBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_GetBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).GetBook(ctx, req.(*GetBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_CreateManualBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateManualBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).CreateManualBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_CreateManualBook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).CreateManualBook(ctx, req.(*CreateManualBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_GetAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).GetAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_GetAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).GetAuthor(ctx, req.(*GetAuthorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BetterReadsService_GetPersonalizedFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPersonalizedFeedRequest)
 	if err := dec(in); err != nil {
@@ -625,6 +1076,63 @@ func _BetterReadsService_UpdateLibraryBook_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BetterReadsService_ImportGoodreadsLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGoodreadsLibraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).ImportGoodreadsLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_ImportGoodreadsLibrary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).ImportGoodreadsLibrary(ctx, req.(*ImportGoodreadsLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_ImportLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportLibraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).ImportLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_ImportLibrary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).ImportLibrary(ctx, req.(*ImportLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_ExportLibrary_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportLibraryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BetterReadsServiceServer).ExportLibrary(m, &betterReadsServiceExportLibraryServer{stream})
+}
+
+type BetterReadsService_ExportLibraryServer interface {
+	Send(*ExportLibraryResponse) error
+	grpc.ServerStream
+}
+
+type betterReadsServiceExportLibraryServer struct {
+	grpc.ServerStream
+}
+
+func (x *betterReadsServiceExportLibraryServer) Send(m *ExportLibraryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _BetterReadsService_GetUserLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserLibraryRequest)
 	if err := dec(in); err != nil {
@@ -643,6 +1151,24 @@ func _BetterReadsService_GetUserLibrary_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BetterReadsService_SearchLibrary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLibraryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).SearchLibrary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_SearchLibrary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).SearchLibrary(ctx, req.(*SearchLibraryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BetterReadsService_CreateShelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateShelfRequest)
 	if err := dec(in); err != nil {
@@ -769,6 +1295,258 @@ func _BetterReadsService_RemoveBookFromShelf_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BetterReadsService_LogReadingProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogReadingProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).LogReadingProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_LogReadingProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).LogReadingProgress(ctx, req.(*LogReadingProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_GetReadingProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadingProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).GetReadingProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_GetReadingProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).GetReadingProgress(ctx, req.(*GetReadingProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_CreateReadingSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReadingSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).CreateReadingSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_CreateReadingSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).CreateReadingSession(ctx, req.(*CreateReadingSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_UpdateReadingSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReadingSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).UpdateReadingSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_UpdateReadingSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).UpdateReadingSession(ctx, req.(*UpdateReadingSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_DeleteReadingSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReadingSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).DeleteReadingSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_DeleteReadingSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).DeleteReadingSession(ctx, req.(*DeleteReadingSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_CreateHighlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHighlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).CreateHighlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_CreateHighlight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).CreateHighlight(ctx, req.(*CreateHighlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_UpdateHighlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHighlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).UpdateHighlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_UpdateHighlight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).UpdateHighlight(ctx, req.(*UpdateHighlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_DeleteHighlight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHighlightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).DeleteHighlight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_DeleteHighlight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).DeleteHighlight(ctx, req.(*DeleteHighlightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_ListHighlights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHighlightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).ListHighlights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_ListHighlights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).ListHighlights(ctx, req.(*ListHighlightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_ImportKindleHighlights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportKindleHighlightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).ImportKindleHighlights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_ImportKindleHighlights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).ImportKindleHighlights(ctx, req.(*ImportKindleHighlightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_SetReadingGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReadingGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).SetReadingGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_SetReadingGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).SetReadingGoal(ctx, req.(*SetReadingGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_GetReadingGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadingGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).GetReadingGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_GetReadingGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).GetReadingGoal(ctx, req.(*GetReadingGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_DeleteReadingGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReadingGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).DeleteReadingGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_DeleteReadingGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).DeleteReadingGoal(ctx, req.(*DeleteReadingGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BetterReadsService_GetReadingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReadingStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).GetReadingStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_GetReadingStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).GetReadingStats(ctx, req.(*GetReadingStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BetterReadsService_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreatePostRequest)
 	if err := dec(in); err != nil {
@@ -967,6 +1745,24 @@ func _BetterReadsService_UpdateUserProfile_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BetterReadsService_UpdateActivitySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateActivitySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BetterReadsServiceServer).UpdateActivitySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BetterReadsService_UpdateActivitySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BetterReadsServiceServer).UpdateActivitySettings(ctx, req.(*UpdateActivitySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BetterReadsService_CreateUserProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserProfileRequest)
 	if err := dec(in); err != nil {
@@ -1050,6 +1846,18 @@ var BetterReadsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchBooks",
 			Handler:    _BetterReadsService_SearchBooks_Handler,
 		},
+		{
+			MethodName: "GetBook",
+			Handler:    _BetterReadsService_GetBook_Handler,
+		},
+		{
+			MethodName: "CreateManualBook",
+			Handler:    _BetterReadsService_CreateManualBook_Handler,
+		},
+		{
+			MethodName: "GetAuthor",
+			Handler:    _BetterReadsService_GetAuthor_Handler,
+		},
 		{
 			MethodName: "GetPersonalizedFeed",
 			Handler:    _BetterReadsService_GetPersonalizedFeed_Handler,
@@ -1066,10 +1874,22 @@ var BetterReadsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateLibraryBook",
 			Handler:    _BetterReadsService_UpdateLibraryBook_Handler,
 		},
+		{
+			MethodName: "ImportGoodreadsLibrary",
+			Handler:    _BetterReadsService_ImportGoodreadsLibrary_Handler,
+		},
+		{
+			MethodName: "ImportLibrary",
+			Handler:    _BetterReadsService_ImportLibrary_Handler,
+		},
 		{
 			MethodName: "GetUserLibrary",
 			Handler:    _BetterReadsService_GetUserLibrary_Handler,
 		},
+		{
+			MethodName: "SearchLibrary",
+			Handler:    _BetterReadsService_SearchLibrary_Handler,
+		},
 		{
 			MethodName: "CreateShelf",
 			Handler:    _BetterReadsService_CreateShelf_Handler,
@@ -1098,6 +1918,62 @@ var BetterReadsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveBookFromShelf",
 			Handler:    _BetterReadsService_RemoveBookFromShelf_Handler,
 		},
+		{
+			MethodName: "LogReadingProgress",
+			Handler:    _BetterReadsService_LogReadingProgress_Handler,
+		},
+		{
+			MethodName: "GetReadingProgress",
+			Handler:    _BetterReadsService_GetReadingProgress_Handler,
+		},
+		{
+			MethodName: "CreateReadingSession",
+			Handler:    _BetterReadsService_CreateReadingSession_Handler,
+		},
+		{
+			MethodName: "UpdateReadingSession",
+			Handler:    _BetterReadsService_UpdateReadingSession_Handler,
+		},
+		{
+			MethodName: "DeleteReadingSession",
+			Handler:    _BetterReadsService_DeleteReadingSession_Handler,
+		},
+		{
+			MethodName: "CreateHighlight",
+			Handler:    _BetterReadsService_CreateHighlight_Handler,
+		},
+		{
+			MethodName: "UpdateHighlight",
+			Handler:    _BetterReadsService_UpdateHighlight_Handler,
+		},
+		{
+			MethodName: "DeleteHighlight",
+			Handler:    _BetterReadsService_DeleteHighlight_Handler,
+		},
+		{
+			MethodName: "ListHighlights",
+			Handler:    _BetterReadsService_ListHighlights_Handler,
+		},
+		{
+			MethodName: "ImportKindleHighlights",
+			Handler:    _BetterReadsService_ImportKindleHighlights_Handler,
+		},
+		{
+			MethodName: "SetReadingGoal",
+			Handler:    _BetterReadsService_SetReadingGoal_Handler,
+		},
+		{
+			MethodName: "GetReadingGoal",
+			Handler:    _BetterReadsService_GetReadingGoal_Handler,
+		},
+		{
+			MethodName: "DeleteReadingGoal",
+			Handler:    _BetterReadsService_DeleteReadingGoal_Handler,
+		},
+		{
+			MethodName: "GetReadingStats",
+			Handler:    _BetterReadsService_GetReadingStats_Handler,
+		},
 		{
 			MethodName: "CreatePost",
 			Handler:    _BetterReadsService_CreatePost_Handler,
@@ -1142,6 +2018,10 @@ var BetterReadsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUserProfile",
 			Handler:    _BetterReadsService_UpdateUserProfile_Handler,
 		},
+		{
+			MethodName: "UpdateActivitySettings",
+			Handler:    _BetterReadsService_UpdateActivitySettings_Handler,
+		},
 		{
 			MethodName: "CreateUserProfile",
 			Handler:    _BetterReadsService_CreateUserProfile_Handler,
@@ -1159,6 +2039,12 @@ var BetterReadsService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _BetterReadsService_UnfollowUser_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportLibrary",
+			Handler:       _BetterReadsService_ExportLibrary_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "betterreads.proto",
 }
@@ -0,0 +1,160 @@
+// Package catalog keeps the shared book catalog filled from search results
+// and up to date with the sources the books came from.
+package catalog
+
+import (
+	"context"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/logger"
+	"github.com/celestialdragonfly/betterreads/internal/openlibrary"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+)
+
+// Refresher defaults, used for zero fields.
+const (
+	DefaultInterval   = time.Hour
+	DefaultMaxAge     = 30 * 24 * time.Hour
+	DefaultBatchSize  = 100
+	DefaultRetryDelay = 6 * time.Hour
+)
+
+// Refresher checks catalog entries from Open Library against it once they are
+// older than MaxAge, so a library shows what Open Library says about a book
+// rather than what was sent when it was first added.
+type Refresher struct {
+	DB          postgres.API
+	OpenLibrary openlibrary.ClientInterface
+	// Interval is how often stale entries are looked for.
+	Interval time.Duration
+	// MaxAge is how long an entry is trusted before it is checked again.
+	MaxAge time.Duration
+	// BatchSize bounds the entries checked each interval, and so the requests
+	// made to Open Library.
+	BatchSize int32
+	// RetryDelay is how long an entry that failed to load waits before it is
+	// checked again.
+	RetryDelay time.Duration
+}
+
+// Run refreshes a batch of entries every interval until ctx is done. Every
+// replica of the server may run one; each claims a batch of its own.
+func (r *Refresher) Run(ctx context.Context) {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		refreshed, err := r.Refresh(ctx)
+		if err != nil {
+			logger.Error("catalog: refresh failed", "error", err)
+		} else if refreshed > 0 {
+			logger.Info("catalog: refreshed books", "count", refreshed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh claims one batch of stale entries, so no other Refresher checks
+// them too, checks them against Open Library and returns how many were
+// updated. A book Open Library no longer returns is left as it is until it is
+// stale again; one that fails to load is retried after RetryDelay, so entries
+// that keep failing do not hold back the rest of the catalog.
+func (r *Refresher) Refresh(ctx context.Context) (int, error) {
+	maxAge := r.MaxAge
+	if maxAge <= 0 {
+		maxAge = DefaultMaxAge
+	}
+	batchSize := r.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+	retryDelay := r.RetryDelay
+	if retryDelay <= 0 {
+		retryDelay = DefaultRetryDelay
+	}
+
+	now := time.Now()
+	stale, err := r.DB.ClaimStaleBooks(ctx, data.BookSourceOpenLibrary, now.Add(-maxAge), now, batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		found  []*data.Book
+		failed []string
+	)
+	for i, book := range stale {
+		latest, err := r.lookup(ctx, book.ExternalID)
+		if err != nil {
+			if ctx.Err() != nil {
+				// Nothing from this batch is saved, so all of it is retried.
+				for _, book := range stale[i:] {
+					failed = append(failed, book.ExternalID)
+				}
+				for _, book := range found {
+					failed = append(failed, book.ExternalID)
+				}
+				r.retry(context.WithoutCancel(ctx), failed, now.Add(retryDelay-maxAge))
+				return 0, ctx.Err()
+			}
+			logger.Warn("catalog: failed to look up book", "book_id", book.ExternalID, "error", err)
+			failed = append(failed, book.ExternalID)
+			continue
+		}
+		if latest != nil {
+			found = append(found, latest.CatalogEntry(now))
+		}
+	}
+
+	if len(failed) > 0 {
+		r.retry(ctx, failed, now.Add(retryDelay-maxAge))
+	}
+	if len(found) > 0 {
+		if err := r.DB.UpsertBooks(ctx, found); err != nil {
+			return 0, err
+		}
+	}
+	return len(found), nil
+}
+
+// retry marks entries that failed to load as checked at, so that, although
+// claiming them marked them checked now, they are stale again after the retry
+// delay rather than MaxAge.
+func (r *Refresher) retry(ctx context.Context, externalIDs []string, at time.Time) {
+	if err := r.DB.MarkBooksChecked(ctx, data.BookSourceOpenLibrary, externalIDs, at); err != nil {
+		logger.Error("catalog: failed to schedule retries", "count", len(externalIDs), "error", err)
+	}
+}
+
+// lookup returns the Open Library search result for the work with the edition
+// the catalog keys a book by, as an entry for that edition, or nil if Open
+// Library does not return it.
+func (r *Refresher) lookup(ctx context.Context, editionKey string) (*openlibrary.Book, error) {
+	resp, err := r.OpenLibrary.SearchBooks(ctx, "edition_key:"+editionKey, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Searching by edition finds the one work that has it, whichever of the
+	// work's editions is its cover edition.
+	if len(resp.Books) == 0 {
+		return nil, nil //nolint: nilnil // a book Open Library does not return is not an error
+	}
+	book := resp.Books[0]
+	if book.CoverEditionKey != editionKey {
+		// The work describes this edition too, except for the cover
+		// edition's ISBN, so the entry keeps its own.
+		book.CoverEditionKey = editionKey
+		book.ISBN = ""
+	}
+	return &book, nil
+}
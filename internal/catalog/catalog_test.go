@@ -0,0 +1,184 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/openlibrary"
+	"github.com/celestialdragonfly/betterreads/internal/postgres/mocks"
+	"github.com/celestialdragonfly/betterreads/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeOpenLibrary answers searches from a map of query to results.
+type fakeOpenLibrary map[string][]openlibrary.Book
+
+func (f fakeOpenLibrary) SearchBooks(_ context.Context, query string, _, _, _ *string) (*openlibrary.SearchBooksResponse, error) {
+	books, ok := f[query]
+	if !ok {
+		return nil, openlibrary.ErrInternalServer
+	}
+	return &openlibrary.SearchBooksResponse{Books: books}, nil
+}
+
+func (fakeOpenLibrary) Source() int32 {
+	return data.BookSourceOpenLibrary
+}
+
+func (fakeOpenLibrary) Search(context.Context, provider.Query) ([]*data.Book, error) {
+	return nil, openlibrary.ErrInternalServer
+}
+
+func (fakeOpenLibrary) GetBook(context.Context, string) (*openlibrary.BookDetails, error) {
+	return nil, openlibrary.ErrNotFound
+}
+
+func (fakeOpenLibrary) GetEditionByISBN(context.Context, string) (*openlibrary.BookDetails, error) {
+	return nil, openlibrary.ErrNotFound
+}
+
+func (fakeOpenLibrary) GetAuthor(context.Context, string) (*openlibrary.Author, error) {
+	return nil, openlibrary.ErrNotFound
+}
+
+func (fakeOpenLibrary) GetAuthorWorks(context.Context, string, int, int) (*openlibrary.AuthorWorks, error) {
+	return nil, openlibrary.ErrNotFound
+}
+
+func TestRefresher_Refresh(t *testing.T) {
+	t.Parallel()
+
+	dune := openlibrary.Book{
+		AuthorKey:       "OL79034A",
+		AuthorName:      "Frank Herbert",
+		CoverEditionKey: "OL456M",
+		CoverImage:      "https://covers.openlibrary.org/b/olid/OL456M-L.jpg",
+		Title:           "Dune",
+		PublishYear:     1965,
+	}
+	library := fakeOpenLibrary{
+		"edition_key:OL456M": {dune},
+		// The work's cover is another edition, which describes this one but
+		// for its ISBN.
+		"edition_key:OL1M": {{CoverEditionKey: "OL2M", Title: "Emma", ISBN: "9780141439587"}},
+		"edition_key:OL3M": {},
+	}
+	stale := []*data.Book{
+		{Source: data.BookSourceOpenLibrary, ExternalID: "OL456M", Title: "Dune (spoofed)"},
+		{Source: data.BookSourceOpenLibrary, ExternalID: "OL1M", Title: "Emma"},
+		{Source: data.BookSourceOpenLibrary, ExternalID: "OL3M", Title: "Gone"},
+		{Source: data.BookSourceOpenLibrary, ExternalID: "OL9M", Title: "Unreachable"},
+	}
+
+	tests := []struct {
+		name      string
+		setupMock func(*mocks.MockAPI)
+		want      int
+		wantErr   bool
+	}{
+		{
+			name: "updates found books and retries failed ones sooner",
+			setupMock: func(m *mocks.MockAPI) {
+				var claimedAt time.Time
+				m.EXPECT().
+					ClaimStaleBooks(gomock.Any(), data.BookSourceOpenLibrary, gomock.Any(), gomock.Any(), int32(DefaultBatchSize)).
+					DoAndReturn(func(_ context.Context, _ int32, checkedBefore, at time.Time, _ int32) ([]*data.Book, error) {
+						assert.WithinDuration(t, time.Now().Add(-DefaultMaxAge), checkedBefore, time.Minute)
+						assert.WithinDuration(t, time.Now(), at, time.Minute)
+						claimedAt = at
+						return stale, nil
+					})
+				m.EXPECT().
+					UpsertBooks(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, books []*data.Book) error {
+						require.Len(t, books, 2)
+						assert.Equal(t, "OL456M", books[0].ExternalID)
+						assert.Equal(t, "Dune", books[0].Title)
+						assert.Equal(t, "OL79034A", books[0].AuthorKey)
+						assert.Equal(t, "OL1M", books[1].ExternalID)
+						assert.Empty(t, books[1].ISBN)
+						return nil
+					})
+				// OL3M, which Open Library no longer has, stays checked until
+				// it is stale again.
+				m.EXPECT().
+					MarkBooksChecked(gomock.Any(), data.BookSourceOpenLibrary, []string{"OL9M"}, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int32, _ []string, at time.Time) error {
+						assert.Equal(t, claimedAt.Add(DefaultRetryDelay-DefaultMaxAge), at)
+						return nil
+					})
+			},
+			want: 2,
+		},
+		{
+			name: "nothing stale",
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().ClaimStaleBooks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			want: 0,
+		},
+		{
+			name: "database error",
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					ClaimStaleBooks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			r := &Refresher{DB: mockDB, OpenLibrary: library}
+			got, err := r.Refresh(context.Background())
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRefresher_Run(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockDB := mocks.NewMockAPI(ctrl)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	// Run refreshes straight away, then stops once ctx is done.
+	mockDB.EXPECT().
+		ClaimStaleBooks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, int32, time.Time, time.Time, int32) ([]*data.Book, error) {
+			cancel()
+			return nil, nil
+		})
+
+	go func() {
+		defer close(done)
+		(&Refresher{DB: mockDB, OpenLibrary: fakeOpenLibrary{}, Interval: time.Hour}).Run(ctx)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not stop")
+	}
+}
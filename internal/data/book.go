@@ -2,16 +2,108 @@ package data
 
 import "time"
 
-type LibraryBook struct {
-	UserID        string
-	BookID        string
+// Reading statuses stored in LibraryBook.ReadingStatus. The values mirror
+// betterreads.ReadingStatus.
+const (
+	ReadingStatusUnspecified int32 = iota
+	ReadingStatusRead
+	ReadingStatusWantToRead
+	ReadingStatusReading
+	ReadingStatusDidNotFinish
+)
+
+// Book sources stored in LibraryBook.Source. The values mirror
+// betterreads.BookSource.
+const (
+	BookSourceUnspecified int32 = iota
+	BookSourceGoogleBooks
+	BookSourceOpenLibrary
+	BookSourceManual
+)
+
+// Book is an entry in the book catalog, shared by every library that has the
+// book. It is keyed by its source and the id the source gives it. WorkKey is
+// the work an Open Library edition belongs to, when known. CreatedBy is the
+// user who entered a manual book, if any.
+// RefreshedAt is nil until the source has confirmed the entry; CheckedAt is
+// when the source was last asked about it.
+type Book struct {
+	Source        int32
+	ExternalID    string
+	WorkKey       string
 	Title         string
 	AuthorName    string
+	AuthorKey     string
+	Authors       []string
 	BookImage     string
-	Rating        int32
-	Source        int32
-	ReadingStatus int32
-	ShelfIDs      []string
-	AddedAt       time.Time
+	ISBN          string
+	PageCount     int32
+	PublishYear   int32
+	RatingAverage float32
+	RatingCount   int32
+	CreatedBy     string
+	RefreshedAt   *time.Time
+	CheckedAt     *time.Time
+	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
+
+// LibraryBookFields is a set of the fields of a LibraryBook a user writes.
+type LibraryBookFields uint8
+
+// Fields of a LibraryBook, for LibraryBook.Keep.
+const (
+	FieldReview LibraryBookFields = 1 << iota
+	FieldReviewHasSpoilers
+	FieldNotes
+	FieldTags
+)
+
+// LibraryBook is a book in a user's library. Title, AuthorName, BookImage and
+// ISBN are read from the book's catalog entry; the rest is the user's own.
+// WorkKey is only written: the Open Library work of a book an import matched
+// to an edition, for its catalog entry. Keep names the fields an update leaves as they are
+// stored, for clients that did not send them.
+type LibraryBook struct {
+	UserID            string
+	BookID            string
+	Title             string
+	AuthorName        string
+	BookImage         string
+	ISBN              string
+	WorkKey           string
+	Rating            int32
+	Source            int32
+	ReadingStatus     int32
+	Review            string
+	ReviewHasSpoilers bool
+	Notes             string   // private to the owner
+	Tags              []string // free-form, separate from shelves
+	ShelfIDs          []string
+	Sessions          []*ReadingSession // oldest first
+	Keep              LibraryBookFields
+	AddedAt           time.Time
+	UpdatedAt         time.Time
+}
+
+// LibrarySearch filters a library. Query matches title, author, review, notes
+// and tags; Tag, when set, keeps only books with that tag.
+type LibrarySearch struct {
+	Query string
+	Tag   string
+}
+
+// ReadingSession is one read of a book. A session with neither FinishedAt
+// nor DidNotFinish set is still in progress. Rating is the rating given on
+// that read, or zero.
+type ReadingSession struct {
+	ID           string
+	UserID       string
+	BookID       string
+	StartedAt    *time.Time
+	FinishedAt   *time.Time
+	DidNotFinish bool
+	Rating       int32
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
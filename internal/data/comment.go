@@ -0,0 +1,11 @@
+package data
+
+import "time"
+
+type Comment struct {
+	ID        string
+	PostID    string
+	UserID    string
+	Content   string
+	CreatedAt time.Time
+}
@@ -0,0 +1,22 @@
+package data
+
+import "time"
+
+// ReadingGoal is a user's target for a calendar year. Either target may be
+// zero, but not both.
+type ReadingGoal struct {
+	UserID      string
+	Year        int32
+	TargetBooks int32
+	TargetPages int32
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// GoalBook is a finished read counted towards a reading goal. Pages is zero
+// when the book's page count is unknown.
+type GoalBook struct {
+	Book       *LibraryBook
+	FinishedAt time.Time
+	Pages      int32
+}
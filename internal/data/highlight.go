@@ -0,0 +1,23 @@
+package data
+
+import "time"
+
+// Highlight is a quote a user saved from a book in their library. Page is
+// zero and Location blank when unknown; Location is free-form, such as a
+// Kindle location range. BookTitle, AuthorName and BookImage come from the
+// library entry and are not stored with the highlight.
+type Highlight struct {
+	ID         string
+	UserID     string
+	BookID     string
+	BookTitle  string
+	AuthorName string
+	BookImage  string
+	Quote      string
+	Page       int32
+	Location   string
+	Comment    string
+	IsPublic   bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
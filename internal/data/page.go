@@ -0,0 +1,19 @@
+package data
+
+import "time"
+
+// Page selects a slice of a list. When After is set the page starts right
+// after that row and Offset is ignored.
+type Page struct {
+	Limit  int32
+	Offset int32
+	After  *Cursor
+}
+
+// Cursor identifies the last row of a page for keyset pagination: its
+// timestamp (created_at or added_at), any text sort keys and its id.
+type Cursor struct {
+	Time time.Time `json:"t,omitzero"`
+	Keys []string  `json:"k,omitempty"`
+	ID   string    `json:"i"`
+}
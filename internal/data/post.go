@@ -0,0 +1,31 @@
+package data
+
+import "time"
+
+// BookDetails is the snapshot of a book attached to a post.
+type BookDetails struct {
+	ID       string
+	Author   string
+	Title    string
+	ImageURL string
+	Rating   int32
+}
+
+type Post struct {
+	ID           string
+	UserID       string
+	Book         BookDetails
+	Description  string
+	Summary      string
+	LikeCount    int32
+	CommentCount int32
+	// HighlightID is the highlight the post shares, if any, and Quote a copy
+	// of its text taken when the post was created.
+	HighlightID string
+	Quote       string
+	// SystemGenerated is set for posts created from library changes rather
+	// than written by the user.
+	SystemGenerated bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
@@ -0,0 +1,17 @@
+package data
+
+import "time"
+
+// ReadingProgress is one entry in the history of how far a user has read a
+// book in their library. Page and TotalPages are zero for entries logged as a
+// percentage; Percent is always set.
+type ReadingProgress struct {
+	ID         string
+	UserID     string
+	BookID     string
+	Page       int32
+	TotalPages int32
+	Percent    float64
+	Note       string
+	CreatedAt  time.Time
+}
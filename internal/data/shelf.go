@@ -9,3 +9,17 @@ type Shelf struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// ShelfSortOrder selects the order of books listed from a shelf. The values
+// mirror betterreads.ShelfSortOrder.
+type ShelfSortOrder int32
+
+const (
+	ShelfSortOrderUnspecified ShelfSortOrder = iota
+	ShelfSortOrderTitleAsc
+	ShelfSortOrderTitleDesc
+	ShelfSortOrderAuthorAsc
+	ShelfSortOrderAuthorDesc
+	ShelfSortOrderDateAddedAsc
+	ShelfSortOrderDateAddedDesc
+)
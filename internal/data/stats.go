@@ -0,0 +1,32 @@
+package data
+
+import "time"
+
+// MaxStatsYears bounds the range ReadingStats.Months covers, so a distant
+// bound cannot expand into thousands of empty months.
+const MaxStatsYears = 10
+
+// ReadingStats summarizes the reads a user finished or abandoned in a time
+// range. RatingCounts is indexed by rating, with unrated reads at zero.
+type ReadingStats struct {
+	BooksRead           int32
+	PagesRead           int32
+	BooksNotFinished    int32
+	AverageTimeToFinish time.Duration
+	RatingCounts        [6]int32
+	TopAuthors          []*AuthorCount
+	Months              []*MonthCount // oldest first; spans at most MaxStatsYears
+}
+
+type AuthorCount struct {
+	AuthorName string
+	Books      int32
+}
+
+// MonthCount is the reads finished in the calendar month (UTC) starting at
+// Month.
+type MonthCount struct {
+	Month time.Time
+	Books int32
+	Pages int32
+}
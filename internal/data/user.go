@@ -10,6 +10,8 @@ type User struct {
 	Email           string
 	ProfilePhotoURL string
 	CreatedAt       time.Time
+	// ActivityPostsEnabled controls whether library changes generate posts.
+	ActivityPostsEnabled bool
 }
 
 func (u *User) GetID() string {
@@ -60,3 +62,10 @@ func (u *User) GetCreatedAt() time.Time {
 	}
 	return u.CreatedAt
 }
+
+func (u *User) GetActivityPostsEnabled() bool {
+	if u == nil {
+		return false
+	}
+	return u.ActivityPostsEnabled
+}
@@ -0,0 +1,114 @@
+// Package exporter writes a library in formats other reading sites and tools
+// can read.
+package exporter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/importer"
+)
+
+// Writer writes one library export. Shelves are written once, before any book.
+// Close finishes the document; it does not close the underlying writer.
+type Writer interface {
+	WriteShelves(shelves []*data.Shelf) error
+	WriteBook(book *data.LibraryBook) error
+	Close() error
+}
+
+// exportDateLayout is the date format Goodreads and StoryGraph both use.
+const exportDateLayout = "2006/01/02"
+
+// csvWriter is the shared part of the CSV formats: a header row followed by a
+// row per book, with shelf ids resolved to names.
+type csvWriter struct {
+	w          *csv.Writer
+	shelfNames map[string]string
+	row        func(book *data.LibraryBook, shelves []string) []string
+}
+
+func newCSVWriter(w *csv.Writer, header []string, row func(book *data.LibraryBook, shelves []string) []string) (*csvWriter, error) {
+	if err := w.Write(header); err != nil {
+		return nil, fmt.Errorf("write header: %w", err)
+	}
+	return &csvWriter{w: w, shelfNames: map[string]string{}, row: row}, nil
+}
+
+func (c *csvWriter) WriteShelves(shelves []*data.Shelf) error {
+	for _, shelf := range shelves {
+		c.shelfNames[shelf.ID] = shelf.Name
+	}
+	return nil
+}
+
+func (c *csvWriter) WriteBook(book *data.LibraryBook) error {
+	shelves := make([]string, 0, len(book.ShelfIDs))
+	for _, id := range book.ShelfIDs {
+		if name, ok := c.shelfNames[id]; ok {
+			shelves = append(shelves, name)
+		}
+	}
+	if err := c.w.Write(c.row(book, shelves)); err != nil {
+		return fmt.Errorf("write book %s: %w", book.BookID, err)
+	}
+	return nil
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// record lays values out in header order, leaving unknown columns blank.
+func record(header []string, values map[string]string) []string {
+	row := make([]string, len(header))
+	for i, column := range header {
+		row[i] = values[column]
+	}
+	return row
+}
+
+// siteID returns the id a book has on site when it was imported from there
+// without an ISBN, or "". Books imported before imports were scoped to the
+// user have the site's id unscoped.
+func siteID(book *data.LibraryBook, site string) string {
+	id, ok := strings.CutPrefix(book.BookID, importer.ScopedBookID(book.UserID, site+":"))
+	if !ok {
+		id, ok = strings.CutPrefix(book.BookID, site+":")
+	}
+	if !ok {
+		return ""
+	}
+	return id
+}
+
+// lastRead returns the date the book was last finished, or "" if it never
+// was.
+func lastRead(book *data.LibraryBook) string {
+	for _, session := range slices.Backward(book.Sessions) {
+		if session.FinishedAt != nil && !session.DidNotFinish {
+			return session.FinishedAt.Format(exportDateLayout)
+		}
+	}
+	return ""
+}
+
+// readCount returns how many times the book was finished. Books marked read
+// before sessions were recorded count once.
+func readCount(book *data.LibraryBook) string {
+	count := 0
+	for _, session := range book.Sessions {
+		if session.FinishedAt != nil && !session.DidNotFinish {
+			count++
+		}
+	}
+	if count == 0 && book.ReadingStatus == data.ReadingStatusRead {
+		count = 1
+	}
+	return strconv.Itoa(count)
+}
@@ -0,0 +1,172 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/importer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testAddedAt = time.Date(2012, 4, 20, 0, 0, 0, 0, time.UTC)
+	testReadAt  = time.Date(2012, 5, 1, 0, 0, 0, 0, time.UTC)
+	testShelves = []*data.Shelf{
+		{ID: "shelf-1", Name: "favorites", CreatedAt: testAddedAt, UpdatedAt: testAddedAt},
+		{ID: "shelf-2", Name: "sci-fi", CreatedAt: testAddedAt, UpdatedAt: testAddedAt},
+	}
+	testBooks = []*data.LibraryBook{
+		{
+			BookID:        "isbn:9780439023481",
+			Title:         "The Hunger Games",
+			AuthorName:    "Suzanne Collins",
+			ISBN:          "9780439023481",
+			Rating:        4,
+			Source:        data.BookSourceManual,
+			ReadingStatus: data.ReadingStatusRead,
+			ShelfIDs:      []string{"shelf-1"},
+			Sessions:      []*data.ReadingSession{{FinishedAt: &testReadAt, Rating: 4}},
+			AddedAt:       testAddedAt,
+			UpdatedAt:     testAddedAt,
+		},
+		{
+			BookID:            "goodreads:41865",
+			Title:             "Twilight, Book 1",
+			AuthorName:        "Stephenie Meyer",
+			Source:            data.BookSourceManual,
+			ReadingStatus:     data.ReadingStatusWantToRead,
+			Review:            "Sparkly, with a twist at the end.",
+			ReviewHasSpoilers: true,
+			Notes:             "Borrowed from Sam",
+			Tags:              []string{"vampires"},
+			ShelfIDs:          []string{"shelf-1", "shelf-2"},
+			AddedAt:           testAddedAt.AddDate(1, 0, 0),
+			UpdatedAt:         testAddedAt.AddDate(1, 0, 0),
+		},
+	}
+)
+
+func export(t *testing.T, newWriter func(io.Writer) (Writer, error)) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := newWriter(&buf)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteShelves(testShelves))
+	for _, book := range testBooks {
+		require.NoError(t, w.WriteBook(book))
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestGoodreadsCSV_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	rows, err := importer.Goodreads{}.Parse(bytes.NewReader(export(t, NewGoodreadsCSV)))
+	require.NoError(t, err)
+	require.Len(t, rows, len(testBooks))
+
+	for i, row := range rows {
+		require.NoError(t, row.Err)
+		want := *testBooks[i]
+		want.ShelfIDs = nil
+		want.Tags = nil // not part of a Goodreads export
+		want.UpdatedAt = time.Time{}
+		assert.Equal(t, want, row.Book)
+	}
+	assert.Equal(t, []string{"favorites"}, rows[0].Shelves)
+	assert.Equal(t, []string{"favorites", "sci-fi"}, rows[1].Shelves)
+}
+
+func TestGoodreadsCSV_ISBN10(t *testing.T) {
+	t.Parallel()
+
+	records, err := csv.NewReader(bytes.NewReader(export(t, NewGoodreadsCSV))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, `="0439023483"`, records[1][5])
+	assert.Equal(t, `=""`, records[2][5])
+	assert.Equal(t, "41865", records[2][0])
+}
+
+func TestSiteID(t *testing.T) {
+	t.Parallel()
+
+	book := &data.LibraryBook{UserID: "user-1", BookID: importer.ScopedBookID("user-1", "goodreads:41865")}
+	assert.Equal(t, "41865", siteID(book, "goodreads"))
+	assert.Empty(t, siteID(book, "storygraph"))
+}
+
+func TestStoryGraphCSV(t *testing.T) {
+	t.Parallel()
+
+	records, err := csv.NewReader(bytes.NewReader(export(t, NewStoryGraphCSV))).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, storyGraphHeader, records[0])
+
+	row := func(i int) map[string]string {
+		values := map[string]string{}
+		for c, column := range storyGraphHeader {
+			values[column] = records[i][c]
+		}
+		return values
+	}
+	hungerGames := row(1)
+	assert.Equal(t, "The Hunger Games", hungerGames["Title"])
+	assert.Equal(t, "9780439023481", hungerGames["ISBN/UID"])
+	assert.Equal(t, "read", hungerGames["Read Status"])
+	assert.Equal(t, "4.0", hungerGames["Star Rating"])
+	assert.Equal(t, "2012/04/20", hungerGames["Date Added"])
+	assert.Equal(t, "2012/05/01", hungerGames["Last Date Read"])
+	assert.Equal(t, "1", hungerGames["Read Count"])
+	assert.Equal(t, "favorites", hungerGames["Tags"])
+
+	twilight := row(2)
+	assert.Equal(t, "to-read", twilight["Read Status"])
+	assert.Empty(t, twilight["Star Rating"])
+	assert.Equal(t, "Sparkly, with a twist at the end.", twilight["Review"])
+	assert.Equal(t, "favorites, sci-fi", twilight["Tags"])
+}
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+
+	var doc struct {
+		Version int         `json:"version"`
+		Shelves []jsonShelf `json:"shelves"`
+		Books   []jsonBook  `json:"books"`
+	}
+	require.NoError(t, json.Unmarshal(export(t, NewJSON), &doc))
+
+	assert.Equal(t, jsonVersion, doc.Version)
+	require.Len(t, doc.Shelves, 2)
+	assert.Equal(t, "favorites", doc.Shelves[0].Name)
+	require.Len(t, doc.Books, 2)
+	assert.Equal(t, "isbn:9780439023481", doc.Books[0].BookID)
+	assert.Equal(t, "read", doc.Books[0].ReadingStatus)
+	assert.Equal(t, "manual", doc.Books[0].Source)
+	require.Len(t, doc.Books[0].Sessions, 1)
+	assert.Equal(t, testReadAt, *doc.Books[0].Sessions[0].FinishedAt)
+	assert.Empty(t, doc.Books[1].Sessions)
+	assert.Equal(t, "Borrowed from Sam", doc.Books[1].Notes)
+	assert.Equal(t, []string{"vampires"}, doc.Books[1].Tags)
+	assert.Equal(t, []string{"shelf-1", "shelf-2"}, doc.Books[1].ShelfIDs)
+}
+
+func TestJSON_EmptyLibrary(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	w, err := NewJSON(&buf)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteShelves(nil))
+	require.NoError(t, w.Close())
+	assert.JSONEq(t, `{"version":1,"shelves":[],"books":[]}`, buf.String())
+}
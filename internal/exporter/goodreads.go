@@ -0,0 +1,93 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+)
+
+// goodreadsHeader is the header of the Goodreads "Export Library" CSV, which
+// Goodreads' own import also expects.
+var goodreadsHeader = []string{
+	"Book Id", "Title", "Author", "Author l-f", "Additional Authors", "ISBN", "ISBN13",
+	"My Rating", "Average Rating", "Publisher", "Binding", "Number of Pages", "Year Published",
+	"Original Publication Year", "Date Read", "Date Added", "Bookshelves", "Bookshelves with positions",
+	"Exclusive Shelf", "My Review", "Spoiler", "Private Notes", "Read Count", "Owned Copies",
+}
+
+// goodreadsShelves names the Goodreads exclusive shelf for each reading status.
+// Goodreads has no did-not-finish shelf; this is the name readers commonly use.
+var goodreadsShelves = map[int32]string{
+	data.ReadingStatusRead:         "read",
+	data.ReadingStatusReading:      "currently-reading",
+	data.ReadingStatusWantToRead:   "to-read",
+	data.ReadingStatusDidNotFinish: "did-not-finish",
+}
+
+// NewGoodreadsCSV returns a Writer producing a Goodreads-compatible CSV.
+func NewGoodreadsCSV(w io.Writer) (Writer, error) {
+	return newCSVWriter(csv.NewWriter(w), goodreadsHeader, goodreadsRow)
+}
+
+func goodreadsRow(book *data.LibraryBook, shelves []string) []string {
+	exclusive := goodreadsShelves[book.ReadingStatus]
+	if exclusive != "" {
+		shelves = append(shelves, exclusive)
+	}
+	return record(goodreadsHeader, map[string]string{
+		"Book Id":         siteID(book, "goodreads"),
+		"Title":           book.Title,
+		"Author":          book.AuthorName,
+		"ISBN":            spreadsheetText(isbn10(book.ISBN)),
+		"ISBN13":          spreadsheetText(book.ISBN),
+		"My Rating":       strconv.Itoa(int(book.Rating)),
+		"Date Read":       lastRead(book),
+		"Date Added":      book.AddedAt.Format(exportDateLayout),
+		"Bookshelves":     strings.Join(shelves, ", "),
+		"Exclusive Shelf": exclusive,
+		"My Review":       book.Review,
+		"Spoiler":         goodreadsSpoiler(book.ReviewHasSpoilers),
+		"Private Notes":   book.Notes,
+		"Read Count":      readCount(book),
+		"Owned Copies":    "0",
+	})
+}
+
+// goodreadsSpoiler writes the Spoiler column, which Goodreads leaves empty
+// for reviews without spoilers.
+func goodreadsSpoiler(spoilers bool) string {
+	if spoilers {
+		return "true"
+	}
+	return ""
+}
+
+// spreadsheetText wraps a value in ="..." as Goodreads does for ISBNs, so
+// spreadsheets keep leading zeros.
+func spreadsheetText(value string) string {
+	return fmt.Sprintf("=%q", value)
+}
+
+// isbn10 converts a 978-prefixed ISBN-13 to its ISBN-10, or returns "" if it
+// has none.
+func isbn10(isbn13 string) string {
+	body, ok := strings.CutPrefix(isbn13, "978")
+	if !ok || len(body) != 10 { //nolint: mnd // ISBN-13 minus its prefix
+		return ""
+	}
+	body = body[:9]
+	sum := 0
+	for i, c := range body {
+		sum += (10 - i) * int(c-'0') //nolint: mnd // ISBN-10 weights
+	}
+	switch check := (11 - sum%11) % 11; check { //nolint: mnd // ISBN-10 check digit
+	case 10: //nolint: mnd // written as X
+		return body + "X"
+	default:
+		return body + strconv.Itoa(check)
+	}
+}
@@ -0,0 +1,143 @@
+package exporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+)
+
+// jsonVersion is bumped whenever the JSON export changes incompatibly.
+const jsonVersion = 1
+
+// Enum names used in the JSON export, so the file does not depend on our
+// numeric values.
+var (
+	jsonStatuses = map[int32]string{
+		data.ReadingStatusRead:         "read",
+		data.ReadingStatusWantToRead:   "want_to_read",
+		data.ReadingStatusReading:      "reading",
+		data.ReadingStatusDidNotFinish: "did_not_finish",
+	}
+	jsonSources = map[int32]string{
+		data.BookSourceGoogleBooks: "google_books",
+		data.BookSourceOpenLibrary: "open_library",
+		data.BookSourceManual:      "manual",
+	}
+)
+
+type jsonShelf struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type jsonBook struct {
+	BookID            string        `json:"book_id"`
+	Title             string        `json:"title"`
+	Author            string        `json:"author"`
+	ISBN              string        `json:"isbn,omitempty"`
+	BookImage         string        `json:"book_image,omitempty"`
+	Source            string        `json:"source,omitempty"`
+	ReadingStatus     string        `json:"reading_status,omitempty"`
+	Rating            int32         `json:"rating,omitempty"`
+	Review            string        `json:"review,omitempty"`
+	ReviewHasSpoilers bool          `json:"review_has_spoilers,omitempty"`
+	Notes             string        `json:"notes,omitempty"`
+	Tags              []string      `json:"tags,omitempty"`
+	ShelfIDs          []string      `json:"shelf_ids"`
+	Sessions          []jsonSession `json:"sessions,omitempty"`
+	AddedAt           time.Time     `json:"added_at"`
+	UpdatedAt         time.Time     `json:"updated_at"`
+}
+
+type jsonSession struct {
+	StartedAt    *time.Time `json:"started_at,omitempty"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	DidNotFinish bool       `json:"did_not_finish,omitempty"`
+	Rating       int32      `json:"rating,omitempty"`
+}
+
+// jsonWriter streams a document of the form
+//
+//	{"version": 1, "shelves": [...], "books": [...]}
+//
+// writing each book as it arrives.
+type jsonWriter struct {
+	w     io.Writer
+	books int
+}
+
+// NewJSON returns a Writer producing the BetterReads JSON export.
+func NewJSON(w io.Writer) (Writer, error) {
+	if _, err := fmt.Fprintf(w, `{"version":%d,`, jsonVersion); err != nil {
+		return nil, err
+	}
+	return &jsonWriter{w: w}, nil
+}
+
+func (j *jsonWriter) WriteShelves(shelves []*data.Shelf) error {
+	out := make([]jsonShelf, 0, len(shelves))
+	for _, s := range shelves {
+		out = append(out, jsonShelf{ID: s.ID, Name: s.Name, CreatedAt: s.CreatedAt, UpdatedAt: s.UpdatedAt})
+	}
+	encoded, err := json.Marshal(out)
+	if err != nil {
+		return fmt.Errorf("encode shelves: %w", err)
+	}
+	_, err = fmt.Fprintf(j.w, `"shelves":%s,"books":[`, encoded)
+	return err
+}
+
+func (j *jsonWriter) WriteBook(book *data.LibraryBook) error {
+	shelfIDs := book.ShelfIDs
+	if shelfIDs == nil {
+		shelfIDs = []string{}
+	}
+	sessions := make([]jsonSession, 0, len(book.Sessions))
+	for _, session := range book.Sessions {
+		sessions = append(sessions, jsonSession{
+			StartedAt:    session.StartedAt,
+			FinishedAt:   session.FinishedAt,
+			DidNotFinish: session.DidNotFinish,
+			Rating:       session.Rating,
+		})
+	}
+	encoded, err := json.Marshal(jsonBook{
+		BookID:            book.BookID,
+		Title:             book.Title,
+		Author:            book.AuthorName,
+		ISBN:              book.ISBN,
+		BookImage:         book.BookImage,
+		Source:            jsonSources[book.Source],
+		ReadingStatus:     jsonStatuses[book.ReadingStatus],
+		Rating:            book.Rating,
+		Review:            book.Review,
+		ReviewHasSpoilers: book.ReviewHasSpoilers,
+		Notes:             book.Notes,
+		Tags:              book.Tags,
+		ShelfIDs:          shelfIDs,
+		Sessions:          sessions,
+		AddedAt:           book.AddedAt,
+		UpdatedAt:         book.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("encode book %s: %w", book.BookID, err)
+	}
+	if j.books > 0 {
+		if _, err := io.WriteString(j.w, ","); err != nil {
+			return err
+		}
+	}
+	j.books++
+	_, err = j.w.Write(encoded)
+	return err
+}
+
+func (j *jsonWriter) Close() error {
+	_, err := io.WriteString(j.w, "]}\n")
+	return err
+}
@@ -0,0 +1,54 @@
+package exporter
+
+import (
+	"cmp"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+)
+
+// storyGraphHeader is the header of a StoryGraph export, which its import
+// also accepts.
+var storyGraphHeader = []string{
+	"Title", "Authors", "Contributors", "ISBN/UID", "Format", "Read Status", "Date Added",
+	"Last Date Read", "Dates Read", "Read Count", "Moods", "Pace", "Character- or Plot-Driven?",
+	"Strong Character Development?", "Loveable Characters?", "Diverse Characters?", "Flawed Characters?",
+	"Star Rating", "Review", "Content Warnings", "Content Warning Description", "Tags", "Owned?",
+}
+
+// storyGraphStatuses names the StoryGraph read status for each reading status.
+var storyGraphStatuses = map[int32]string{
+	data.ReadingStatusRead:         "read",
+	data.ReadingStatusReading:      "currently-reading",
+	data.ReadingStatusWantToRead:   "to-read",
+	data.ReadingStatusDidNotFinish: "did-not-finish",
+}
+
+// NewStoryGraphCSV returns a Writer producing a StoryGraph-compatible CSV.
+// Shelves are exported as tags.
+func NewStoryGraphCSV(w io.Writer) (Writer, error) {
+	return newCSVWriter(csv.NewWriter(w), storyGraphHeader, storyGraphRow)
+}
+
+func storyGraphRow(book *data.LibraryBook, shelves []string) []string {
+	rating := ""
+	if book.Rating > 0 {
+		rating = strconv.FormatFloat(float64(book.Rating), 'f', 1, 64)
+	}
+
+	return record(storyGraphHeader, map[string]string{
+		"Title":          book.Title,
+		"Authors":        book.AuthorName,
+		"ISBN/UID":       cmp.Or(book.ISBN, siteID(book, "storygraph")),
+		"Read Status":    storyGraphStatuses[book.ReadingStatus],
+		"Date Added":     book.AddedAt.Format(exportDateLayout),
+		"Last Date Read": lastRead(book),
+		"Read Count":     readCount(book),
+		"Star Rating":    rating,
+		"Review":         book.Review,
+		"Tags":           strings.Join(shelves, ", "),
+	})
+}
@@ -0,0 +1,89 @@
+package gateway
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/logger"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportPath downloads the caller's library in the format named by the
+// "format" query parameter.
+const ExportPath = "/api/v1/library/export"
+
+// exportFile describes the download for each value of the format parameter.
+type exportFile struct {
+	format      betterreads.ExportFormat
+	contentType string
+	name        string
+}
+
+var exportFiles = map[string]exportFile{
+	"json":       {betterreads.ExportFormat_EXPORT_FORMAT_JSON, "application/json", "betterreads_library.json"},
+	"goodreads":  {betterreads.ExportFormat_EXPORT_FORMAT_GOODREADS_CSV, "text/csv; charset=utf-8", "goodreads_library_export.csv"},
+	"storygraph": {betterreads.ExportFormat_EXPORT_FORMAT_STORYGRAPH_CSV, "text/csv; charset=utf-8", "storygraph_library_export.csv"},
+}
+
+// RegisterExportRoutes adds the library download route to mux. The file is
+// streamed from client to the response chunk by chunk, without buffering it.
+func RegisterExportRoutes(mux *runtime.ServeMux, client betterreads.BetterReadsServiceClient) error {
+	return mux.HandlePath(http.MethodGet, ExportPath, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		file, ok := exportFiles[r.URL.Query().Get("format")]
+		if !ok {
+			err := status.Error(codes.InvalidArgument, "format must be one of json, goodreads or storygraph")
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r,
+			betterreads.BetterReadsService_ExportLibrary_FullMethodName,
+			runtime.WithHTTPPathPattern(ExportPath),
+		)
+		if err != nil {
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+
+		stream, err := client.ExportLibrary(ctx, &betterreads.ExportLibraryRequest{Format: file.format})
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		// Errors such as a bad token arrive with the first message, while the
+		// status can still be set.
+		chunk, err := stream.Recv()
+		if err != nil && !errors.Is(err, io.EOF) {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", file.contentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.name))
+		w.WriteHeader(http.StatusOK)
+		flusher, _ := w.(http.Flusher)
+
+		for ; err == nil; chunk, err = stream.Recv() {
+			if _, err := w.Write(chunk.Data); err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+		if !errors.Is(err, io.EOF) {
+			// The headers are gone, so abort the response rather than let a
+			// truncated file look complete.
+			logger.Error("library export failed mid-stream", "error", err)
+			panic(http.ErrAbortHandler)
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package gateway
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportClient replays chunks followed by err from ExportLibrary.
+type exportClient struct {
+	betterreads.BetterReadsServiceClient
+	format betterreads.ExportFormat
+	chunks []string
+	err    error
+}
+
+func (c *exportClient) ExportLibrary(_ context.Context, req *betterreads.ExportLibraryRequest, _ ...grpc.CallOption) (betterreads.BetterReadsService_ExportLibraryClient, error) {
+	c.format = req.Format
+	return &exportStream{chunks: c.chunks, err: c.err}, nil
+}
+
+type exportStream struct {
+	grpc.ClientStream
+	chunks []string
+	err    error
+}
+
+func (s *exportStream) Recv() (*betterreads.ExportLibraryResponse, error) {
+	if len(s.chunks) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+		return nil, io.EOF
+	}
+	chunk := s.chunks[0]
+	s.chunks = s.chunks[1:]
+	return &betterreads.ExportLibraryResponse{Data: []byte(chunk)}, nil
+}
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		query       string
+		chunks      []string
+		err         error
+		wantStatus  int
+		wantFormat  betterreads.ExportFormat
+		wantBody    string
+		wantHeaders map[string]string
+	}{
+		{
+			name:       "streams the file",
+			query:      "?format=goodreads",
+			chunks:     []string{"Book Id,Title\n", "1,Dune\n"},
+			wantStatus: http.StatusOK,
+			wantFormat: betterreads.ExportFormat_EXPORT_FORMAT_GOODREADS_CSV,
+			wantBody:   "Book Id,Title\n1,Dune\n",
+			wantHeaders: map[string]string{
+				"Content-Type":        "text/csv; charset=utf-8",
+				"Content-Disposition": `attachment; filename="goodreads_library_export.csv"`,
+			},
+		},
+		{
+			name:       "json",
+			query:      "?format=json",
+			chunks:     []string{`{"version":1}`},
+			wantStatus: http.StatusOK,
+			wantFormat: betterreads.ExportFormat_EXPORT_FORMAT_JSON,
+			wantBody:   `{"version":1}`,
+		},
+		{
+			name:       "unknown format",
+			query:      "?format=xlsx",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "error before the first chunk",
+			query:      "?format=storygraph",
+			err:        status.Error(codes.Unauthenticated, "invalid token"),
+			wantStatus: http.StatusUnauthorized,
+			wantFormat: betterreads.ExportFormat_EXPORT_FORMAT_STORYGRAPH_CSV,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &exportClient{chunks: tt.chunks, err: tt.err}
+			mux := runtime.NewServeMux()
+			require.NoError(t, RegisterExportRoutes(mux, client))
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ExportPath+tt.query, nil))
+
+			assert.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			assert.Equal(t, tt.wantFormat, client.format)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			assert.Equal(t, tt.wantBody, rec.Body.String())
+			for header, value := range tt.wantHeaders {
+				assert.Equal(t, value, rec.Header().Get(header))
+			}
+		})
+	}
+}
+
+func TestExport_ErrorMidStreamAborts(t *testing.T) {
+	t.Parallel()
+
+	client := &exportClient{chunks: []string{"partial"}, err: status.Error(codes.Internal, "database gone")}
+	mux := runtime.NewServeMux()
+	require.NoError(t, RegisterExportRoutes(mux, client))
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, ExportPath+"?format=json", nil)
+	assert.PanicsWithValue(t, http.ErrAbortHandler, func() { mux.ServeHTTP(rec, req) })
+}
@@ -0,0 +1,142 @@
+// Package gateway holds HTTP routes that grpc-gateway cannot generate from the
+// proto, such as multipart file uploads.
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// UploadPath accepts a library export from the site named by {source} as the
+// "file" field of a multipart form. A "dry_run" query or form value of true
+// reports what the import would do without writing anything.
+const UploadPath = "/api/v1/library/import/{source}/upload"
+
+// uploadField is the multipart form field holding the file.
+const uploadField = "file"
+
+// uploadSources maps the {source} path segment to the site it names.
+var uploadSources = map[string]betterreads.ImportSource{
+	"goodreads":    betterreads.ImportSource_IMPORT_SOURCE_GOODREADS,
+	"storygraph":   betterreads.ImportSource_IMPORT_SOURCE_STORYGRAPH,
+	"librarything": betterreads.ImportSource_IMPORT_SOURCE_LIBRARYTHING,
+}
+
+// MaxUploadSize keeps the forwarded request under the gRPC server's default
+// 4 MiB message limit.
+const MaxUploadSize = 4<<20 - 4<<10
+
+var ErrUploadTooLarge = fmt.Errorf("file is larger than %d bytes", MaxUploadSize)
+
+// KindleUploadPath accepts a Kindle's "My Clippings.txt" as the "file" field
+// of a multipart form, with the same dry_run option as UploadPath.
+const KindleUploadPath = "/api/v1/highlights/import/kindle/upload"
+
+// RegisterUploadRoutes adds the multipart upload routes to mux. Uploads are
+// forwarded to client with the request's headers, so they go through the same
+// authentication as every other route.
+func RegisterUploadRoutes(mux *runtime.ServeMux, client betterreads.BetterReadsServiceClient) error {
+	err := mux.HandlePath(http.MethodPost, UploadPath, func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		source, ok := uploadSources[params["source"]]
+		if !ok {
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+			err := status.Error(codes.InvalidArgument, "source must be one of goodreads, storygraph or librarything")
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+
+		forwardUpload(mux, w, r, UploadPath, betterreads.BetterReadsService_ImportLibrary_FullMethodName,
+			func(ctx context.Context, file []byte, dryRun bool, opts ...grpc.CallOption) (proto.Message, error) {
+				return client.ImportLibrary(ctx, &betterreads.ImportLibraryRequest{Source: source, File: file, DryRun: dryRun}, opts...)
+			})
+	})
+	if err != nil {
+		return err
+	}
+
+	return mux.HandlePath(http.MethodPost, KindleUploadPath, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		forwardUpload(mux, w, r, KindleUploadPath, betterreads.BetterReadsService_ImportKindleHighlights_FullMethodName,
+			func(ctx context.Context, file []byte, dryRun bool, opts ...grpc.CallOption) (proto.Message, error) {
+				return client.ImportKindleHighlights(ctx, &betterreads.ImportKindleHighlightsRequest{File: file, DryRun: dryRun}, opts...)
+			})
+	})
+}
+
+// forwardUpload reads the uploaded file and dry_run option from r, passes
+// them to call as the gRPC method, and writes the response or error.
+func forwardUpload(
+	mux *runtime.ServeMux,
+	w http.ResponseWriter,
+	r *http.Request,
+	path, method string,
+	call func(ctx context.Context, file []byte, dryRun bool, opts ...grpc.CallOption) (proto.Message, error),
+) {
+	_, outbound := runtime.MarshalerForRequest(mux, r)
+
+	file, err := readUpload(w, r)
+	if err != nil {
+		runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+		return
+	}
+
+	dryRun := false
+	if value := r.FormValue("dry_run"); value != "" {
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			err = status.Errorf(codes.InvalidArgument, "dry_run must be true or false, not %q", value)
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+	}
+
+	ctx, err := runtime.AnnotateContext(r.Context(), mux, r, method, runtime.WithHTTPPathPattern(path))
+	if err != nil {
+		runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+		return
+	}
+
+	var md runtime.ServerMetadata
+	resp, err := call(ctx, file, dryRun, grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD))
+	ctx = runtime.NewServerMetadataContext(ctx, md)
+	if err != nil {
+		runtime.HTTPError(ctx, mux, outbound, w, r, err)
+		return
+	}
+	runtime.ForwardResponseMessage(ctx, mux, outbound, w, r, resp)
+}
+
+// readUpload returns the contents of the uploaded file, as a gRPC status error
+// on failure so that it is rendered like any other gateway error.
+func readUpload(w http.ResponseWriter, r *http.Request) ([]byte, error) {
+	// Leave room for the multipart framing around the file.
+	r.Body = http.MaxBytesReader(w, r.Body, 2*MaxUploadSize) //nolint: mnd // framing allowance
+
+	file, _, err := r.FormFile(uploadField)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return nil, status.Error(codes.InvalidArgument, ErrUploadTooLarge.Error())
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "expected a multipart form with a %q file: %v", uploadField, err)
+	}
+	defer file.Close()
+
+	contents, err := io.ReadAll(io.LimitReader(file, MaxUploadSize+1))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to read upload: %v", err)
+	}
+	if len(contents) > MaxUploadSize {
+		return nil, status.Error(codes.InvalidArgument, ErrUploadTooLarge.Error())
+	}
+	return contents, nil
+}
@@ -0,0 +1,198 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// importClient records the import request it receives.
+type importClient struct {
+	betterreads.BetterReadsServiceClient
+	file          []byte
+	source        betterreads.ImportSource
+	dryRun        bool
+	authorization []string
+	err           error
+}
+
+func (c *importClient) ImportLibrary(ctx context.Context, req *betterreads.ImportLibraryRequest, _ ...grpc.CallOption) (*betterreads.ImportLibraryResponse, error) {
+	c.file = req.File
+	c.source = req.Source
+	c.dryRun = req.DryRun
+	md, _ := metadata.FromOutgoingContext(ctx)
+	c.authorization = md.Get("authorization")
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &betterreads.ImportLibraryResponse{ImportedCount: 1, DryRun: req.DryRun}, nil
+}
+
+func (c *importClient) ImportKindleHighlights(ctx context.Context, req *betterreads.ImportKindleHighlightsRequest, _ ...grpc.CallOption) (*betterreads.ImportKindleHighlightsResponse, error) {
+	c.file = req.File
+	c.dryRun = req.DryRun
+	md, _ := metadata.FromOutgoingContext(ctx)
+	c.authorization = md.Get("authorization")
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &betterreads.ImportKindleHighlightsResponse{ImportedCount: 1, DryRun: req.DryRun}, nil
+}
+
+const goodreadsUploadPath = "/api/v1/library/import/goodreads/upload"
+
+func multipartRequest(t *testing.T, target, field string, contents []byte) *http.Request {
+	t.Helper()
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile(field, "goodreads_library_export.csv")
+	require.NoError(t, err)
+	_, err = part.Write(contents)
+	require.NoError(t, err)
+	require.NoError(t, form.Close())
+
+	req := httptest.NewRequest(http.MethodPost, target, &body)
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	req.Header.Set("Authorization", "Bearer token")
+	return req
+}
+
+func TestUpload(t *testing.T) {
+	t.Parallel()
+
+	csv := []byte("Book Id,Title\n1,Dune\n")
+
+	tests := []struct {
+		name       string
+		request    func(t *testing.T) *http.Request
+		clientErr  error
+		wantStatus int
+		wantCSV    []byte
+		wantSource betterreads.ImportSource
+		wantDryRun bool
+	}{
+		{
+			name: "forwards the file and credentials",
+			request: func(t *testing.T) *http.Request {
+				t.Helper()
+				return multipartRequest(t, goodreadsUploadPath, "file", csv)
+			},
+			wantStatus: http.StatusOK,
+			wantCSV:    csv,
+			wantSource: betterreads.ImportSource_IMPORT_SOURCE_GOODREADS,
+		},
+		{
+			name: "dry run",
+			request: func(t *testing.T) *http.Request {
+				t.Helper()
+				return multipartRequest(t, "/api/v1/library/import/storygraph/upload?dry_run=true", "file", csv)
+			},
+			wantStatus: http.StatusOK,
+			wantCSV:    csv,
+			wantSource: betterreads.ImportSource_IMPORT_SOURCE_STORYGRAPH,
+			wantDryRun: true,
+		},
+		{
+			name: "kindle clippings",
+			request: func(t *testing.T) *http.Request {
+				t.Helper()
+				return multipartRequest(t, KindleUploadPath+"?dry_run=true", "file", csv)
+			},
+			wantStatus: http.StatusOK,
+			wantCSV:    csv,
+			wantSource: betterreads.ImportSource_IMPORT_SOURCE_UNSPECIFIED,
+			wantDryRun: true,
+		},
+		{
+			name: "invalid dry_run",
+			request: func(t *testing.T) *http.Request {
+				t.Helper()
+				return multipartRequest(t, "/api/v1/library/import/librarything/upload?dry_run=maybe", "file", csv)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "unknown source",
+			request: func(t *testing.T) *http.Request {
+				t.Helper()
+				return multipartRequest(t, "/api/v1/library/import/shelfari/upload", "file", csv)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "wrong form field",
+			request: func(t *testing.T) *http.Request {
+				t.Helper()
+				return multipartRequest(t, goodreadsUploadPath, "upload", csv)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "not multipart",
+			request: func(t *testing.T) *http.Request {
+				t.Helper()
+				return httptest.NewRequest(http.MethodPost, goodreadsUploadPath, bytes.NewReader(csv))
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "file too large",
+			request: func(t *testing.T) *http.Request {
+				t.Helper()
+				return multipartRequest(t, goodreadsUploadPath, "file", bytes.Repeat([]byte("a"), MaxUploadSize+1))
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "rpc error",
+			request: func(t *testing.T) *http.Request {
+				t.Helper()
+				return multipartRequest(t, goodreadsUploadPath, "file", csv)
+			},
+			clientErr:  status.Error(codes.Unauthenticated, "invalid token"),
+			wantStatus: http.StatusUnauthorized,
+			wantCSV:    csv,
+			wantSource: betterreads.ImportSource_IMPORT_SOURCE_GOODREADS,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &importClient{err: tt.clientErr}
+			mux := runtime.NewServeMux()
+			require.NoError(t, RegisterUploadRoutes(mux, client))
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, tt.request(t))
+
+			assert.Equal(t, tt.wantStatus, rec.Code, rec.Body.String())
+			assert.Equal(t, tt.wantCSV, client.file)
+			if tt.wantCSV == nil {
+				return
+			}
+			assert.Equal(t, tt.wantSource, client.source)
+			assert.Equal(t, tt.wantDryRun, client.dryRun)
+			assert.Equal(t, []string{"Bearer token"}, client.authorization)
+			if tt.wantStatus == http.StatusOK {
+				var resp map[string]any
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+				assert.EqualValues(t, 1, resp["importedCount"])
+			}
+		})
+	}
+}
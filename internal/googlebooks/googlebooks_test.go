@@ -0,0 +1,167 @@
+package googlebooks
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const duneVolumes = `{
+	"kind": "books#volumes",
+	"totalItems": 2,
+	"items": [
+		{
+			"id": "B1hSG45JCX4C",
+			"volumeInfo": {
+				"title": "Dune",
+				"authors": ["Frank Herbert", "Brian Herbert"],
+				"publishedDate": "2005-08-02",
+				"industryIdentifiers": [
+					{"type": "ISBN_10", "identifier": "0441013597"},
+					{"type": "ISBN_13", "identifier": "9780441013593"}
+				],
+				"pageCount": 528,
+				"averageRating": 4.5,
+				"ratingsCount": 120,
+				"imageLinks": {"thumbnail": "http://books.google.com/books/content?id=B1hSG45JCX4C&img=1"}
+			}
+		},
+		{
+			"id": "p9cJAQAAMAAJ",
+			"volumeInfo": {
+				"title": "Dune Messiah",
+				"publishedDate": "1969",
+				"industryIdentifiers": [{"type": "OTHER", "identifier": "UOM:39015004611318"}]
+			}
+		}
+	]
+}`
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewClient("https://www.googleapis.com/books/v1", "key")
+	require.NoError(t, err)
+	assert.Equal(t, "key", client.APIKey)
+
+	_, err = NewClient("://invalid-url", "")
+	require.Error(t, err)
+}
+
+func TestClient_Search(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		query     provider.Query
+		apiKey    string
+		status    int
+		body      string
+		wantQuery string
+		want      []*data.Book
+		wantErr   error
+	}{
+		{
+			name:      "volumes",
+			query:     provider.Query{Query: "dune", Author: "Frank Herbert"},
+			apiKey:    "secret",
+			status:    http.StatusOK,
+			body:      duneVolumes,
+			wantQuery: `dune inauthor:"Frank Herbert"`,
+			want: []*data.Book{
+				{
+					Source:        data.BookSourceGoogleBooks,
+					ExternalID:    "B1hSG45JCX4C",
+					Title:         "Dune",
+					AuthorName:    "Frank Herbert",
+					BookImage:     "https://books.google.com/books/content?id=B1hSG45JCX4C&img=1",
+					ISBN:          "9780441013593",
+					PageCount:     528,
+					PublishYear:   2005,
+					RatingAverage: 4.5,
+					RatingCount:   120,
+				},
+				{
+					Source:      data.BookSourceGoogleBooks,
+					ExternalID:  "p9cJAQAAMAAJ",
+					Title:       "Dune Messiah",
+					PublishYear: 1969,
+				},
+			},
+		},
+		{
+			name:      "no results",
+			query:     provider.Query{Title: "zzzz", Subject: "fiction"},
+			status:    http.StatusOK,
+			body:      `{"kind": "books#volumes", "totalItems": 0}`,
+			wantQuery: `intitle:"zzzz" subject:"fiction"`,
+			want:      []*data.Book{},
+		},
+		{
+			name:    "empty query",
+			wantErr: provider.ErrBadRequest,
+		},
+		{
+			name:      "rejected",
+			query:     provider.Query{Query: "dune"},
+			status:    http.StatusForbidden,
+			body:      `{"error": {"code": 403, "message": "quota exceeded"}}`,
+			wantQuery: "dune",
+			wantErr:   provider.ErrBadRequest,
+		},
+		{
+			name:      "unavailable",
+			query:     provider.Query{Query: "dune"},
+			status:    http.StatusServiceUnavailable,
+			wantQuery: "dune",
+			wantErr:   provider.ErrInternalServer,
+		},
+		{
+			name:      "malformed response",
+			query:     provider.Query{Query: "dune"},
+			status:    http.StatusOK,
+			body:      `{"items": [`,
+			wantQuery: "dune",
+			wantErr:   provider.ErrInternalServer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/volumes", r.URL.Path)
+				assert.Equal(t, tt.wantQuery, r.URL.Query().Get("q"))
+				assert.Equal(t, tt.apiKey, r.URL.Query().Get("key"))
+				assert.Equal(t, "books", r.URL.Query().Get("printType"))
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			client, err := NewClient(srv.URL, tt.apiKey)
+			require.NoError(t, err)
+
+			got, err := client.Search(context.Background(), tt.query)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			for _, book := range got {
+				assert.False(t, book.UpdatedAt.IsZero())
+				book.CreatedAt, book.UpdatedAt = time.Time{}, time.Time{}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+)
+
+// Columns of the Goodreads "Export Library" CSV that the import reads.
+const (
+	goodreadsBookID         = "Book Id"
+	goodreadsTitle          = "Title"
+	goodreadsAuthor         = "Author"
+	goodreadsISBN           = "ISBN"
+	goodreadsISBN13         = "ISBN13"
+	goodreadsMyRating       = "My Rating"
+	goodreadsDateRead       = "Date Read"
+	goodreadsDateAdded      = "Date Added"
+	goodreadsBookshelves    = "Bookshelves"
+	goodreadsExclusiveShelf = "Exclusive Shelf"
+	goodreadsMyReview       = "My Review"
+	goodreadsSpoiler        = "Spoiler"
+	goodreadsPrivateNotes   = "Private Notes"
+)
+
+// goodreadsDateLayout is the format of dates in a Goodreads export.
+const goodreadsDateLayout = "2006/01/02"
+
+// goodreadsStatuses maps Goodreads exclusive shelves to reading statuses.
+// Goodreads only creates the first three; the rest are common custom names.
+var goodreadsStatuses = map[string]int32{
+	"read":              data.ReadingStatusRead,
+	"currently-reading": data.ReadingStatusReading,
+	"to-read":           data.ReadingStatusWantToRead,
+	"did-not-finish":    data.ReadingStatusDidNotFinish,
+	"dnf":               data.ReadingStatusDidNotFinish,
+}
+
+// Goodreads reads the CSV from Goodreads' "Export Library".
+type Goodreads struct{}
+
+func (Goodreads) Name() string { return "Goodreads" }
+
+func (Goodreads) Parse(r io.Reader) ([]Row, error) {
+	return readDelimited(r, ',', []string{
+		goodreadsBookID, goodreadsTitle, goodreadsAuthor, goodreadsISBN, goodreadsISBN13,
+		goodreadsMyRating, goodreadsDateAdded, goodreadsBookshelves, goodreadsExclusiveShelf,
+	}, parseGoodreadsRow)
+}
+
+func parseGoodreadsRow(line int, get func(column string) string) Row {
+	row := Row{
+		Line: line,
+		Book: data.LibraryBook{
+			Title:      get(goodreadsTitle),
+			AuthorName: get(goodreadsAuthor),
+			Source:     data.BookSourceManual,
+		},
+	}
+	book := &row.Book
+	book.Review = get(goodreadsMyReview)
+	book.ReviewHasSpoilers = strings.EqualFold(get(goodreadsSpoiler), "true")
+	book.Notes = get(goodreadsPrivateNotes)
+
+	book.ISBN = normalizeISBN(unquoteSpreadsheet(get(goodreadsISBN13)), unquoteSpreadsheet(get(goodreadsISBN)))
+	book.BookID = BookID(book.ISBN, "goodreads", get(goodreadsBookID))
+
+	switch {
+	case book.Title == "":
+		row.Err = fmt.Errorf("%w: title", ErrMissingField)
+		return row
+	case book.AuthorName == "":
+		row.Err = fmt.Errorf("%w: author", ErrMissingField)
+		return row
+	case book.BookID == "":
+		row.Err = fmt.Errorf("%w: ISBN or Goodreads book id", ErrMissingField)
+		return row
+	}
+
+	rating, err := starRating(get(goodreadsMyRating))
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	book.Rating = rating
+
+	if added := get(goodreadsDateAdded); added != "" {
+		addedAt, err := time.Parse(goodreadsDateLayout, added)
+		if err != nil {
+			row.Err = fmt.Errorf("%w: date added %q", ErrInvalidField, added)
+			return row
+		}
+		book.AddedAt = addedAt
+	}
+
+	// Goodreads keeps only the latest read's date.
+	readAt, err := parseDate(goodreadsDateLayout, goodreadsDateRead, get(goodreadsDateRead))
+	if err != nil {
+		row.Err = err
+		return row
+	}
+
+	// A custom exclusive shelf gets the nearest status, read when the book
+	// has a date read and want to read otherwise, and is kept as a plain
+	// shelf below.
+	exclusive := get(goodreadsExclusiveShelf)
+	status, ok := goodreadsStatuses[strings.ToLower(exclusive)]
+	switch {
+	case ok:
+	case readAt != nil:
+		status = data.ReadingStatusRead
+	default:
+		status = data.ReadingStatusWantToRead
+	}
+	book.ReadingStatus = status
+	addSession(book, nil, readAt)
+
+	// Bookshelves repeats the exclusive shelf, which is already the status
+	// unless it is a custom one.
+	shelves := splitList(get(goodreadsBookshelves), ",")
+	if !ok && exclusive != "" {
+		shelves = append(shelves, exclusive)
+	}
+	for _, shelf := range shelves {
+		if _, isStatus := goodreadsStatuses[strings.ToLower(shelf)]; !isStatus && !slices.Contains(row.Shelves, shelf) {
+			row.Shelves = append(row.Shelves, shelf)
+		}
+	}
+	return row
+}
+
+// unquoteSpreadsheet strips the ="..." wrapper Goodreads puts around ISBNs so
+// that spreadsheets keep their leading zeros.
+func unquoteSpreadsheet(value string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(value, `="`), `"`)
+}
@@ -0,0 +1,142 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const goodreadsHeader = `Book Id,Title,Author,Author l-f,Additional Authors,ISBN,ISBN13,My Rating,Average Rating,Publisher,Binding,Number of Pages,Year Published,Original Publication Year,Date Read,Date Added,Bookshelves,Bookshelves with positions,Exclusive Shelf,My Review,Spoiler,Private Notes,Read Count,Owned Copies`
+
+func TestGoodreads_Parse(t *testing.T) {
+	t.Parallel()
+
+	csv := goodreadsHeader + "\n" +
+		`2767052,"The Hunger Games (The Hunger Games, #1)",Suzanne Collins,"Collins, Suzanne",,"=""0439023483""","=""9780439023481""",4,4.33,Scholastic Press,Hardcover,374,2008,2008,2012/05/01,2012/04/20,"favorites, read",favorites (#3),read,,,,1,0` + "\n" +
+		`41865,Twilight,Stephenie Meyer,"Meyer, Stephenie",,"=""0316015849""","=""""",0,3.66,Little Brown,Paperback,498,2006,2005,,2013/01/02,to-read,to-read (#7),to-read,Everyone dies.,true,Borrowed from Sam,0,0` + "\n" +
+		`1,No Identifiers,Someone,,,"=""""","=""""",0,0,,,,,,,2020/01/01,"sci-fi, sci-fi",,currently-reading,,,,0,0` + "\n" +
+		`2,,Nobody,,,"=""""","=""""",0,0,,,,,,,2020/01/01,,,read,,,,0,0` + "\n" +
+		`3,Bad Rating,Someone,,,"=""""","=""""",9,0,,,,,,,2020/01/01,,,read,,,,0,0` + "\n" +
+		`4,Custom Shelf,Someone,,,"=""""","=""""",0,0,,,,,,,2020/01/01,"on-hold, sci-fi",,on-hold,,,,0,0` + "\n" +
+		`5,Custom Read Shelf,Someone,,,"=""""","=""""",5,0,,,,,,2021/03/04,2020/01/01,,,Borrowed,,,,0,0` + "\n"
+
+	rows, err := Goodreads{}.Parse(strings.NewReader(csv))
+	require.NoError(t, err)
+	require.Len(t, rows, 7)
+
+	hungerGames := rows[0]
+	require.NoError(t, hungerGames.Err)
+	assert.Equal(t, 2, hungerGames.Line)
+	assert.Equal(t, data.LibraryBook{
+		BookID:        "isbn:9780439023481",
+		Title:         "The Hunger Games (The Hunger Games, #1)",
+		AuthorName:    "Suzanne Collins",
+		ISBN:          "9780439023481",
+		Rating:        4,
+		Source:        data.BookSourceManual,
+		ReadingStatus: data.ReadingStatusRead,
+		Sessions:      []*data.ReadingSession{{FinishedAt: date(2012, 5, 1), Rating: 4}},
+		AddedAt:       time.Date(2012, 4, 20, 0, 0, 0, 0, time.UTC),
+	}, hungerGames.Book)
+	assert.Equal(t, []string{"favorites"}, hungerGames.Shelves)
+
+	// Only the ISBN-10 is present; it is converted to an ISBN-13.
+	twilight := rows[1]
+	require.NoError(t, twilight.Err)
+	assert.Equal(t, "9780316015844", twilight.Book.ISBN)
+	assert.Equal(t, "isbn:9780316015844", twilight.Book.BookID)
+	assert.Equal(t, data.ReadingStatusWantToRead, twilight.Book.ReadingStatus)
+	assert.Equal(t, "Everyone dies.", twilight.Book.Review)
+	assert.True(t, twilight.Book.ReviewHasSpoilers)
+	assert.Equal(t, "Borrowed from Sam", twilight.Book.Notes)
+	assert.Empty(t, twilight.Shelves)
+
+	noISBN := rows[2]
+	require.NoError(t, noISBN.Err)
+	assert.Equal(t, "goodreads:1", noISBN.Book.BookID)
+	assert.Equal(t, data.ReadingStatusReading, noISBN.Book.ReadingStatus)
+	assert.Equal(t, []string{"sci-fi"}, noISBN.Shelves)
+
+	require.ErrorIs(t, rows[3].Err, ErrMissingField)
+	require.ErrorIs(t, rows[4].Err, ErrInvalidField)
+
+	// Custom exclusive shelves are kept as plain shelves, with the nearest
+	// status.
+	onHold := rows[5]
+	require.NoError(t, onHold.Err)
+	assert.Equal(t, 7, onHold.Line)
+	assert.Equal(t, data.ReadingStatusWantToRead, onHold.Book.ReadingStatus)
+	assert.Equal(t, []string{"on-hold", "sci-fi"}, onHold.Shelves)
+
+	borrowed := rows[6]
+	require.NoError(t, borrowed.Err)
+	assert.Equal(t, data.ReadingStatusRead, borrowed.Book.ReadingStatus)
+	assert.Equal(t, []*data.ReadingSession{{FinishedAt: date(2021, 3, 4), Rating: 5}}, borrowed.Book.Sessions)
+	assert.Equal(t, []string{"Borrowed"}, borrowed.Shelves)
+}
+
+func TestGoodreads_NotAnExport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		csv     string
+		wantErr error
+	}{
+		{name: "empty", csv: "", wantErr: ErrEmptyFile},
+		{name: "other csv", csv: "Title,Author\nDune,Frank Herbert\n", wantErr: ErrMissingColumn},
+		{name: "byte order mark", csv: "\ufeff" + goodreadsHeader + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Goodreads{}.Parse(strings.NewReader(tt.csv))
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGoodreads_MalformedRow(t *testing.T) {
+	t.Parallel()
+
+	csv := goodreadsHeader + "\n" +
+		`1,Fine,Someone,,,,,0,0,,,,,,,2020/01/01,,,read,,,,0,0` + "\n" +
+		`2,"Broken,Someone` + "\n"
+
+	rows, err := Goodreads{}.Parse(strings.NewReader(csv))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.NoError(t, rows[0].Err)
+	require.ErrorIs(t, rows[1].Err, ErrMalformedFile)
+	assert.Equal(t, 3, rows[1].Line)
+}
+
+func TestNormalizeISBN(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		candidates []string
+		expected   string
+	}{
+		{candidates: []string{"9780439023481", "0439023483"}, expected: "9780439023481"},
+		{candidates: []string{"", "0439023483"}, expected: "9780439023481"},
+		{candidates: []string{"", "0-8044-2957-X"}, expected: "9780804429573"},
+		{candidates: []string{"978-0-306-40615-7"}, expected: "9780306406157"},
+		{candidates: []string{"12345", "abcdefghij"}, expected: ""},
+		{candidates: nil, expected: ""},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, normalizeISBN(tt.candidates...), tt.candidates)
+	}
+}
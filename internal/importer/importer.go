@@ -0,0 +1,219 @@
+// Package importer parses library exports from other reading sites into
+// library books, and Kindle clippings into highlights.
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/isbn"
+)
+
+var (
+	ErrEmptyFile     = errors.New("file is empty")
+	ErrMalformedFile = errors.New("file is malformed")
+	ErrMissingColumn = errors.New("missing column")
+	ErrMissingField  = errors.New("missing required field")
+	ErrInvalidField  = errors.New("invalid field")
+)
+
+// maxRating is the highest star rating.
+const maxRating = 5
+
+// Source parses the library export of one reading site.
+type Source interface {
+	// Name is the site's name, for messages.
+	Name() string
+	// Parse reads an export. It fails only if r is not an export from this
+	// site; problems with individual rows are reported on the row.
+	Parse(r io.Reader) ([]Row, error)
+}
+
+// Row is one book read from an export. Book has no UserID or ShelfIDs; the
+// caller assigns them. When Err is set the row could not be read and Book
+// holds whatever was parsed before the error.
+type Row struct {
+	// Line is the row's line number in the file, or its position in formats
+	// without lines, for reporting.
+	Line    int
+	Book    data.LibraryBook
+	Shelves []string
+	Err     error
+}
+
+// BookID returns the library book id for an imported book. Books with an ISBN
+// are keyed by it, so the same edition imported from different sites is one
+// library entry; otherwise the id is namespaced by the site it came from.
+func BookID(isbn, site, siteID string) string {
+	switch {
+	case isbn != "":
+		return "isbn:" + isbn
+	case siteID != "":
+		return site + ":" + siteID
+	default:
+		return ""
+	}
+}
+
+// ScopedBookID returns the id userID's library keeps a book imported with
+// bookID under when Open Library has no edition with its ISBN. Nothing an
+// export says about such a book can be checked, so each user's are catalog
+// entries of their own rather than ones shared with every library that
+// imports the same id.
+func ScopedBookID(userID, bookID string) string {
+	return "import:" + userID + ":" + bookID
+}
+
+// starRating converts a rating on a five star scale, which may use half or
+// quarter stars, to whole stars. Any rating rounds to at least one star and
+// blank or zero means unrated.
+func starRating(value string) (int32, error) {
+	if value == "" {
+		return 0, nil
+	}
+	stars, err := strconv.ParseFloat(value, 64)
+	if err != nil || stars < 0 || stars > maxRating {
+		return 0, fmt.Errorf("%w: rating %q", ErrInvalidField, value)
+	}
+	if stars == 0 {
+		return 0, nil
+	}
+	return int32(max(1, math.Round(stars))), nil
+}
+
+// parseDate parses an optional date column, returning nil if value is blank.
+func parseDate(layout, column, value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil //nolint: nilnil // a blank date is not an error
+	}
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s %q", ErrInvalidField, strings.ToLower(column), value)
+	}
+	return &t, nil
+}
+
+// addSession records the read an export's dates describe on book, if it has
+// any. A finished read carries the book's rating, or is a DNF if the book was
+// not finished.
+func addSession(book *data.LibraryBook, startedAt, finishedAt *time.Time) {
+	if startedAt == nil && finishedAt == nil {
+		return
+	}
+	session := &data.ReadingSession{StartedAt: startedAt, FinishedAt: finishedAt}
+	if finishedAt != nil {
+		session.DidNotFinish = book.ReadingStatus == data.ReadingStatusDidNotFinish
+		if !session.DidNotFinish {
+			session.Rating = book.Rating
+		}
+	}
+	book.Sessions = append(book.Sessions, session)
+}
+
+// readDelimited reads a CSV or TSV export with a header row, passing each
+// record to parse. The header must contain every column in required.
+func readDelimited(r io.Reader, comma rune, required []string, parse func(line int, get func(column string) string) Row) ([]Row, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = comma
+	reader.FieldsPerRecord = -1
+	// TSV exports do not quote fields.
+	reader.LazyQuotes = comma == '\t'
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, ErrEmptyFile
+		}
+		return nil, fmt.Errorf("%w: %w", ErrMalformedFile, err)
+	}
+	columns, err := indexColumns(header, required...)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			// A malformed quote can swallow the rest of the file, so stop here.
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				line = parseErr.StartLine
+			}
+			rows = append(rows, Row{Line: line, Err: fmt.Errorf("%w: %w", ErrMalformedFile, err)})
+			break
+		}
+		rows = append(rows, parse(line, columns.record(record)))
+	}
+	return rows, nil
+}
+
+// normalizeISBN returns the first valid candidate as an ISBN-13, converting
+// ISBN-10s, or "" if none is valid.
+func normalizeISBN(candidates ...string) string {
+	for _, candidate := range candidates {
+		if isbn13 := isbn.To13(candidate); isbn13 != "" {
+			return isbn13
+		}
+	}
+	return ""
+}
+
+// columnIndex maps header names to their position in a record.
+type columnIndex map[string]int
+
+// indexColumns locates the required columns in header.
+func indexColumns(header []string, required ...string) (columnIndex, error) {
+	columns := columnIndex{}
+	for i, name := range header {
+		// Excel prefixes UTF-8 exports with a byte order mark.
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		if _, seen := columns[name]; !seen {
+			columns[name] = i
+		}
+	}
+	var missing []string
+	for _, name := range required {
+		if _, ok := columns[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrMissingColumn, strings.Join(missing, ", "))
+	}
+	return columns, nil
+}
+
+// record returns a lookup of the trimmed value of a column in record, which is
+// "" for short rows.
+func (c columnIndex) record(record []string) func(column string) string {
+	return func(column string) string {
+		i, ok := c[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+}
+
+// splitList splits a delimited cell, dropping blank entries.
+func splitList(value, sep string) []string {
+	var items []string
+	for _, item := range strings.Split(value, sep) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
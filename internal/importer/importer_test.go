@@ -0,0 +1,86 @@
+package importer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) *time.Time {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &t
+}
+
+func TestAddSession(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		status     int32
+		startedAt  *time.Time
+		finishedAt *time.Time
+		expected   []*data.ReadingSession
+	}{
+		{name: "no dates", status: data.ReadingStatusRead},
+		{
+			name:       "finished",
+			status:     data.ReadingStatusRead,
+			finishedAt: date(2020, 1, 2),
+			expected:   []*data.ReadingSession{{FinishedAt: date(2020, 1, 2), Rating: 3}},
+		},
+		{
+			name:      "in progress",
+			status:    data.ReadingStatusReading,
+			startedAt: date(2020, 1, 1),
+			expected:  []*data.ReadingSession{{StartedAt: date(2020, 1, 1)}},
+		},
+		{
+			name:       "did not finish",
+			status:     data.ReadingStatusDidNotFinish,
+			startedAt:  date(2020, 1, 1),
+			finishedAt: date(2020, 1, 2),
+			expected:   []*data.ReadingSession{{StartedAt: date(2020, 1, 1), FinishedAt: date(2020, 1, 2), DidNotFinish: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		book := &data.LibraryBook{ReadingStatus: tt.status, Rating: 3}
+		addSession(book, tt.startedAt, tt.finishedAt)
+		assert.Equal(t, tt.expected, book.Sessions, tt.name)
+	}
+}
+
+func TestStarRating(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    string
+		expected int32
+		wantErr  bool
+	}{
+		{value: "", expected: 0},
+		{value: "0", expected: 0},
+		{value: "4", expected: 4},
+		{value: "3.5", expected: 4},
+		{value: "3.25", expected: 3},
+		{value: "4.75", expected: 5},
+		{value: "0.25", expected: 1},
+		{value: "5.0", expected: 5},
+		{value: "6", wantErr: true},
+		{value: "-1", wantErr: true},
+		{value: "four", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		rating, err := starRating(tt.value)
+		if tt.wantErr {
+			require.ErrorIs(t, err, ErrInvalidField, tt.value)
+			continue
+		}
+		require.NoError(t, err, tt.value)
+		assert.Equal(t, tt.expected, rating, tt.value)
+	}
+}
@@ -0,0 +1,313 @@
+package importer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+)
+
+var (
+	// ErrUnattachedNote is reported for a Kindle note that was not made on a
+	// highlight, since a highlight needs a quote.
+	ErrUnattachedNote = errors.New("note is not on a highlight")
+	// ErrClippingLimit is reported for a highlight the Kindle did not save
+	// because the publisher's limit on clippings from the book was reached.
+	ErrClippingLimit = errors.New("clipping limit reached for the book")
+)
+
+// kindleSeparator ends each clipping in "My Clippings.txt".
+const kindleSeparator = "=========="
+
+// kindleMaxLine bounds a single line of the file; a long highlight is one
+// line.
+const kindleMaxLine = 1 << 20
+
+// kindleClippingLimit starts the text a Kindle saves in place of a highlight
+// once the publisher's clipping limit for the book is reached.
+const kindleClippingLimit = "<You have reached the clipping limit"
+
+// kindleDateLayouts are the "Added on" formats written by US and UK locale
+// Kindles, newest firmware first.
+var kindleDateLayouts = []string{
+	"Monday, January 2, 2006 3:04:05 PM",
+	"Monday, 2 January 2006 15:04:05",
+	"Monday, January 2, 2006, 03:04 PM",
+}
+
+var (
+	kindlePage     = regexp.MustCompile(`(?i)\bpage ([0-9]+)`)
+	kindleLocation = regexp.MustCompile(`(?i)\b(?:location|loc\.) ([0-9]+(?:-[0-9]+)?)`)
+)
+
+// Clipping is one highlight read from a Kindle's "My Clippings.txt". The
+// highlight has its BookTitle and AuthorName set from the clipping; the
+// caller matches them to a library book. When Err is set the clipping could
+// not be read.
+type Clipping struct {
+	// Line is the line of the clipping's title in the file.
+	Line      int
+	Highlight data.Highlight
+	Err       error
+}
+
+// kindleEntry is one block of the file between separators.
+type kindleEntry struct {
+	line     int
+	kind     string
+	title    string
+	author   string
+	page     int32
+	location string
+	addedAt  time.Time
+	text     string
+}
+
+// ParseKindleClippings reads the "My Clippings.txt" a Kindle keeps of its
+// highlights, notes and bookmarks. Each highlight becomes a clipping and
+// each note becomes the comment of the highlight it was made on: the nearest
+// one in the file on the same book whose location range covers the note.
+// Bookmarks are skipped. It fails only if r is not a clippings file.
+func ParseKindleClippings(r io.Reader) ([]Clipping, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, kindleMaxLine)
+
+	var (
+		clippings []Clipping
+		notes     []*kindleEntry
+		// noteAt holds where each note came in clippings, to find the
+		// nearest highlight.
+		noteAt    []int
+		block     []string
+		blockLine int
+		line      int
+		separated bool
+	)
+	flush := func() {
+		if len(block) == 0 {
+			return
+		}
+		entry, err := parseKindleEntry(blockLine, block)
+		block = nil
+		switch {
+		case err != nil:
+			clippings = append(clippings, Clipping{Line: entry.line, Err: err})
+		case entry.kind == kindleHighlight:
+			clippings = append(clippings, kindleClipping(entry))
+		case entry.kind == kindleNote:
+			notes = append(notes, entry)
+			noteAt = append(noteAt, len(clippings))
+		}
+	}
+	for scanner.Scan() {
+		line++
+		// Kindles put a byte order mark before each title, not just the first.
+		text := strings.TrimRight(strings.ReplaceAll(scanner.Text(), "\ufeff", ""), "\r")
+		if strings.TrimSpace(text) == kindleSeparator {
+			separated = true
+			flush()
+			continue
+		}
+		if len(block) == 0 {
+			if strings.TrimSpace(text) == "" {
+				continue
+			}
+			blockLine = line
+		}
+		block = append(block, text)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedFile, err)
+	}
+	switch {
+	case !separated && len(block) == 0:
+		return nil, ErrEmptyFile
+	case !separated:
+		return nil, fmt.Errorf("%w: no %q separators", ErrMalformedFile, kindleSeparator)
+	}
+	// A file cut short may end without a separator.
+	flush()
+
+	var unattached []Clipping
+	for i, note := range notes {
+		if h := nearestKindleHighlight(clippings, noteAt[i], note); h != nil {
+			if h.Comment != "" {
+				h.Comment += "\n\n"
+			}
+			h.Comment += note.text
+			continue
+		}
+		unattached = append(unattached, Clipping{
+			Line:      note.line,
+			Highlight: data.Highlight{BookTitle: note.title, AuthorName: note.author, Comment: note.text},
+			Err:       ErrUnattachedNote,
+		})
+	}
+	if len(unattached) > 0 {
+		clippings = append(clippings, unattached...)
+		slices.SortStableFunc(clippings, func(a, b Clipping) int { return a.Line - b.Line })
+	}
+	return clippings, nil
+}
+
+// Kinds of clipping.
+const (
+	kindleHighlight = "highlight"
+	kindleNote      = "note"
+	kindleBookmark  = "bookmark"
+)
+
+func kindleClipping(entry *kindleEntry) Clipping {
+	clipping := Clipping{
+		Line: entry.line,
+		Highlight: data.Highlight{
+			BookTitle:  entry.title,
+			AuthorName: entry.author,
+			Quote:      entry.text,
+			Page:       entry.page,
+			Location:   entry.location,
+			CreatedAt:  entry.addedAt,
+		},
+	}
+	switch {
+	case strings.HasPrefix(entry.text, kindleClippingLimit):
+		clipping.Err = ErrClippingLimit
+	case entry.text == "":
+		clipping.Err = fmt.Errorf("%w: highlight text", ErrMissingField)
+	}
+	return clipping
+}
+
+// nearestKindleHighlight returns the highlight note was made on, searching
+// outwards from at, the position in clippings the note was read at.
+func nearestKindleHighlight(clippings []Clipping, at int, note *kindleEntry) *data.Highlight {
+	for distance := 0; distance <= len(clippings); distance++ {
+		for _, i := range []int{at - 1 - distance, at + distance} {
+			if i < 0 || i >= len(clippings) || clippings[i].Err != nil {
+				continue
+			}
+			h := &clippings[i].Highlight
+			if h.BookTitle == note.title && kindleNoteOn(note, h) {
+				return h
+			}
+		}
+	}
+	return nil
+}
+
+// parseKindleEntry reads a block, starting at line, made of a
+// "Title (Author)" line, a line describing the clipping, a blank line and the
+// clipping's text. The entry is returned even on error, for its line.
+func parseKindleEntry(line int, block []string) (*kindleEntry, error) {
+	entry := &kindleEntry{line: line}
+	if len(block) < 2 || !strings.HasPrefix(block[1], "- ") { //nolint: mnd // title and description lines
+		return entry, fmt.Errorf("%w: clipping has no description line", ErrMalformedFile)
+	}
+
+	entry.title, entry.author = kindleTitleAuthor(strings.TrimSpace(block[0]))
+	if entry.title == "" {
+		return entry, fmt.Errorf("%w: title", ErrMissingField)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(block[1], "- "), "|")
+	kind := strings.ToLower(parts[0])
+	switch {
+	case strings.Contains(kind, kindleHighlight):
+		entry.kind = kindleHighlight
+	case strings.Contains(kind, kindleNote):
+		entry.kind = kindleNote
+	case strings.Contains(kind, kindleBookmark):
+		entry.kind = kindleBookmark
+	default:
+		return entry, fmt.Errorf("%w: clipping type %q", ErrInvalidField, strings.TrimSpace(parts[0]))
+	}
+
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if m := kindlePage.FindStringSubmatch(part); m != nil {
+			page, err := strconv.ParseInt(m[1], 10, 32)
+			if err == nil {
+				entry.page = int32(page)
+			}
+		}
+		if m := kindleLocation.FindStringSubmatch(part); m != nil {
+			entry.location = m[1]
+		}
+		if added, ok := strings.CutPrefix(part, "Added on "); ok {
+			for _, layout := range kindleDateLayouts {
+				if t, err := time.Parse(layout, added); err == nil {
+					entry.addedAt = t
+					break
+				}
+			}
+		}
+	}
+
+	if len(block) > 2 { //nolint: mnd // text follows the description line
+		entry.text = strings.TrimSpace(strings.Join(block[2:], "\n"))
+	}
+	return entry, nil
+}
+
+// kindleTitleAuthor splits a "Title (Author)" line. The author is the last
+// parenthesized group, so a series in the title is kept; authors written
+// "Last, First" are turned around and only the first of several is kept.
+func kindleTitleAuthor(line string) (string, string) {
+	if !strings.HasSuffix(line, ")") {
+		return line, ""
+	}
+	depth := 0
+	for i := len(line) - 1; i >= 0; i-- {
+		switch line[i] {
+		case ')':
+			depth++
+		case '(':
+			depth--
+			if depth == 0 {
+				author, _, _ := strings.Cut(line[i+1:len(line)-1], ";")
+				return strings.TrimSpace(line[:i]), firstLast(author)
+			}
+		}
+	}
+	return line, ""
+}
+
+// kindleNoteOn reports whether note was made on highlight h: the note's
+// location falls in the highlight's range or, without locations, they are on
+// the same page.
+func kindleNoteOn(note *kindleEntry, h *data.Highlight) bool {
+	at, _, okNote := kindleLocationRange(note.location)
+	from, to, okHighlight := kindleLocationRange(h.Location)
+	if okNote && okHighlight {
+		return from <= at && at <= to
+	}
+	return note.page > 0 && note.page == h.Page
+}
+
+// kindleLocationRange parses a location such as "170-172" or "170".
+func kindleLocationRange(location string) (int, int, bool) {
+	start, end, isRange := strings.Cut(location, "-")
+	from, err := strconv.Atoi(start)
+	if err != nil {
+		return 0, 0, false
+	}
+	if !isRange {
+		return from, from, true
+	}
+	// Older Kindles shorten the end of a range, writing 1170-72.
+	if len(end) < len(start) {
+		end = start[:len(start)-len(end)] + end
+	}
+	to, err := strconv.Atoi(end)
+	if err != nil || to < from {
+		return from, from, true
+	}
+	return from, to, true
+}
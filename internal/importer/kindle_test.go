@@ -0,0 +1,119 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKindleClippings(t *testing.T) {
+	t.Parallel()
+
+	clippings := strings.Join([]string{
+		"\ufeffThe Hunger Games (Hunger Games, Book 1) (Collins, Suzanne)",
+		"- Your Highlight on page 12 | Location 170-172 | Added on Sunday, March 3, 2019 10:00:00 PM",
+		"",
+		"May the odds be ever in your favor.",
+		"==========",
+		"\ufeffThe Hunger Games (Hunger Games, Book 1) (Collins, Suzanne)",
+		"- Your Note on page 12 | Location 172 | Added on Sunday, March 3, 2019 10:01:00 PM",
+		"",
+		"Effie's catchphrase",
+		"==========",
+		"Piranesi (Susanna Clarke)",
+		"- Your Bookmark on Location 400 | Added on Monday, 4 March 2019 08:00:00",
+		"",
+		"",
+		"==========",
+		"Piranesi (Susanna Clarke)",
+		"- Your Note at location 900 | Added on Monday, 4 March 2019 08:05:00",
+		"",
+		"Check this later",
+		"==========",
+		"Piranesi (Susanna Clarke)",
+		"- Your Note at location 1172 | Added on Monday, 4 March 2019 08:11:00",
+		"",
+		"Written before the highlight in some firmware",
+		"==========",
+		"Piranesi (Susanna Clarke)",
+		"- Your Highlight at location 1170-72 | Added on Monday, 4 March 2019 08:10:00",
+		"",
+		"The Beauty of the House is immeasurable;",
+		"its Kindness infinite.",
+		"==========",
+		"Locked Book (Someone)",
+		"- Your Highlight on Location 5-6 | Added on Tuesday, March 5, 2019 1:00:00 AM",
+		"",
+		"<You have reached the clipping limit for this item>",
+		"==========",
+		"No description",
+		"==========",
+	}, "\r\n") + "\r\n"
+
+	got, err := ParseKindleClippings(strings.NewReader(clippings))
+	require.NoError(t, err)
+	require.Len(t, got, 5)
+
+	hungerGames := got[0]
+	require.NoError(t, hungerGames.Err)
+	assert.Equal(t, 1, hungerGames.Line)
+	assert.Equal(t, data.Highlight{
+		BookTitle:  "The Hunger Games (Hunger Games, Book 1)",
+		AuthorName: "Suzanne Collins",
+		Quote:      "May the odds be ever in your favor.",
+		Page:       12,
+		Location:   "170-172",
+		Comment:    "Effie's catchphrase",
+		CreatedAt:  time.Date(2019, 3, 3, 22, 0, 0, 0, time.UTC),
+	}, hungerGames.Highlight)
+
+	require.ErrorIs(t, got[1].Err, ErrUnattachedNote)
+	assert.Equal(t, 16, got[1].Line)
+	assert.Equal(t, "Check this later", got[1].Highlight.Comment)
+
+	piranesi := got[2]
+	require.NoError(t, piranesi.Err)
+	assert.Equal(t, "The Beauty of the House is immeasurable;\nits Kindness infinite.", piranesi.Highlight.Quote)
+	assert.Equal(t, "1170-72", piranesi.Highlight.Location)
+	assert.Equal(t, "Written before the highlight in some firmware", piranesi.Highlight.Comment)
+	assert.Equal(t, time.Date(2019, 3, 4, 8, 10, 0, 0, time.UTC), piranesi.Highlight.CreatedAt)
+
+	require.ErrorIs(t, got[3].Err, ErrClippingLimit)
+	require.ErrorIs(t, got[4].Err, ErrMalformedFile)
+	assert.Equal(t, 37, got[4].Line)
+}
+
+func TestParseKindleClippings_NotClippings(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseKindleClippings(strings.NewReader(""))
+	require.ErrorIs(t, err, ErrEmptyFile)
+
+	_, err = ParseKindleClippings(strings.NewReader("Title,Author\nDune,Frank Herbert\n"))
+	require.ErrorIs(t, err, ErrMalformedFile)
+}
+
+func TestKindleTitleAuthor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line       string
+		wantTitle  string
+		wantAuthor string
+	}{
+		{"Dune (Frank Herbert)", "Dune", "Frank Herbert"},
+		{"Dune (Herbert, Frank)", "Dune", "Frank Herbert"},
+		{"Good Omens (Pratchett, Terry;Gaiman, Neil)", "Good Omens", "Terry Pratchett"},
+		{"Notes (on Things) (Someone (Editor))", "Notes (on Things)", "Someone (Editor)"},
+		{"Personal Document", "Personal Document", ""},
+	}
+	for _, tt := range tests {
+		title, author := kindleTitleAuthor(tt.line)
+		assert.Equal(t, tt.wantTitle, title, tt.line)
+		assert.Equal(t, tt.wantAuthor, author, tt.line)
+	}
+}
@@ -0,0 +1,315 @@
+package importer
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+)
+
+// Columns of the LibraryThing tab-delimited export that the import reads.
+const (
+	libraryThingBookID        = "Book Id"
+	libraryThingTitle         = "Title"
+	libraryThingPrimaryAuthor = "Primary Author"
+	libraryThingRating        = "Rating"
+	libraryThingTags          = "Tags"
+	libraryThingCollections   = "Collections"
+	libraryThingISBN          = "ISBN"
+	libraryThingISBNs         = "ISBNs"
+	libraryThingEntryDate     = "Entry Date"
+	libraryThingDateStarted   = "Date Started"
+	libraryThingDateRead      = "Date Read"
+	libraryThingReview        = "Review"
+	libraryThingPrivate       = "Private Comment"
+)
+
+// libraryThingDateLayout is the format of dates in a LibraryThing export.
+const libraryThingDateLayout = "2006-01-02"
+
+// libraryThingStatuses maps LibraryThing's built-in collections to reading
+// statuses, in order of precedence. Books in none of them are read if they
+// have a date read and otherwise want to read.
+var libraryThingStatuses = []struct {
+	collection string
+	status     int32
+}{
+	{"currently reading", data.ReadingStatusReading},
+	{"read but unowned", data.ReadingStatusRead},
+	{"to read", data.ReadingStatusWantToRead},
+	{"wishlist", data.ReadingStatusWantToRead},
+}
+
+// LibraryThing reads LibraryThing's tab-delimited or JSON export, telling
+// them apart by the first character. Half star ratings are rounded up to
+// whole stars and tags become shelves.
+type LibraryThing struct{}
+
+func (LibraryThing) Name() string { return "LibraryThing" }
+
+func (LibraryThing) Parse(r io.Reader) ([]Row, error) {
+	br := bufio.NewReader(r)
+	for {
+		c, _, err := br.ReadRune()
+		if errors.Is(err, io.EOF) {
+			return nil, ErrEmptyFile
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrMalformedFile, err)
+		}
+		if unicode.IsSpace(c) || c == '\ufeff' {
+			continue
+		}
+		_ = br.UnreadRune()
+		if c == '{' {
+			return parseLibraryThingJSON(br)
+		}
+		return readDelimited(br, '\t', []string{
+			libraryThingBookID, libraryThingTitle, libraryThingPrimaryAuthor, libraryThingRating,
+			libraryThingTags, libraryThingCollections, libraryThingISBNs, libraryThingEntryDate,
+		}, parseLibraryThingTSVRow)
+	}
+}
+
+func parseLibraryThingTSVRow(line int, get func(column string) string) Row {
+	isbns := append([]string{strings.Trim(get(libraryThingISBN), "[]")}, splitList(get(libraryThingISBNs), ",")...)
+	return libraryThingRow(line, libraryThingBook{
+		id:          get(libraryThingBookID),
+		title:       get(libraryThingTitle),
+		author:      firstLast(get(libraryThingPrimaryAuthor)),
+		rating:      get(libraryThingRating),
+		tags:        splitList(get(libraryThingTags), ","),
+		collections: splitList(get(libraryThingCollections), ","),
+		isbns:       isbns,
+		entryDate:   get(libraryThingEntryDate),
+		dateStarted: get(libraryThingDateStarted),
+		dateRead:    get(libraryThingDateRead),
+		review:      get(libraryThingReview),
+		notes:       get(libraryThingPrivate),
+	})
+}
+
+// libraryThingEntry is one book in the JSON export.
+type libraryThingEntry struct {
+	BooksID       string          `json:"books_id"`
+	Title         string          `json:"title"`
+	PrimaryAuthor string          `json:"primaryauthor"`
+	Authors       json.RawMessage `json:"authors"`
+	Rating        json.Number     `json:"rating"`
+	Tags          []string        `json:"tags"`
+	Collections   []string        `json:"collections"`
+	ISBN          json.RawMessage `json:"isbn"`
+	OriginalISBN  string          `json:"originalisbn"`
+	EntryDate     string          `json:"entrydate"`
+	DateStarted   string          `json:"datestarted"`
+	DateRead      string          `json:"dateread"`
+	Review        string          `json:"review"`
+	Private       string          `json:"privatecomment"`
+}
+
+// parseLibraryThingJSON reads the JSON export, an object of books keyed by
+// their LibraryThing id. It is decoded one book at a time to keep the file's
+// order, and a row's Line is its position in the object.
+func parseLibraryThingJSON(r io.Reader) ([]Row, error) {
+	dec := json.NewDecoder(r)
+	if _, err := dec.Token(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedFile, err)
+	}
+
+	var rows []Row
+	for line := 1; dec.More(); line++ {
+		key, err := dec.Token()
+		if err != nil {
+			rows = append(rows, Row{Line: line, Err: fmt.Errorf("%w: %w", ErrMalformedFile, err)})
+			break
+		}
+		var entry libraryThingEntry
+		if err := dec.Decode(&entry); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &typeErr) {
+				// A syntax error leaves the decoder unable to find the next book.
+				rows = append(rows, Row{Line: line, Err: fmt.Errorf("%w: %w", ErrMalformedFile, err)})
+				break
+			}
+			rows = append(rows, Row{Line: line, Err: fmt.Errorf("%w: %s", ErrInvalidField, typeErr.Field)})
+			continue
+		}
+
+		id, _ := key.(string)
+		rows = append(rows, libraryThingRow(line, libraryThingBook{
+			id:          cmp.Or(entry.BooksID, id),
+			title:       strings.TrimSpace(entry.Title),
+			author:      cmp.Or(libraryThingAuthor(entry.Authors), firstLast(entry.PrimaryAuthor)),
+			rating:      entry.Rating.String(),
+			tags:        entry.Tags,
+			collections: entry.Collections,
+			isbns:       append(jsonStrings(entry.ISBN), entry.OriginalISBN),
+			entryDate:   strings.TrimSpace(entry.EntryDate),
+			dateStarted: strings.TrimSpace(entry.DateStarted),
+			dateRead:    strings.TrimSpace(entry.DateRead),
+			review:      strings.TrimSpace(entry.Review),
+			notes:       strings.TrimSpace(entry.Private),
+		}))
+	}
+	return rows, nil
+}
+
+// libraryThingBook is a book from either LibraryThing format.
+type libraryThingBook struct {
+	id          string
+	title       string
+	author      string
+	rating      string
+	tags        []string
+	collections []string
+	isbns       []string
+	entryDate   string
+	dateStarted string
+	dateRead    string
+	review      string
+	notes       string
+}
+
+func libraryThingRow(line int, lt libraryThingBook) Row {
+	row := Row{
+		Line: line,
+		Book: data.LibraryBook{
+			Title:      lt.title,
+			AuthorName: lt.author,
+			Review:     lt.review,
+			Notes:      lt.notes,
+			Source:     data.BookSourceManual,
+		},
+	}
+	book := &row.Book
+
+	book.ISBN = normalizeISBN(lt.isbns...)
+	book.BookID = BookID(book.ISBN, "librarything", lt.id)
+
+	switch {
+	case book.Title == "":
+		row.Err = fmt.Errorf("%w: title", ErrMissingField)
+		return row
+	case book.AuthorName == "":
+		row.Err = fmt.Errorf("%w: author", ErrMissingField)
+		return row
+	case book.BookID == "":
+		row.Err = fmt.Errorf("%w: ISBN or LibraryThing book id", ErrMissingField)
+		return row
+	}
+
+	rating, err := starRating(lt.rating)
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	book.Rating = rating
+
+	book.ReadingStatus = data.ReadingStatusWantToRead
+	if lt.dateRead != "" {
+		book.ReadingStatus = data.ReadingStatusRead
+	}
+	for _, s := range libraryThingStatuses {
+		if slices.ContainsFunc(lt.collections, func(c string) bool { return strings.EqualFold(strings.TrimSpace(c), s.collection) }) {
+			book.ReadingStatus = s.status
+			break
+		}
+	}
+
+	if lt.entryDate != "" {
+		addedAt, err := time.Parse(libraryThingDateLayout, lt.entryDate)
+		if err != nil {
+			row.Err = fmt.Errorf("%w: entry date %q", ErrInvalidField, lt.entryDate)
+			return row
+		}
+		book.AddedAt = addedAt
+	}
+
+	startedAt, err := parseDate(libraryThingDateLayout, libraryThingDateStarted, lt.dateStarted)
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	readAt, err := parseDate(libraryThingDateLayout, libraryThingDateRead, lt.dateRead)
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	addSession(book, startedAt, readAt)
+
+	for _, tag := range lt.tags {
+		if tag = strings.TrimSpace(tag); tag != "" && !slices.Contains(row.Shelves, tag) {
+			row.Shelves = append(row.Shelves, tag)
+		}
+	}
+	return row
+}
+
+// libraryThingAuthor returns the first author's name from the JSON export's
+// authors list, or "" if it has none.
+func libraryThingAuthor(raw json.RawMessage) string {
+	var authors []struct {
+		FL string `json:"fl"`
+		LF string `json:"lf"`
+	}
+	if json.Unmarshal(raw, &authors) != nil {
+		return ""
+	}
+	for _, author := range authors {
+		if name := cmp.Or(strings.TrimSpace(author.FL), firstLast(author.LF)); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// jsonStrings reads a value the JSON export writes as a string, an array of
+// strings or an object of strings keyed by position, as it does for ISBNs.
+func jsonStrings(raw json.RawMessage) []string {
+	var one string
+	if json.Unmarshal(raw, &one) == nil {
+		return []string{one}
+	}
+	var list []string
+	if json.Unmarshal(raw, &list) == nil {
+		return list
+	}
+	var keyed map[string]string
+	if json.Unmarshal(raw, &keyed) != nil {
+		return nil
+	}
+	keys := make([]string, 0, len(keyed))
+	for key := range keyed {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		i, _ := strconv.Atoi(a)
+		j, _ := strconv.Atoi(b)
+		return i - j
+	})
+	values := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, keyed[key])
+	}
+	return values
+}
+
+// firstLast turns a "Last, First" name into "First Last". Names with more
+// than one comma, such as "King, Martin Luther, Jr.", are left alone.
+func firstLast(name string) string {
+	name = strings.TrimSpace(name)
+	if strings.Count(name, ",") != 1 {
+		return name
+	}
+	last, first, _ := strings.Cut(name, ",")
+	return strings.TrimSpace(strings.TrimSpace(first) + " " + strings.TrimSpace(last))
+}
@@ -0,0 +1,136 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibraryThing_ParseTSV(t *testing.T) {
+	t.Parallel()
+
+	tsv := strings.Join([]string{
+		"Book Id\tTitle\tPrimary Author\tRating\tTags\tCollections\tISBN\tISBNs\tEntry Date\tDate Started\tDate Read",
+		"101\tThe Hunger Games\tCollins, Suzanne\t4.5\tfavorites, dystopia\tYour library\t[0439023483]\t0439023483, 9780439023481\t2012-04-20\t2012-04-21\t2012-05-01",
+		"102\tPiranesi\tClarke, Susanna\t\t\tYour library, Currently reading\t\t\t2021-03-04\t2021-03-05\t",
+		"103\tDune\tFrank Herbert\t\t\tWishlist\t[]\t\t2020-01-01\t\t",
+		"104\tUnread\tSomeone\t\t\tYour library\t\t\t2020-01-01\t\t",
+		"105\tBad Date\tSomeone\t\t\tYour library\t\t\tyesterday\t\t",
+	}, "\n") + "\n"
+
+	rows, err := LibraryThing{}.Parse(strings.NewReader(tsv))
+	require.NoError(t, err)
+	require.Len(t, rows, 5)
+
+	hungerGames := rows[0]
+	require.NoError(t, hungerGames.Err)
+	assert.Equal(t, 2, hungerGames.Line)
+	assert.Equal(t, data.LibraryBook{
+		BookID:        "isbn:9780439023481",
+		Title:         "The Hunger Games",
+		AuthorName:    "Suzanne Collins",
+		ISBN:          "9780439023481",
+		Rating:        5,
+		Source:        data.BookSourceManual,
+		ReadingStatus: data.ReadingStatusRead,
+		Sessions:      []*data.ReadingSession{{StartedAt: date(2012, 4, 21), FinishedAt: date(2012, 5, 1), Rating: 5}},
+		AddedAt:       time.Date(2012, 4, 20, 0, 0, 0, 0, time.UTC),
+	}, hungerGames.Book)
+	assert.Equal(t, []string{"favorites", "dystopia"}, hungerGames.Shelves)
+
+	assert.Equal(t, "librarything:102", rows[1].Book.BookID)
+	assert.Equal(t, data.ReadingStatusReading, rows[1].Book.ReadingStatus)
+	assert.Equal(t, []*data.ReadingSession{{StartedAt: date(2021, 3, 5)}}, rows[1].Book.Sessions)
+	assert.Equal(t, data.ReadingStatusWantToRead, rows[2].Book.ReadingStatus)
+	assert.Equal(t, "Frank Herbert", rows[2].Book.AuthorName)
+	assert.Equal(t, data.ReadingStatusWantToRead, rows[3].Book.ReadingStatus)
+	require.ErrorIs(t, rows[4].Err, ErrInvalidField)
+}
+
+func TestLibraryThing_ParseJSON(t *testing.T) {
+	t.Parallel()
+
+	doc := `
+	{
+		"202": {
+			"books_id": "202",
+			"title": "Piranesi",
+			"primaryauthor": "Clarke, Susanna",
+			"authors": [{"lf": "Clarke, Susanna", "fl": "Susanna Clarke", "role": ""}],
+			"rating": 3.5,
+			"tags": ["fantasy"],
+			"collections": ["Currently reading"],
+			"isbn": {"0": "1635575990", "2": "9781635575996"},
+			"entrydate": "2021-03-04"
+		},
+		"101": {
+			"books_id": "101",
+			"title": "The Hunger Games",
+			"primaryauthor": "Collins, Suzanne",
+			"authors": [],
+			"tags": [],
+			"collections": ["Your library"],
+			"isbn": ["0439023483"],
+			"entrydate": "2012-04-20",
+			"dateread": "2012-05-01"
+		},
+		"303": {"books_id": "303", "title": "Bad Rating", "primaryauthor": "Someone", "rating": [4]},
+		"404": {"books_id": "404", "primaryauthor": "Someone"}
+	}`
+
+	rows, err := LibraryThing{}.Parse(strings.NewReader(doc))
+	require.NoError(t, err)
+	require.Len(t, rows, 4)
+
+	piranesi := rows[0]
+	require.NoError(t, piranesi.Err)
+	assert.Equal(t, 1, piranesi.Line)
+	assert.Equal(t, data.LibraryBook{
+		BookID:        "isbn:9781635575996",
+		Title:         "Piranesi",
+		AuthorName:    "Susanna Clarke",
+		ISBN:          "9781635575996",
+		Rating:        4,
+		Source:        data.BookSourceManual,
+		ReadingStatus: data.ReadingStatusReading,
+		AddedAt:       time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC),
+	}, piranesi.Book)
+	assert.Equal(t, []string{"fantasy"}, piranesi.Shelves)
+
+	hungerGames := rows[1]
+	require.NoError(t, hungerGames.Err)
+	assert.Equal(t, "isbn:9780439023481", hungerGames.Book.BookID)
+	assert.Equal(t, "Suzanne Collins", hungerGames.Book.AuthorName)
+	assert.Equal(t, data.ReadingStatusRead, hungerGames.Book.ReadingStatus)
+
+	require.ErrorIs(t, rows[2].Err, ErrInvalidField)
+	require.ErrorIs(t, rows[3].Err, ErrMissingField)
+	assert.Equal(t, 4, rows[3].Line)
+}
+
+func TestLibraryThing_NotAnExport(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		file    string
+		wantErr error
+	}{
+		{name: "empty", file: " \n", wantErr: ErrEmptyFile},
+		{name: "goodreads csv", file: goodreadsHeader + "\n", wantErr: ErrMissingColumn},
+		{name: "json array", file: `[{"title": "Dune"}]`, wantErr: ErrMissingColumn},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := LibraryThing{}.Parse(strings.NewReader(tt.file))
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
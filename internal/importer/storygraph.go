@@ -0,0 +1,120 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+)
+
+// Columns of the StoryGraph "Export StoryGraph Library" CSV that the import
+// reads.
+const (
+	storyGraphTitle      = "Title"
+	storyGraphAuthors    = "Authors"
+	storyGraphISBN       = "ISBN/UID"
+	storyGraphReadStatus = "Read Status"
+	storyGraphDateAdded  = "Date Added"
+	storyGraphLastRead   = "Last Date Read"
+	storyGraphStarRating = "Star Rating"
+	storyGraphReview     = "Review"
+	storyGraphTags       = "Tags"
+)
+
+// storyGraphDateLayout is the format of dates in a StoryGraph export.
+const storyGraphDateLayout = "2006/01/02"
+
+// storyGraphStatuses maps StoryGraph read statuses to reading statuses. A
+// paused book is still being read.
+var storyGraphStatuses = map[string]int32{
+	"read":              data.ReadingStatusRead,
+	"currently-reading": data.ReadingStatusReading,
+	"paused":            data.ReadingStatusReading,
+	"to-read":           data.ReadingStatusWantToRead,
+	"did-not-finish":    data.ReadingStatusDidNotFinish,
+}
+
+// StoryGraph reads the CSV from StoryGraph's "Export StoryGraph Library".
+// Star ratings in quarter stars are rounded to whole stars and tags become
+// shelves.
+type StoryGraph struct{}
+
+func (StoryGraph) Name() string { return "StoryGraph" }
+
+func (StoryGraph) Parse(r io.Reader) ([]Row, error) {
+	return readDelimited(r, ',', []string{
+		storyGraphTitle, storyGraphAuthors, storyGraphISBN, storyGraphReadStatus,
+		storyGraphDateAdded, storyGraphStarRating, storyGraphTags,
+	}, parseStoryGraphRow)
+}
+
+func parseStoryGraphRow(line int, get func(column string) string) Row {
+	row := Row{
+		Line: line,
+		Book: data.LibraryBook{
+			Title:      get(storyGraphTitle),
+			AuthorName: get(storyGraphAuthors),
+			Review:     get(storyGraphReview),
+			Source:     data.BookSourceManual,
+		},
+	}
+	book := &row.Book
+
+	// ISBN/UID holds StoryGraph's own id for editions without an ISBN.
+	uid := get(storyGraphISBN)
+	book.ISBN = normalizeISBN(uid)
+	book.BookID = BookID(book.ISBN, "storygraph", uid)
+
+	switch {
+	case book.Title == "":
+		row.Err = fmt.Errorf("%w: title", ErrMissingField)
+		return row
+	case book.AuthorName == "":
+		row.Err = fmt.Errorf("%w: authors", ErrMissingField)
+		return row
+	case book.BookID == "":
+		row.Err = fmt.Errorf("%w: ISBN/UID", ErrMissingField)
+		return row
+	}
+
+	rating, err := starRating(get(storyGraphStarRating))
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	book.Rating = rating
+
+	readStatus := strings.ToLower(get(storyGraphReadStatus))
+	status, ok := storyGraphStatuses[readStatus]
+	if !ok {
+		row.Err = fmt.Errorf("%w: read status %q", ErrInvalidField, readStatus)
+		return row
+	}
+	book.ReadingStatus = status
+
+	if added := get(storyGraphDateAdded); added != "" {
+		addedAt, err := time.Parse(storyGraphDateLayout, added)
+		if err != nil {
+			row.Err = fmt.Errorf("%w: date added %q", ErrInvalidField, added)
+			return row
+		}
+		book.AddedAt = addedAt
+	}
+
+	lastRead, err := parseDate(storyGraphDateLayout, storyGraphLastRead, get(storyGraphLastRead))
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	addSession(book, nil, lastRead)
+
+	for _, tag := range splitList(get(storyGraphTags), ",") {
+		if !slices.Contains(row.Shelves, tag) {
+			row.Shelves = append(row.Shelves, tag)
+		}
+	}
+	return row
+}
@@ -0,0 +1,68 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const storyGraphHeader = `Title,Authors,Contributors,ISBN/UID,Format,Read Status,Date Added,Last Date Read,Dates Read,Read Count,Moods,Pace,Character- or Plot-Driven?,Strong Character Development?,Loveable Characters?,Diverse Characters?,Flawed Characters?,Star Rating,Review,Content Warnings,Content Warning Description,Tags,Owned?`
+
+func TestStoryGraph_Parse(t *testing.T) {
+	t.Parallel()
+
+	csv := storyGraphHeader + "\n" +
+		`The Hunger Games,Suzanne Collins,,9780439023481,hardcover,read,2012/04/20,2012/05/01,2012/05/01,1,adventurous,fast,Plot,Yes,Yes,No,Yes,3.75,,,,"favorites, dystopia",Yes` + "\n" +
+		`Piranesi,Susanna Clarke,,a1b2c3d4,ebook,paused,2021/03/04,,,0,,,,,,,,3.5,,,,,No` + "\n" +
+		`Dune,Frank Herbert,,0441013597,paperback,to-read,2020/01/01,,,0,,,,,,,,,,,,,No` + "\n" +
+		`Bad Rating,Someone,,9780306406157,,read,2020/01/01,,,0,,,,,,,,7,,,,,No` + "\n" +
+		`Bad Status,Someone,,9780306406157,,abandoned,2020/01/01,,,0,,,,,,,,,,,,,No` + "\n"
+
+	rows, err := StoryGraph{}.Parse(strings.NewReader(csv))
+	require.NoError(t, err)
+	require.Len(t, rows, 5)
+
+	hungerGames := rows[0]
+	require.NoError(t, hungerGames.Err)
+	assert.Equal(t, data.LibraryBook{
+		BookID:        "isbn:9780439023481",
+		Title:         "The Hunger Games",
+		AuthorName:    "Suzanne Collins",
+		ISBN:          "9780439023481",
+		Rating:        4,
+		Source:        data.BookSourceManual,
+		ReadingStatus: data.ReadingStatusRead,
+		Sessions:      []*data.ReadingSession{{FinishedAt: date(2012, 5, 1), Rating: 4}},
+		AddedAt:       time.Date(2012, 4, 20, 0, 0, 0, 0, time.UTC),
+	}, hungerGames.Book)
+	assert.Equal(t, []string{"favorites", "dystopia"}, hungerGames.Shelves)
+
+	piranesi := rows[1]
+	require.NoError(t, piranesi.Err)
+	assert.Equal(t, "storygraph:a1b2c3d4", piranesi.Book.BookID)
+	assert.Empty(t, piranesi.Book.ISBN)
+	assert.Equal(t, int32(4), piranesi.Book.Rating)
+	assert.Equal(t, data.ReadingStatusReading, piranesi.Book.ReadingStatus)
+	assert.Empty(t, piranesi.Shelves)
+
+	dune := rows[2]
+	require.NoError(t, dune.Err)
+	assert.Equal(t, "isbn:9780441013593", dune.Book.BookID)
+	assert.Equal(t, data.ReadingStatusWantToRead, dune.Book.ReadingStatus)
+	assert.Zero(t, dune.Book.Rating)
+
+	require.ErrorIs(t, rows[3].Err, ErrInvalidField)
+	require.ErrorIs(t, rows[4].Err, ErrInvalidField)
+	assert.Equal(t, 6, rows[4].Line)
+}
+
+func TestStoryGraph_NotAnExport(t *testing.T) {
+	t.Parallel()
+
+	_, err := StoryGraph{}.Parse(strings.NewReader(goodreadsHeader + "\n"))
+	require.ErrorIs(t, err, ErrMissingColumn)
+}
@@ -0,0 +1,35 @@
+// Package isbn normalizes ISBNs so books from different sources can be
+// compared by a single key.
+package isbn
+
+import "strings"
+
+// To13 returns isbn as a bare ISBN-13, converting an ISBN-10 and dropping
+// hyphens and spaces, or "" if isbn is neither.
+func To13(isbn string) string {
+	isbn = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(isbn))
+	switch len(isbn) {
+	case 13: //nolint: mnd // ISBN-13 length
+		if strings.Trim(isbn, "0123456789") != "" {
+			return ""
+		}
+		return isbn
+	case 10: //nolint: mnd // ISBN-10 length
+		if strings.Trim(isbn[:9], "0123456789") != "" || strings.Trim(isbn[9:], "0123456789X") != "" {
+			return ""
+		}
+		// ISBN-13 check digit: digits weighted 1, 3, 1, 3, ...
+		isbn = "978" + isbn[:9]
+		sum := 0
+		for i, c := range isbn {
+			weight := 1
+			if i%2 == 1 {
+				weight = 3
+			}
+			sum += int(c-'0') * weight
+		}
+		return isbn + string(rune('0'+(10-sum%10)%10))
+	default:
+		return ""
+	}
+}
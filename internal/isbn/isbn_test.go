@@ -0,0 +1,34 @@
+package isbn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTo13(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		isbn string
+		want string
+	}{
+		{isbn: "9780441013593", want: "9780441013593"},
+		{isbn: "978-0-441-01359-3", want: "9780441013593"},
+		{isbn: "0441013597", want: "9780441013593"},
+		{isbn: "0-8044-2957-x", want: "9780804429573"},
+		{isbn: "0-8044-2957-X", want: "9780804429573"},
+		{isbn: "0 8044 2957 X", want: "9780804429573"},
+		{isbn: "", want: ""},
+		{isbn: "044101359", want: ""},
+		{isbn: "978044101359X", want: ""},
+		{isbn: "X441013597", want: ""},
+		{isbn: "abcdefghij", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.isbn, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, To13(tt.isbn))
+		})
+	}
+}
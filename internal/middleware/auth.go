@@ -15,23 +15,54 @@ import (
 // GRPCAuthentication creates a gRPC unary interceptor that validates the Authorization metadata.
 func GRPCAuthentication(authn auth.Authenticator) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
-		}
-
-		authHeader := md.Get("authorization")
-		if len(authHeader) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+		ctx, err := authenticate(ctx, authn)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		token := strings.TrimPrefix(authHeader[0], "Bearer ")
-		verifiedToken, err := authn.VerifyIDToken(ctx, token)
+// GRPCStreamAuthentication is the streaming counterpart of GRPCAuthentication.
+func GRPCStreamAuthentication(authn auth.Authenticator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(ss.Context(), authn)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "invalid token")
+			return err
 		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		ctx = context.WithValue(ctx, headers.UserIDContextKey, verifiedToken.UserID)
-		return handler(ctx, req)
+// authenticate verifies the bearer token in the Authorization metadata and
+// returns ctx carrying the caller's user ID.
+func authenticate(ctx context.Context, authn auth.Authenticator) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
 	}
+
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing authorization header")
+	}
+
+	token := strings.TrimPrefix(authHeader[0], "Bearer ")
+	verifiedToken, err := authn.VerifyIDToken(ctx, token)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid token")
+	}
+
+	return context.WithValue(ctx, headers.UserIDContextKey, verifiedToken.UserID), nil
+}
+
+// authenticatedStream overrides the stream's context with one carrying the
+// caller's user ID.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context //nolint: containedctx // the stream's context is replaced, not stored
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
 }
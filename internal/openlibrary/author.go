@@ -0,0 +1,96 @@
+package openlibrary
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	library "github.com/celestialdragonfly/betterreads/internal/openlibrary/contracts"
+)
+
+var authorID = regexp.MustCompile(`^OL[0-9]+A$`)
+
+// Author is a person Open Library credits with works.
+type Author struct {
+	Key       string
+	Name      string
+	Bio       string
+	BirthDate string // As given, e.g. "13 September 1916"
+	DeathDate string
+	Photo     string
+}
+
+// AuthorWork is one entry of an author's bibliography.
+type AuthorWork struct {
+	Key              string
+	Title            string
+	Subtitle         string
+	CoverImage       string
+	FirstPublishDate string
+}
+
+// AuthorWorks is one page of an author's bibliography.
+type AuthorWorks struct {
+	Works []AuthorWork
+	// Total is the number of works by the author.
+	Total int
+}
+
+// GetAuthor looks up an author by Open Library author id (OL34184A). It
+// returns ErrBadRequest for any other id and ErrNotFound if Open Library does
+// not have the author.
+func (c *Client) GetAuthor(ctx context.Context, id string) (*Author, error) {
+	if !authorID.MatchString(id) {
+		return nil, fmt.Errorf("GetAuthor: %w, %q is not an Open Library author id", ErrBadRequest, id)
+	}
+	resp, err := c.Client.GetAuthor(ctx, id, &library.GetAuthorParams{UserAgent: userAgent})
+	if err != nil {
+		return nil, fmt.Errorf("GetAuthor: %w - request error: %w", ErrInternalServer, err)
+	}
+	author, err := parseResponse("GetAuthor", resp, library.ParseGetAuthorResponse,
+		func(r *library.GetAuthorResponse) *library.Author { return r.JSON200 })
+	if err != nil {
+		return nil, err
+	}
+	return &Author{
+		Key:       keyID(author.Key),
+		Name:      author.Name,
+		Bio:       text(author.Bio),
+		BirthDate: deref(author.BirthDate),
+		DeathDate: deref(author.DeathDate),
+		Photo:     imageURL("a", deref(author.Photos)),
+	}, nil
+}
+
+// GetAuthorWorks returns up to limit of an author's works, skipping the first
+// offset, in the order Open Library lists them.
+func (c *Client) GetAuthorWorks(ctx context.Context, id string, limit, offset int) (*AuthorWorks, error) {
+	if !authorID.MatchString(id) {
+		return nil, fmt.Errorf("GetAuthorWorks: %w, %q is not an Open Library author id", ErrBadRequest, id)
+	}
+	resp, err := c.Client.GetAuthorWorks(ctx, id, &library.GetAuthorWorksParams{
+		UserAgent: userAgent,
+		Limit:     &limit,
+		Offset:    &offset,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetAuthorWorks: %w - request error: %w", ErrInternalServer, err)
+	}
+	works, err := parseResponse("GetAuthorWorks", resp, library.ParseGetAuthorWorksResponse,
+		func(r *library.GetAuthorWorksResponse) *library.AuthorWorks { return r.JSON200 })
+	if err != nil {
+		return nil, err
+	}
+
+	result := &AuthorWorks{Total: works.Size}
+	for _, work := range works.Entries {
+		result.Works = append(result.Works, AuthorWork{
+			Key:              keyID(work.Key),
+			Title:            work.Title,
+			Subtitle:         deref(work.Subtitle),
+			CoverImage:       coverImage(deref(work.Covers)),
+			FirstPublishDate: deref(work.FirstPublishDate),
+		})
+	}
+	return result, nil
+}
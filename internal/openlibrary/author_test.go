@@ -0,0 +1,96 @@
+package openlibrary
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAuthor(t *testing.T) {
+	t.Parallel()
+
+	found := map[string]string{
+		"/authors/OL34184A.json": `{
+			"key": "/authors/OL34184A",
+			"name": "Roald Dahl",
+			"bio": {"type": "/type/text", "value": "British novelist."},
+			"birth_date": "13 September 1916",
+			"death_date": "23 November 1990",
+			"photos": [9395323]
+		}`,
+		"/authors/OL1A.json": `{"key": "/authors/OL1A", "name": "Anonymous", "bio": "Unknown."}`,
+	}
+
+	t.Run("with typed bio", func(t *testing.T) {
+		t.Parallel()
+		author, err := newTestClient(t, routes(t, found)).GetAuthor(context.Background(), "OL34184A")
+		require.NoError(t, err)
+		assert.Equal(t, &Author{
+			Key:       "OL34184A",
+			Name:      "Roald Dahl",
+			Bio:       "British novelist.",
+			BirthDate: "13 September 1916",
+			DeathDate: "23 November 1990",
+			Photo:     "https://covers.openlibrary.org/a/id/9395323-L.jpg",
+		}, author)
+	})
+
+	t.Run("with plain bio", func(t *testing.T) {
+		t.Parallel()
+		author, err := newTestClient(t, routes(t, found)).GetAuthor(context.Background(), "OL1A")
+		require.NoError(t, err)
+		assert.Equal(t, "Unknown.", author.Bio)
+		assert.Empty(t, author.Photo)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		t.Parallel()
+		_, err := newTestClient(t, routes(t, found)).GetAuthor(context.Background(), "OL45804W")
+		require.ErrorIs(t, err, ErrBadRequest)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+		_, err := newTestClient(t, routes(t, found)).GetAuthor(context.Background(), "OL2A")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestGetAuthorWorks(t *testing.T) {
+	t.Parallel()
+
+	doer := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "2", req.URL.Query().Get("limit"))
+			assert.Equal(t, "4", req.URL.Query().Get("offset"))
+			return routes(t, map[string]string{
+				"/authors/OL34184A/works.json": `{
+					"size": 52,
+					"entries": [
+						{"key": "/works/OL45804W", "title": "Fantastic Mr Fox", "covers": [6498519], "first_publish_date": "1970"},
+						{"key": "/works/OL45883W", "title": "Matilda"}
+					]
+				}`,
+			}).Do(req)
+		},
+	}
+
+	works, err := newTestClient(t, doer).GetAuthorWorks(context.Background(), "OL34184A", 2, 4)
+	require.NoError(t, err)
+	assert.Equal(t, 52, works.Total)
+	assert.Equal(t, []AuthorWork{
+		{
+			Key:              "OL45804W",
+			Title:            "Fantastic Mr Fox",
+			CoverImage:       "https://covers.openlibrary.org/b/id/6498519-L.jpg",
+			FirstPublishDate: "1970",
+		},
+		{Key: "OL45883W", Title: "Matilda"},
+	}, works.Works)
+
+	_, err = newTestClient(t, doer).GetAuthorWorks(context.Background(), "Roald Dahl", 2, 4)
+	require.ErrorIs(t, err, ErrBadRequest)
+}
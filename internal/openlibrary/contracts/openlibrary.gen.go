@@ -15,6 +15,57 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Author defines model for Author.
+type Author struct {
+	// Bio Free text, given either as a string or as a typed value.
+	Bio                  *Text                  `json:"bio,omitempty"`
+	BirthDate            *string                `json:"birth_date,omitempty"`
+	DeathDate            *string                `json:"death_date,omitempty"`
+	Key                  string                 `json:"key"`
+	Name                 string                 `json:"name"`
+	PersonalName         *string                `json:"personal_name,omitempty"`
+	Photos               *[]int                 `json:"photos,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// AuthorWorks defines model for AuthorWorks.
+type AuthorWorks struct {
+	Entries []Work `json:"entries"`
+
+	// Size Number of works by the author
+	Size                 int                    `json:"size"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// Edition defines model for Edition.
+type Edition struct {
+	Authors *[]Reference `json:"authors,omitempty"`
+	Covers  *[]int       `json:"covers,omitempty"`
+
+	// Description Free text, given either as a string or as a typed value.
+	Description          *Text                  `json:"description,omitempty"`
+	Isbn10               *[]string              `json:"isbn_10,omitempty"`
+	Isbn13               *[]string              `json:"isbn_13,omitempty"`
+	Key                  string                 `json:"key"`
+	NumberOfPages        *int                   `json:"number_of_pages,omitempty"`
+	PhysicalFormat       *string                `json:"physical_format,omitempty"`
+	PublishDate          *string                `json:"publish_date,omitempty"`
+	Publishers           *[]string              `json:"publishers,omitempty"`
+	Subtitle             *string                `json:"subtitle,omitempty"`
+	Title                string                 `json:"title"`
+	Works                *[]Reference           `json:"works,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// PlainText defines model for PlainText.
+type PlainText = string
+
+// Reference defines model for Reference.
+type Reference struct {
+	Key                  string                 `json:"key"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // SearchDocument defines model for SearchDocument.
 type SearchDocument struct {
 	AuthorKey            []string               `json:"author_key"`
@@ -22,6 +73,7 @@ type SearchDocument struct {
 	CoverEditionKey      string                 `json:"cover_edition_key"`
 	Isbn                 []string               `json:"isbn"`
 	Key                  string                 `json:"key"`
+	NumberOfPagesMedian  *int                   `json:"number_of_pages_median,omitempty"`
 	PublishYear          []int                  `json:"publish_year"`
 	RatingsAverage       float32                `json:"ratings_average"`
 	RatingsCount         int                    `json:"ratings_count"`
@@ -40,6 +92,80 @@ type SearchResponse struct {
 	Start            int              `json:"start"`
 }
 
+// Text Free text, given either as a string or as a typed value.
+type Text struct {
+	union json.RawMessage
+}
+
+// TypedText defines model for TypedText.
+type TypedText struct {
+	Type  *string `json:"type,omitempty"`
+	Value string  `json:"value"`
+}
+
+// Work defines model for Work.
+type Work struct {
+	Authors *[]WorkAuthor `json:"authors,omitempty"`
+	Covers  *[]int        `json:"covers,omitempty"`
+
+	// Description Free text, given either as a string or as a typed value.
+	Description          *Text                  `json:"description,omitempty"`
+	FirstPublishDate     *string                `json:"first_publish_date,omitempty"`
+	Key                  string                 `json:"key"`
+	Subjects             *[]string              `json:"subjects,omitempty"`
+	Subtitle             *string                `json:"subtitle,omitempty"`
+	Title                string                 `json:"title"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// WorkAuthor defines model for WorkAuthor.
+type WorkAuthor struct {
+	Author               Reference              `json:"author"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// WorkEditions defines model for WorkEditions.
+type WorkEditions struct {
+	Entries []Edition `json:"entries"`
+
+	// Size Number of editions of the work
+	Size                 int                    `json:"size"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// UserAgent defines model for UserAgent.
+type UserAgent = string
+
+// GetAuthorParams defines parameters for GetAuthor.
+type GetAuthorParams struct {
+	// UserAgent Contact info
+	UserAgent UserAgent `json:"User-Agent"`
+}
+
+// GetAuthorWorksParams defines parameters for GetAuthorWorks.
+type GetAuthorWorksParams struct {
+	// Limit Number of works to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of works to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// UserAgent Contact info
+	UserAgent UserAgent `json:"User-Agent"`
+}
+
+// GetEditionParams defines parameters for GetEdition.
+type GetEditionParams struct {
+	// UserAgent Contact info
+	UserAgent UserAgent `json:"User-Agent"`
+}
+
+// GetEditionByISBNParams defines parameters for GetEditionByISBN.
+type GetEditionByISBNParams struct {
+	// UserAgent Contact info
+	UserAgent UserAgent `json:"User-Agent"`
+}
+
 // SearchBooksParams defines parameters for SearchBooks.
 type SearchBooksParams struct {
 	// Q General search query (title, author, etc.)
@@ -71,64 +197,74 @@ type SearchBooksParams struct {
 	Lang *string `form:"lang,omitempty" json:"lang,omitempty"`
 
 	// UserAgent Contact info
-	UserAgent string `json:"User-Agent"`
+	UserAgent UserAgent `json:"User-Agent"`
 }
 
-// Getter for additional properties for SearchDocument. Returns the specified
+// GetWorkParams defines parameters for GetWork.
+type GetWorkParams struct {
+	// UserAgent Contact info
+	UserAgent UserAgent `json:"User-Agent"`
+}
+
+// GetWorkEditionsParams defines parameters for GetWorkEditions.
+type GetWorkEditionsParams struct {
+	// Limit Number of editions to return
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Number of editions to skip
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// UserAgent Contact info
+	UserAgent UserAgent `json:"User-Agent"`
+}
+
+// Getter for additional properties for Author. Returns the specified
 // element and whether it was found
-func (a SearchDocument) Get(fieldName string) (value interface{}, found bool) {
+func (a Author) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for SearchDocument
-func (a *SearchDocument) Set(fieldName string, value interface{}) {
+// Setter for additional properties for Author
+func (a *Author) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for SearchDocument to handle AdditionalProperties
-func (a *SearchDocument) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for Author to handle AdditionalProperties
+func (a *Author) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["author_key"]; found {
-		err = json.Unmarshal(raw, &a.AuthorKey)
-		if err != nil {
-			return fmt.Errorf("error reading 'author_key': %w", err)
-		}
-		delete(object, "author_key")
-	}
-
-	if raw, found := object["author_name"]; found {
-		err = json.Unmarshal(raw, &a.AuthorName)
+	if raw, found := object["bio"]; found {
+		err = json.Unmarshal(raw, &a.Bio)
 		if err != nil {
-			return fmt.Errorf("error reading 'author_name': %w", err)
+			return fmt.Errorf("error reading 'bio': %w", err)
 		}
-		delete(object, "author_name")
+		delete(object, "bio")
 	}
 
-	if raw, found := object["cover_edition_key"]; found {
-		err = json.Unmarshal(raw, &a.CoverEditionKey)
+	if raw, found := object["birth_date"]; found {
+		err = json.Unmarshal(raw, &a.BirthDate)
 		if err != nil {
-			return fmt.Errorf("error reading 'cover_edition_key': %w", err)
+			return fmt.Errorf("error reading 'birth_date': %w", err)
 		}
-		delete(object, "cover_edition_key")
+		delete(object, "birth_date")
 	}
 
-	if raw, found := object["isbn"]; found {
-		err = json.Unmarshal(raw, &a.Isbn)
+	if raw, found := object["death_date"]; found {
+		err = json.Unmarshal(raw, &a.DeathDate)
 		if err != nil {
-			return fmt.Errorf("error reading 'isbn': %w", err)
+			return fmt.Errorf("error reading 'death_date': %w", err)
 		}
-		delete(object, "isbn")
+		delete(object, "death_date")
 	}
 
 	if raw, found := object["key"]; found {
@@ -139,36 +275,28 @@ func (a *SearchDocument) UnmarshalJSON(b []byte) error {
 		delete(object, "key")
 	}
 
-	if raw, found := object["publish_year"]; found {
-		err = json.Unmarshal(raw, &a.PublishYear)
-		if err != nil {
-			return fmt.Errorf("error reading 'publish_year': %w", err)
-		}
-		delete(object, "publish_year")
-	}
-
-	if raw, found := object["ratings_average"]; found {
-		err = json.Unmarshal(raw, &a.RatingsAverage)
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
 		if err != nil {
-			return fmt.Errorf("error reading 'ratings_average': %w", err)
+			return fmt.Errorf("error reading 'name': %w", err)
 		}
-		delete(object, "ratings_average")
+		delete(object, "name")
 	}
 
-	if raw, found := object["ratings_count"]; found {
-		err = json.Unmarshal(raw, &a.RatingsCount)
+	if raw, found := object["personal_name"]; found {
+		err = json.Unmarshal(raw, &a.PersonalName)
 		if err != nil {
-			return fmt.Errorf("error reading 'ratings_count': %w", err)
+			return fmt.Errorf("error reading 'personal_name': %w", err)
 		}
-		delete(object, "ratings_count")
+		delete(object, "personal_name")
 	}
 
-	if raw, found := object["title"]; found {
-		err = json.Unmarshal(raw, &a.Title)
+	if raw, found := object["photos"]; found {
+		err = json.Unmarshal(raw, &a.Photos)
 		if err != nil {
-			return fmt.Errorf("error reading 'title': %w", err)
+			return fmt.Errorf("error reading 'photos': %w", err)
 		}
-		delete(object, "title")
+		delete(object, "photos")
 	}
 
 	if len(object) != 0 {
@@ -185,34 +313,29 @@ func (a *SearchDocument) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for SearchDocument to handle AdditionalProperties
-func (a SearchDocument) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for Author to handle AdditionalProperties
+func (a Author) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.AuthorKey != nil {
-		object["author_key"], err = json.Marshal(a.AuthorKey)
+	if a.Bio != nil {
+		object["bio"], err = json.Marshal(a.Bio)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'author_key': %w", err)
+			return nil, fmt.Errorf("error marshaling 'bio': %w", err)
 		}
 	}
 
-	if a.AuthorName != nil {
-		object["author_name"], err = json.Marshal(a.AuthorName)
+	if a.BirthDate != nil {
+		object["birth_date"], err = json.Marshal(a.BirthDate)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'author_name': %w", err)
+			return nil, fmt.Errorf("error marshaling 'birth_date': %w", err)
 		}
 	}
 
-	object["cover_edition_key"], err = json.Marshal(a.CoverEditionKey)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'cover_edition_key': %w", err)
-	}
-
-	if a.Isbn != nil {
-		object["isbn"], err = json.Marshal(a.Isbn)
+	if a.DeathDate != nil {
+		object["death_date"], err = json.Marshal(a.DeathDate)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'isbn': %w", err)
+			return nil, fmt.Errorf("error marshaling 'death_date': %w", err)
 		}
 	}
 
@@ -221,26 +344,23 @@ func (a SearchDocument) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'key': %w", err)
 	}
 
-	if a.PublishYear != nil {
-		object["publish_year"], err = json.Marshal(a.PublishYear)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'publish_year': %w", err)
-		}
-	}
-
-	object["ratings_average"], err = json.Marshal(a.RatingsAverage)
+	object["name"], err = json.Marshal(a.Name)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'ratings_average': %w", err)
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
-	object["ratings_count"], err = json.Marshal(a.RatingsCount)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'ratings_count': %w", err)
+	if a.PersonalName != nil {
+		object["personal_name"], err = json.Marshal(a.PersonalName)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'personal_name': %w", err)
+		}
 	}
 
-	object["title"], err = json.Marshal(a.Title)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'title': %w", err)
+	if a.Photos != nil {
+		object["photos"], err = json.Marshal(a.Photos)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'photos': %w", err)
+		}
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -252,105 +372,1607 @@ func (a SearchDocument) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// Getter for additional properties for AuthorWorks. Returns the specified
+// element and whether it was found
+func (a AuthorWorks) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+// Setter for additional properties for AuthorWorks
+func (a *AuthorWorks) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// Override default JSON handling for AuthorWorks to handle AdditionalProperties
+func (a *AuthorWorks) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	if raw, found := object["entries"]; found {
+		err = json.Unmarshal(raw, &a.Entries)
+		if err != nil {
+			return fmt.Errorf("error reading 'entries': %w", err)
+		}
+		delete(object, "entries")
+	}
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	if raw, found := object["size"]; found {
+		err = json.Unmarshal(raw, &a.Size)
+		if err != nil {
+			return fmt.Errorf("error reading 'size': %w", err)
+		}
+		delete(object, "size")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// Override default JSON handling for AuthorWorks to handle AdditionalProperties
+func (a AuthorWorks) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
+	if a.Entries != nil {
+		object["entries"], err = json.Marshal(a.Entries)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'entries': %w", err)
 		}
 	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
+
+	object["size"], err = json.Marshal(a.Size)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'size': %w", err)
 	}
-	return &client, nil
-}
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
 	}
+	return json.Marshal(object)
 }
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
+// Getter for additional properties for Edition. Returns the specified
+// element and whether it was found
+func (a Edition) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
 	}
+	return
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// SearchBooks request
-	SearchBooks(ctx context.Context, params *SearchBooksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Setter for additional properties for Edition
+func (a *Edition) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-func (c *Client) SearchBooks(ctx context.Context, params *SearchBooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewSearchBooksRequest(c.Server, params)
+// Override default JSON handling for Edition to handle AdditionalProperties
+func (a *Edition) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["authors"]; found {
+		err = json.Unmarshal(raw, &a.Authors)
+		if err != nil {
+			return fmt.Errorf("error reading 'authors': %w", err)
+		}
+		delete(object, "authors")
+	}
+
+	if raw, found := object["covers"]; found {
+		err = json.Unmarshal(raw, &a.Covers)
+		if err != nil {
+			return fmt.Errorf("error reading 'covers': %w", err)
+		}
+		delete(object, "covers")
+	}
+
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
+		if err != nil {
+			return fmt.Errorf("error reading 'description': %w", err)
+		}
+		delete(object, "description")
+	}
+
+	if raw, found := object["isbn_10"]; found {
+		err = json.Unmarshal(raw, &a.Isbn10)
+		if err != nil {
+			return fmt.Errorf("error reading 'isbn_10': %w", err)
+		}
+		delete(object, "isbn_10")
+	}
+
+	if raw, found := object["isbn_13"]; found {
+		err = json.Unmarshal(raw, &a.Isbn13)
+		if err != nil {
+			return fmt.Errorf("error reading 'isbn_13': %w", err)
+		}
+		delete(object, "isbn_13")
+	}
+
+	if raw, found := object["key"]; found {
+		err = json.Unmarshal(raw, &a.Key)
+		if err != nil {
+			return fmt.Errorf("error reading 'key': %w", err)
+		}
+		delete(object, "key")
+	}
+
+	if raw, found := object["number_of_pages"]; found {
+		err = json.Unmarshal(raw, &a.NumberOfPages)
+		if err != nil {
+			return fmt.Errorf("error reading 'number_of_pages': %w", err)
+		}
+		delete(object, "number_of_pages")
+	}
+
+	if raw, found := object["physical_format"]; found {
+		err = json.Unmarshal(raw, &a.PhysicalFormat)
+		if err != nil {
+			return fmt.Errorf("error reading 'physical_format': %w", err)
+		}
+		delete(object, "physical_format")
+	}
+
+	if raw, found := object["publish_date"]; found {
+		err = json.Unmarshal(raw, &a.PublishDate)
+		if err != nil {
+			return fmt.Errorf("error reading 'publish_date': %w", err)
+		}
+		delete(object, "publish_date")
+	}
+
+	if raw, found := object["publishers"]; found {
+		err = json.Unmarshal(raw, &a.Publishers)
+		if err != nil {
+			return fmt.Errorf("error reading 'publishers': %w", err)
+		}
+		delete(object, "publishers")
+	}
+
+	if raw, found := object["subtitle"]; found {
+		err = json.Unmarshal(raw, &a.Subtitle)
+		if err != nil {
+			return fmt.Errorf("error reading 'subtitle': %w", err)
+		}
+		delete(object, "subtitle")
+	}
+
+	if raw, found := object["title"]; found {
+		err = json.Unmarshal(raw, &a.Title)
+		if err != nil {
+			return fmt.Errorf("error reading 'title': %w", err)
+		}
+		delete(object, "title")
+	}
+
+	if raw, found := object["works"]; found {
+		err = json.Unmarshal(raw, &a.Works)
+		if err != nil {
+			return fmt.Errorf("error reading 'works': %w", err)
+		}
+		delete(object, "works")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Edition to handle AdditionalProperties
+func (a Edition) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Authors != nil {
+		object["authors"], err = json.Marshal(a.Authors)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'authors': %w", err)
+		}
+	}
+
+	if a.Covers != nil {
+		object["covers"], err = json.Marshal(a.Covers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'covers': %w", err)
+		}
+	}
+
+	if a.Description != nil {
+		object["description"], err = json.Marshal(a.Description)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+		}
+	}
+
+	if a.Isbn10 != nil {
+		object["isbn_10"], err = json.Marshal(a.Isbn10)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'isbn_10': %w", err)
+		}
+	}
+
+	if a.Isbn13 != nil {
+		object["isbn_13"], err = json.Marshal(a.Isbn13)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'isbn_13': %w", err)
+		}
+	}
+
+	object["key"], err = json.Marshal(a.Key)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'key': %w", err)
+	}
+
+	if a.NumberOfPages != nil {
+		object["number_of_pages"], err = json.Marshal(a.NumberOfPages)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'number_of_pages': %w", err)
+		}
+	}
+
+	if a.PhysicalFormat != nil {
+		object["physical_format"], err = json.Marshal(a.PhysicalFormat)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'physical_format': %w", err)
+		}
+	}
+
+	if a.PublishDate != nil {
+		object["publish_date"], err = json.Marshal(a.PublishDate)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'publish_date': %w", err)
+		}
+	}
+
+	if a.Publishers != nil {
+		object["publishers"], err = json.Marshal(a.Publishers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'publishers': %w", err)
+		}
+	}
+
+	if a.Subtitle != nil {
+		object["subtitle"], err = json.Marshal(a.Subtitle)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'subtitle': %w", err)
+		}
+	}
+
+	object["title"], err = json.Marshal(a.Title)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'title': %w", err)
+	}
+
+	if a.Works != nil {
+		object["works"], err = json.Marshal(a.Works)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'works': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for Reference. Returns the specified
+// element and whether it was found
+func (a Reference) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Reference
+func (a *Reference) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Reference to handle AdditionalProperties
+func (a *Reference) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["key"]; found {
+		err = json.Unmarshal(raw, &a.Key)
+		if err != nil {
+			return fmt.Errorf("error reading 'key': %w", err)
+		}
+		delete(object, "key")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Reference to handle AdditionalProperties
+func (a Reference) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["key"], err = json.Marshal(a.Key)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'key': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for SearchDocument. Returns the specified
+// element and whether it was found
+func (a SearchDocument) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for SearchDocument
+func (a *SearchDocument) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for SearchDocument to handle AdditionalProperties
+func (a *SearchDocument) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["author_key"]; found {
+		err = json.Unmarshal(raw, &a.AuthorKey)
+		if err != nil {
+			return fmt.Errorf("error reading 'author_key': %w", err)
+		}
+		delete(object, "author_key")
+	}
+
+	if raw, found := object["author_name"]; found {
+		err = json.Unmarshal(raw, &a.AuthorName)
+		if err != nil {
+			return fmt.Errorf("error reading 'author_name': %w", err)
+		}
+		delete(object, "author_name")
+	}
+
+	if raw, found := object["cover_edition_key"]; found {
+		err = json.Unmarshal(raw, &a.CoverEditionKey)
+		if err != nil {
+			return fmt.Errorf("error reading 'cover_edition_key': %w", err)
+		}
+		delete(object, "cover_edition_key")
+	}
+
+	if raw, found := object["isbn"]; found {
+		err = json.Unmarshal(raw, &a.Isbn)
+		if err != nil {
+			return fmt.Errorf("error reading 'isbn': %w", err)
+		}
+		delete(object, "isbn")
+	}
+
+	if raw, found := object["key"]; found {
+		err = json.Unmarshal(raw, &a.Key)
+		if err != nil {
+			return fmt.Errorf("error reading 'key': %w", err)
+		}
+		delete(object, "key")
+	}
+
+	if raw, found := object["number_of_pages_median"]; found {
+		err = json.Unmarshal(raw, &a.NumberOfPagesMedian)
+		if err != nil {
+			return fmt.Errorf("error reading 'number_of_pages_median': %w", err)
+		}
+		delete(object, "number_of_pages_median")
+	}
+
+	if raw, found := object["publish_year"]; found {
+		err = json.Unmarshal(raw, &a.PublishYear)
+		if err != nil {
+			return fmt.Errorf("error reading 'publish_year': %w", err)
+		}
+		delete(object, "publish_year")
+	}
+
+	if raw, found := object["ratings_average"]; found {
+		err = json.Unmarshal(raw, &a.RatingsAverage)
+		if err != nil {
+			return fmt.Errorf("error reading 'ratings_average': %w", err)
+		}
+		delete(object, "ratings_average")
+	}
+
+	if raw, found := object["ratings_count"]; found {
+		err = json.Unmarshal(raw, &a.RatingsCount)
+		if err != nil {
+			return fmt.Errorf("error reading 'ratings_count': %w", err)
+		}
+		delete(object, "ratings_count")
+	}
+
+	if raw, found := object["title"]; found {
+		err = json.Unmarshal(raw, &a.Title)
+		if err != nil {
+			return fmt.Errorf("error reading 'title': %w", err)
+		}
+		delete(object, "title")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for SearchDocument to handle AdditionalProperties
+func (a SearchDocument) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.AuthorKey != nil {
+		object["author_key"], err = json.Marshal(a.AuthorKey)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'author_key': %w", err)
+		}
+	}
+
+	if a.AuthorName != nil {
+		object["author_name"], err = json.Marshal(a.AuthorName)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'author_name': %w", err)
+		}
+	}
+
+	object["cover_edition_key"], err = json.Marshal(a.CoverEditionKey)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'cover_edition_key': %w", err)
+	}
+
+	if a.Isbn != nil {
+		object["isbn"], err = json.Marshal(a.Isbn)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'isbn': %w", err)
+		}
+	}
+
+	object["key"], err = json.Marshal(a.Key)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'key': %w", err)
+	}
+
+	if a.NumberOfPagesMedian != nil {
+		object["number_of_pages_median"], err = json.Marshal(a.NumberOfPagesMedian)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'number_of_pages_median': %w", err)
+		}
+	}
+
+	if a.PublishYear != nil {
+		object["publish_year"], err = json.Marshal(a.PublishYear)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'publish_year': %w", err)
+		}
+	}
+
+	object["ratings_average"], err = json.Marshal(a.RatingsAverage)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'ratings_average': %w", err)
+	}
+
+	object["ratings_count"], err = json.Marshal(a.RatingsCount)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'ratings_count': %w", err)
+	}
+
+	object["title"], err = json.Marshal(a.Title)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'title': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for Work. Returns the specified
+// element and whether it was found
+func (a Work) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for Work
+func (a *Work) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for Work to handle AdditionalProperties
+func (a *Work) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["authors"]; found {
+		err = json.Unmarshal(raw, &a.Authors)
+		if err != nil {
+			return fmt.Errorf("error reading 'authors': %w", err)
+		}
+		delete(object, "authors")
+	}
+
+	if raw, found := object["covers"]; found {
+		err = json.Unmarshal(raw, &a.Covers)
+		if err != nil {
+			return fmt.Errorf("error reading 'covers': %w", err)
+		}
+		delete(object, "covers")
+	}
+
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
+		if err != nil {
+			return fmt.Errorf("error reading 'description': %w", err)
+		}
+		delete(object, "description")
+	}
+
+	if raw, found := object["first_publish_date"]; found {
+		err = json.Unmarshal(raw, &a.FirstPublishDate)
+		if err != nil {
+			return fmt.Errorf("error reading 'first_publish_date': %w", err)
+		}
+		delete(object, "first_publish_date")
+	}
+
+	if raw, found := object["key"]; found {
+		err = json.Unmarshal(raw, &a.Key)
+		if err != nil {
+			return fmt.Errorf("error reading 'key': %w", err)
+		}
+		delete(object, "key")
+	}
+
+	if raw, found := object["subjects"]; found {
+		err = json.Unmarshal(raw, &a.Subjects)
+		if err != nil {
+			return fmt.Errorf("error reading 'subjects': %w", err)
+		}
+		delete(object, "subjects")
+	}
+
+	if raw, found := object["subtitle"]; found {
+		err = json.Unmarshal(raw, &a.Subtitle)
+		if err != nil {
+			return fmt.Errorf("error reading 'subtitle': %w", err)
+		}
+		delete(object, "subtitle")
+	}
+
+	if raw, found := object["title"]; found {
+		err = json.Unmarshal(raw, &a.Title)
+		if err != nil {
+			return fmt.Errorf("error reading 'title': %w", err)
+		}
+		delete(object, "title")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for Work to handle AdditionalProperties
+func (a Work) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Authors != nil {
+		object["authors"], err = json.Marshal(a.Authors)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'authors': %w", err)
+		}
+	}
+
+	if a.Covers != nil {
+		object["covers"], err = json.Marshal(a.Covers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'covers': %w", err)
+		}
+	}
+
+	if a.Description != nil {
+		object["description"], err = json.Marshal(a.Description)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+		}
+	}
+
+	if a.FirstPublishDate != nil {
+		object["first_publish_date"], err = json.Marshal(a.FirstPublishDate)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'first_publish_date': %w", err)
+		}
+	}
+
+	object["key"], err = json.Marshal(a.Key)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'key': %w", err)
+	}
+
+	if a.Subjects != nil {
+		object["subjects"], err = json.Marshal(a.Subjects)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'subjects': %w", err)
+		}
+	}
+
+	if a.Subtitle != nil {
+		object["subtitle"], err = json.Marshal(a.Subtitle)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'subtitle': %w", err)
+		}
+	}
+
+	object["title"], err = json.Marshal(a.Title)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'title': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for WorkAuthor. Returns the specified
+// element and whether it was found
+func (a WorkAuthor) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for WorkAuthor
+func (a *WorkAuthor) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for WorkAuthor to handle AdditionalProperties
+func (a *WorkAuthor) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["author"]; found {
+		err = json.Unmarshal(raw, &a.Author)
+		if err != nil {
+			return fmt.Errorf("error reading 'author': %w", err)
+		}
+		delete(object, "author")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for WorkAuthor to handle AdditionalProperties
+func (a WorkAuthor) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["author"], err = json.Marshal(a.Author)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'author': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for WorkEditions. Returns the specified
+// element and whether it was found
+func (a WorkEditions) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for WorkEditions
+func (a *WorkEditions) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for WorkEditions to handle AdditionalProperties
+func (a *WorkEditions) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["entries"]; found {
+		err = json.Unmarshal(raw, &a.Entries)
+		if err != nil {
+			return fmt.Errorf("error reading 'entries': %w", err)
+		}
+		delete(object, "entries")
+	}
+
+	if raw, found := object["size"]; found {
+		err = json.Unmarshal(raw, &a.Size)
+		if err != nil {
+			return fmt.Errorf("error reading 'size': %w", err)
+		}
+		delete(object, "size")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for WorkEditions to handle AdditionalProperties
+func (a WorkEditions) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Entries != nil {
+		object["entries"], err = json.Marshal(a.Entries)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'entries': %w", err)
+		}
+	}
+
+	object["size"], err = json.Marshal(a.Size)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'size': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// AsPlainText returns the union data inside the Text as a PlainText
+func (t Text) AsPlainText() (PlainText, error) {
+	var body PlainText
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromPlainText overwrites any union data inside the Text as the provided PlainText
+func (t *Text) FromPlainText(v PlainText) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergePlainText performs a merge with any union data inside the Text, using the provided PlainText
+func (t *Text) MergePlainText(v PlainText) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsTypedText returns the union data inside the Text as a TypedText
+func (t Text) AsTypedText() (TypedText, error) {
+	var body TypedText
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTypedText overwrites any union data inside the Text as the provided TypedText
+func (t *Text) FromTypedText(v TypedText) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTypedText performs a merge with any union data inside the Text, using the provided TypedText
+func (t *Text) MergeTypedText(v TypedText) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t Text) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *Text) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetAuthor request
+	GetAuthor(ctx context.Context, key string, params *GetAuthorParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuthorWorks request
+	GetAuthorWorks(ctx context.Context, key string, params *GetAuthorWorksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEdition request
+	GetEdition(ctx context.Context, olid string, params *GetEditionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEditionByISBN request
+	GetEditionByISBN(ctx context.Context, isbn string, params *GetEditionByISBNParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchBooks request
+	SearchBooks(ctx context.Context, params *SearchBooksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWork request
+	GetWork(ctx context.Context, id string, params *GetWorkParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWorkEditions request
+	GetWorkEditions(ctx context.Context, id string, params *GetWorkEditionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetAuthor(ctx context.Context, key string, params *GetAuthorParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthorRequest(c.Server, key, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAuthorWorks(ctx context.Context, key string, params *GetAuthorWorksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthorWorksRequest(c.Server, key, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEdition(ctx context.Context, olid string, params *GetEditionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEditionRequest(c.Server, olid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEditionByISBN(ctx context.Context, isbn string, params *GetEditionByISBNParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEditionByISBNRequest(c.Server, isbn, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchBooks(ctx context.Context, params *SearchBooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchBooksRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWork(ctx context.Context, id string, params *GetWorkParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWorkEditions(ctx context.Context, id string, params *GetWorkEditionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkEditionsRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAuthorRequest generates requests for GetAuthor
+func NewGetAuthorRequest(server string, key string, params *GetAuthorParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/authors/%s.json", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, params.UserAgent)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("User-Agent", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetAuthorWorksRequest generates requests for GetAuthorWorks
+func NewGetAuthorWorksRequest(server string, key string, params *GetAuthorWorksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "key", runtime.ParamLocationPath, key)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/authors/%s/works.json", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, params.UserAgent)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("User-Agent", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetEditionRequest generates requests for GetEdition
+func NewGetEditionRequest(server string, olid string, params *GetEditionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "olid", runtime.ParamLocationPath, olid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/books/%s.json", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, params.UserAgent)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("User-Agent", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetEditionByISBNRequest generates requests for GetEditionByISBN
+func NewGetEditionByISBNRequest(server string, isbn string, params *GetEditionByISBNParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "isbn", runtime.ParamLocationPath, isbn)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/isbn/%s.json", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, params.UserAgent)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("User-Agent", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewSearchBooksRequest generates requests for SearchBooks
+func NewSearchBooksRequest(server string, params *SearchBooksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/search.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Title != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "title", runtime.ParamLocationQuery, *params.Title); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Author != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "author", runtime.ParamLocationQuery, *params.Author); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Subject != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Lang != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lang", runtime.ParamLocationQuery, *params.Lang); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, params.UserAgent)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("User-Agent", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetWorkRequest generates requests for GetWork
+func NewGetWorkRequest(server string, id string, params *GetWorkParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+
+	operationPath := fmt.Sprintf("/works/%s.json", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
 		return nil, err
 	}
-	return c.Client.Do(req)
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, params.UserAgent)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("User-Agent", headerParam0)
+
+	}
+
+	return req, nil
 }
 
-// NewSearchBooksRequest generates requests for SearchBooks
-func NewSearchBooksRequest(server string, params *SearchBooksParams) (*http.Request, error) {
+// NewGetWorkEditionsRequest generates requests for GetWorkEditions
+func NewGetWorkEditionsRequest(server string, id string, params *GetWorkEditionsParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/search.json")
+	operationPath := fmt.Sprintf("/works/%s/editions.json", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -363,21 +1985,9 @@ func NewSearchBooksRequest(server string, params *SearchBooksParams) (*http.Requ
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		if params.Title != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "title", runtime.ParamLocationQuery, *params.Title); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -391,9 +2001,9 @@ func NewSearchBooksRequest(server string, params *SearchBooksParams) (*http.Requ
 
 		}
 
-		if params.Author != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "author", runtime.ParamLocationQuery, *params.Author); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -407,204 +2017,484 @@ func NewSearchBooksRequest(server string, params *SearchBooksParams) (*http.Requ
 
 		}
 
-		if params.Subject != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "subject", runtime.ParamLocationQuery, *params.Subject); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, params.UserAgent)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("User-Agent", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
 
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
 		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAuthorWithResponse request
+	GetAuthorWithResponse(ctx context.Context, key string, params *GetAuthorParams, reqEditors ...RequestEditorFn) (*GetAuthorResponse, error)
+
+	// GetAuthorWorksWithResponse request
+	GetAuthorWorksWithResponse(ctx context.Context, key string, params *GetAuthorWorksParams, reqEditors ...RequestEditorFn) (*GetAuthorWorksResponse, error)
+
+	// GetEditionWithResponse request
+	GetEditionWithResponse(ctx context.Context, olid string, params *GetEditionParams, reqEditors ...RequestEditorFn) (*GetEditionResponse, error)
+
+	// GetEditionByISBNWithResponse request
+	GetEditionByISBNWithResponse(ctx context.Context, isbn string, params *GetEditionByISBNParams, reqEditors ...RequestEditorFn) (*GetEditionByISBNResponse, error)
+
+	// SearchBooksWithResponse request
+	SearchBooksWithResponse(ctx context.Context, params *SearchBooksParams, reqEditors ...RequestEditorFn) (*SearchBooksResponse, error)
+
+	// GetWorkWithResponse request
+	GetWorkWithResponse(ctx context.Context, id string, params *GetWorkParams, reqEditors ...RequestEditorFn) (*GetWorkResponse, error)
+
+	// GetWorkEditionsWithResponse request
+	GetWorkEditionsWithResponse(ctx context.Context, id string, params *GetWorkEditionsParams, reqEditors ...RequestEditorFn) (*GetWorkEditionsResponse, error)
+}
+
+type GetAuthorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Author
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthorResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthorResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthorWorksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthorWorks
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthorWorksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthorWorksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEditionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Edition
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEditionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEditionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEditionByISBNResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Edition
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEditionByISBNResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEditionByISBNResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchBooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchBooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchBooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Work
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkEditionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkEditions
+}
 
-		if params.Page != nil {
+// Status returns HTTPResponse.Status
+func (r GetWorkEditionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkEditionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+// GetAuthorWithResponse request returning *GetAuthorResponse
+func (c *ClientWithResponses) GetAuthorWithResponse(ctx context.Context, key string, params *GetAuthorParams, reqEditors ...RequestEditorFn) (*GetAuthorResponse, error) {
+	rsp, err := c.GetAuthor(ctx, key, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthorResponse(rsp)
+}
 
-		if params.Limit != nil {
+// GetAuthorWorksWithResponse request returning *GetAuthorWorksResponse
+func (c *ClientWithResponses) GetAuthorWorksWithResponse(ctx context.Context, key string, params *GetAuthorWorksParams, reqEditors ...RequestEditorFn) (*GetAuthorWorksResponse, error) {
+	rsp, err := c.GetAuthorWorks(ctx, key, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthorWorksResponse(rsp)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// GetEditionWithResponse request returning *GetEditionResponse
+func (c *ClientWithResponses) GetEditionWithResponse(ctx context.Context, olid string, params *GetEditionParams, reqEditors ...RequestEditorFn) (*GetEditionResponse, error) {
+	rsp, err := c.GetEdition(ctx, olid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEditionResponse(rsp)
+}
 
-		}
+// GetEditionByISBNWithResponse request returning *GetEditionByISBNResponse
+func (c *ClientWithResponses) GetEditionByISBNWithResponse(ctx context.Context, isbn string, params *GetEditionByISBNParams, reqEditors ...RequestEditorFn) (*GetEditionByISBNResponse, error) {
+	rsp, err := c.GetEditionByISBN(ctx, isbn, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEditionByISBNResponse(rsp)
+}
 
-		if params.Fields != nil {
+// SearchBooksWithResponse request returning *SearchBooksResponse
+func (c *ClientWithResponses) SearchBooksWithResponse(ctx context.Context, params *SearchBooksParams, reqEditors ...RequestEditorFn) (*SearchBooksResponse, error) {
+	rsp, err := c.SearchBooks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchBooksResponse(rsp)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// GetWorkWithResponse request returning *GetWorkResponse
+func (c *ClientWithResponses) GetWorkWithResponse(ctx context.Context, id string, params *GetWorkParams, reqEditors ...RequestEditorFn) (*GetWorkResponse, error) {
+	rsp, err := c.GetWork(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkResponse(rsp)
+}
 
-		}
+// GetWorkEditionsWithResponse request returning *GetWorkEditionsResponse
+func (c *ClientWithResponses) GetWorkEditionsWithResponse(ctx context.Context, id string, params *GetWorkEditionsParams, reqEditors ...RequestEditorFn) (*GetWorkEditionsResponse, error) {
+	rsp, err := c.GetWorkEditions(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkEditionsResponse(rsp)
+}
 
-		if params.Lang != nil {
+// ParseGetAuthorResponse parses an HTTP response from a GetAuthorWithResponse call
+func ParseGetAuthorResponse(rsp *http.Response) (*GetAuthorResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lang", runtime.ParamLocationQuery, *params.Lang); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	response := &GetAuthorResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Author
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
 
-		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	return response, nil
+}
+
+// ParseGetAuthorWorksResponse parses an HTTP response from a GetAuthorWorksWithResponse call
+func ParseGetAuthorWorksResponse(rsp *http.Response) (*GetAuthorWorksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-
-		var headerParam0 string
+	response := &GetAuthorWorksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "User-Agent", runtime.ParamLocationHeader, params.UserAgent)
-		if err != nil {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthorWorks
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-
-		req.Header.Set("User-Agent", headerParam0)
+		response.JSON200 = &dest
 
 	}
 
-	return req, nil
+	return response, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// ParseGetEditionResponse parses an HTTP response from a GetEditionWithResponse call
+func ParseGetEditionResponse(rsp *http.Response) (*GetEditionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+	response := &GetEditionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Edition
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return response, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// ParseGetEditionByISBNResponse parses an HTTP response from a GetEditionByISBNWithResponse call
+func ParseGetEditionByISBNResponse(rsp *http.Response) (*GetEditionByISBNResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
+	response := &GetEditionByISBNResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Edition
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
-		c.Server = newBaseURL.String()
-		return nil
+		response.JSON200 = &dest
+
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// SearchBooksWithResponse request
-	SearchBooksWithResponse(ctx context.Context, params *SearchBooksParams, reqEditors ...RequestEditorFn) (*SearchBooksResponse, error)
+	return response, nil
 }
 
-type SearchBooksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *SearchResponse
-}
+// ParseSearchBooksResponse parses an HTTP response from a SearchBooksWithResponse call
+func ParseSearchBooksResponse(rsp *http.Response) (*SearchBooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r SearchBooksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &SearchBooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r SearchBooksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
+
+	return response, nil
 }
 
-// SearchBooksWithResponse request returning *SearchBooksResponse
-func (c *ClientWithResponses) SearchBooksWithResponse(ctx context.Context, params *SearchBooksParams, reqEditors ...RequestEditorFn) (*SearchBooksResponse, error) {
-	rsp, err := c.SearchBooks(ctx, params, reqEditors...)
+// ParseGetWorkResponse parses an HTTP response from a GetWorkWithResponse call
+func ParseGetWorkResponse(rsp *http.Response) (*GetWorkResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseSearchBooksResponse(rsp)
+
+	response := &GetWorkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Work
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseSearchBooksResponse parses an HTTP response from a SearchBooksWithResponse call
-func ParseSearchBooksResponse(rsp *http.Response) (*SearchBooksResponse, error) {
+// ParseGetWorkEditionsResponse parses an HTTP response from a GetWorkEditionsWithResponse call
+func ParseGetWorkEditionsResponse(rsp *http.Response) (*GetWorkEditionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &SearchBooksResponse{
+	response := &GetWorkEditionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest SearchResponse
+		var dest WorkEditions
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1,9 +1,9 @@
 package openlibrary
 
-import "errors"
+import "github.com/celestialdragonfly/betterreads/internal/provider"
 
 var (
-	ErrInternalServer = errors.New("internal server error")
-	ErrNotFound       = errors.New("not found")
-	ErrBadRequest     = errors.New("bad request")
+	ErrInternalServer = provider.ErrInternalServer
+	ErrNotFound       = provider.ErrNotFound
+	ErrBadRequest     = provider.ErrBadRequest
 )
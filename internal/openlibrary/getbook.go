@@ -0,0 +1,266 @@
+package openlibrary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+
+	library "github.com/celestialdragonfly/betterreads/internal/openlibrary/contracts"
+)
+
+// editionLimit is the number of a work's editions listed with a book.
+const editionLimit = 20
+
+var (
+	workID    = regexp.MustCompile(`^OL[0-9]+W$`)
+	editionID = regexp.MustCompile(`^OL[0-9]+M$`)
+	isbnID    = regexp.MustCompile(`^(?:[0-9]{9}[0-9X]|[0-9]{13})$`)
+)
+
+// Edition is one published form of a book.
+type Edition struct {
+	Key            string
+	Title          string
+	Subtitle       string
+	Publishers     []string
+	PublishDate    string
+	PageCount      int
+	ISBN           string
+	CoverImage     string
+	PhysicalFormat string
+}
+
+// BookDetails describes a book: the edition asked for, the work it is an
+// edition of and the work's other editions. When a work is asked for, Edition
+// holds only the work's key, title, subtitle and cover.
+type BookDetails struct {
+	Edition
+	WorkKey      string
+	AuthorKeys   []string
+	Description  string
+	Subjects     []string
+	Editions     []Edition
+	EditionCount int
+}
+
+// GetBook looks up a book by an Open Library edition id (OL7353617M), work id
+// (OL45804W) or ISBN, which may carry an "isbn:" prefix and hyphens. It
+// returns ErrBadRequest for any other id and ErrNotFound if Open Library does
+// not have the book.
+func (c *Client) GetBook(ctx context.Context, id string) (*BookDetails, error) {
+	var (
+		details BookDetails
+		work    *library.Work
+		err     error
+	)
+	switch isbn := strings.ReplaceAll(strings.TrimPrefix(id, "isbn:"), "-", ""); {
+	case workID.MatchString(id):
+		work, err = c.getWork(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		details.Key = id
+		details.Title = work.Title
+		details.Subtitle = deref(work.Subtitle)
+		details.CoverImage = coverImage(deref(work.Covers))
+	case editionID.MatchString(id), isbnID.MatchString(isbn):
+		edition, err := c.getEdition(ctx, id, isbn)
+		if err != nil {
+			return nil, err
+		}
+		details = editionDetails(edition)
+		works := deref(edition.Works)
+		if len(works) == 0 {
+			return &details, nil
+		}
+		work, err = c.getWork(ctx, keyID(works[0].Key))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("GetBook: %w, %q is not an Open Library id or ISBN", ErrBadRequest, id)
+	}
+
+	details.WorkKey = keyID(work.Key)
+	if description := text(work.Description); description != "" {
+		details.Description = description
+	}
+	details.Subjects = deref(work.Subjects)
+	if details.CoverImage == "" {
+		details.CoverImage = coverImage(deref(work.Covers))
+	}
+	if authors := deref(work.Authors); len(authors) > 0 {
+		details.AuthorKeys = details.AuthorKeys[:0]
+		for _, author := range authors {
+			details.AuthorKeys = append(details.AuthorKeys, keyID(author.Author.Key))
+		}
+	}
+
+	editions, err := c.getWorkEditions(ctx, details.WorkKey)
+	if err != nil {
+		return nil, err
+	}
+	details.EditionCount = editions.Size
+	for _, edition := range editions.Entries {
+		details.Editions = append(details.Editions, toEdition(&edition))
+	}
+	return &details, nil
+}
+
+// GetEditionByISBN looks up the edition with an ISBN, which may carry an
+// "isbn:" prefix and hyphens, in a single request. Unlike GetBook it does not
+// read the edition's work, so of the work only WorkKey is set. It returns
+// ErrBadRequest for anything but an ISBN and ErrNotFound if Open Library does
+// not have the edition.
+func (c *Client) GetEditionByISBN(ctx context.Context, isbn string) (*BookDetails, error) {
+	isbn = strings.ReplaceAll(strings.TrimPrefix(isbn, "isbn:"), "-", "")
+	if !isbnID.MatchString(isbn) {
+		return nil, fmt.Errorf("GetEditionByISBN: %w, %q is not an ISBN", ErrBadRequest, isbn)
+	}
+	edition, err := c.getEdition(ctx, "", isbn)
+	if err != nil {
+		return nil, err
+	}
+	details := editionDetails(edition)
+	if works := deref(edition.Works); len(works) > 0 {
+		details.WorkKey = keyID(works[0].Key)
+	}
+	return &details, nil
+}
+
+// editionDetails returns what an edition says about a book by itself.
+func editionDetails(edition *library.Edition) BookDetails {
+	details := BookDetails{
+		Edition:     toEdition(edition),
+		Description: text(edition.Description),
+	}
+	for _, author := range deref(edition.Authors) {
+		details.AuthorKeys = append(details.AuthorKeys, keyID(author.Key))
+	}
+	return details
+}
+
+// getEdition fetches an edition by its id or, when id is not one, by isbn.
+func (c *Client) getEdition(ctx context.Context, id, isbn string) (*library.Edition, error) {
+	if editionID.MatchString(id) {
+		resp, err := c.Client.GetEdition(ctx, id, &library.GetEditionParams{UserAgent: userAgent})
+		if err != nil {
+			return nil, fmt.Errorf("GetEdition: %w - request error: %w", ErrInternalServer, err)
+		}
+		return parseResponse("GetEdition", resp, library.ParseGetEditionResponse,
+			func(r *library.GetEditionResponse) *library.Edition { return r.JSON200 })
+	}
+	resp, err := c.Client.GetEditionByISBN(ctx, isbn, &library.GetEditionByISBNParams{UserAgent: userAgent})
+	if err != nil {
+		return nil, fmt.Errorf("GetEditionByISBN: %w - request error: %w", ErrInternalServer, err)
+	}
+	return parseResponse("GetEditionByISBN", resp, library.ParseGetEditionByISBNResponse,
+		func(r *library.GetEditionByISBNResponse) *library.Edition { return r.JSON200 })
+}
+
+func (c *Client) getWork(ctx context.Context, id string) (*library.Work, error) {
+	resp, err := c.Client.GetWork(ctx, id, &library.GetWorkParams{UserAgent: userAgent})
+	if err != nil {
+		return nil, fmt.Errorf("GetWork: %w - request error: %w", ErrInternalServer, err)
+	}
+	return parseResponse("GetWork", resp, library.ParseGetWorkResponse,
+		func(r *library.GetWorkResponse) *library.Work { return r.JSON200 })
+}
+
+func (c *Client) getWorkEditions(ctx context.Context, id string) (*library.WorkEditions, error) {
+	limit := editionLimit
+	resp, err := c.Client.GetWorkEditions(ctx, id, &library.GetWorkEditionsParams{UserAgent: userAgent, Limit: &limit})
+	if err != nil {
+		return nil, fmt.Errorf("GetWorkEditions: %w - request error: %w", ErrInternalServer, err)
+	}
+	return parseResponse("GetWorkEditions", resp, library.ParseGetWorkEditionsResponse,
+		func(r *library.GetWorkEditionsResponse) *library.WorkEditions { return r.JSON200 })
+}
+
+// parseResponse parses resp with parse, which closes its body, and returns
+// the JSON body picked out by body. A 404 is ErrNotFound.
+func parseResponse[R, T any](op string, resp *http.Response, parse func(*http.Response) (*R, error), body func(*R) *T) (*T, error) {
+	parsed, err := parse(resp)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w - failed to parse response: %w", op, ErrInternalServer, err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("%s: %w", op, ErrNotFound)
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		return nil, fmt.Errorf("%s: %w, library returned status %d", op, ErrInternalServer, resp.StatusCode)
+	}
+	if result := body(parsed); result != nil {
+		return result, nil
+	}
+	return nil, fmt.Errorf("%s: %w - response is not JSON", op, ErrInternalServer)
+}
+
+func toEdition(e *library.Edition) Edition {
+	isbn := getFirstValue(deref(e.Isbn13))
+	if isbn == "" {
+		isbn = getFirstValue(deref(e.Isbn10))
+	}
+	return Edition{
+		Key:            keyID(e.Key),
+		Title:          e.Title,
+		Subtitle:       deref(e.Subtitle),
+		Publishers:     deref(e.Publishers),
+		PublishDate:    deref(e.PublishDate),
+		PageCount:      deref(e.NumberOfPages),
+		ISBN:           isbn,
+		CoverImage:     coverImage(deref(e.Covers)),
+		PhysicalFormat: deref(e.PhysicalFormat),
+	}
+}
+
+// coverImage returns the large cover image for the first of covers.
+func coverImage(covers []int) string {
+	return imageURL("b", covers)
+}
+
+// imageURL returns the large image of the given kind, "b" for book covers and
+// "a" for author photos, for the first of ids. Open Library marks removed
+// images with -1.
+func imageURL(kind string, ids []int) string {
+	for _, id := range ids {
+		if id > 0 {
+			return fmt.Sprintf("https://covers.openlibrary.org/%s/id/%d-L.jpg", kind, id)
+		}
+	}
+	return ""
+}
+
+// text returns free text given either as a string or as a typed value.
+func text(t *library.Text) string {
+	if t == nil {
+		return ""
+	}
+	if s, err := t.AsPlainText(); err == nil {
+		return s
+	}
+	if typed, err := t.AsTypedText(); err == nil {
+		return typed.Value
+	}
+	return ""
+}
+
+// keyID returns the id at the end of an Open Library key such as
+// "/works/OL45804W".
+func keyID(key string) string {
+	if key == "" {
+		return ""
+	}
+	return path.Base(key)
+}
+
+func deref[V any](v *V) V {
+	if v == nil {
+		var zero V
+		return zero
+	}
+	return *v
+}
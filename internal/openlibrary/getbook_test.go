@@ -0,0 +1,235 @@
+package openlibrary
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	library "github.com/celestialdragonfly/betterreads/internal/openlibrary/contracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	duneEdition = `{
+		"key": "/books/OL7353617M",
+		"title": "Dune",
+		"publishers": ["Chilton Books"],
+		"publish_date": "1965",
+		"number_of_pages": 412,
+		"isbn_10": ["0801950775"],
+		"isbn_13": ["9780801950773"],
+		"covers": [-1, 8231856],
+		"works": [{"key": "/works/OL893415W"}],
+		"authors": [{"key": "/authors/OL79034A"}],
+		"physical_format": "Hardcover",
+		"description": "The edition's blurb."
+	}`
+	duneWork = `{
+		"key": "/works/OL893415W",
+		"title": "Dune",
+		"description": {"type": "/type/text", "value": "A desert planet."},
+		"subjects": ["Science fiction", "Arrakis"],
+		"covers": [11481354],
+		"authors": [{"author": {"key": "/authors/OL79034A"}, "type": {"key": "/type/author_role"}}]
+	}`
+	duneEditions = `{
+		"size": 120,
+		"entries": [
+			{"key": "/books/OL7353617M", "title": "Dune", "number_of_pages": 412},
+			{"key": "/books/OL26242482M", "title": "Dune", "isbn_10": ["0441013597"], "physical_format": "Paperback"}
+		]
+	}`
+)
+
+// routes answers requests by URL path with a JSON body, and anything else
+// with a 404.
+func routes(t *testing.T, bodies map[string]string) *MockHTTPClient {
+	t.Helper()
+	return &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			assert.NotEmpty(t, req.Header.Get("User-Agent"))
+			body, ok := bodies[req.URL.Path]
+			if !ok {
+				return &http.Response{
+					StatusCode: http.StatusNotFound,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"error": "notfound"}`)),
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(body)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		},
+	}
+}
+
+func newTestClient(t *testing.T, doer library.HttpRequestDoer) *Client {
+	t.Helper()
+	libraryClient, err := library.NewClient("https://openlibrary.org", library.WithHTTPClient(doer))
+	require.NoError(t, err)
+	return &Client{Client: libraryClient}
+}
+
+func TestGetBook(t *testing.T) {
+	t.Parallel()
+
+	found := map[string]string{
+		"/books/OL7353617M.json":         duneEdition,
+		"/isbn/9780801950773.json":       duneEdition,
+		"/works/OL893415W.json":          duneWork,
+		"/works/OL893415W/editions.json": duneEditions,
+	}
+
+	t.Run("by edition", func(t *testing.T) {
+		t.Parallel()
+		book, err := newTestClient(t, routes(t, found)).GetBook(context.Background(), "OL7353617M")
+		require.NoError(t, err)
+
+		assert.Equal(t, "OL7353617M", book.Key)
+		assert.Equal(t, "Dune", book.Title)
+		assert.Equal(t, []string{"Chilton Books"}, book.Publishers)
+		assert.Equal(t, 412, book.PageCount)
+		assert.Equal(t, "9780801950773", book.ISBN)
+		assert.Equal(t, "Hardcover", book.PhysicalFormat)
+		// Removed covers are skipped.
+		assert.Equal(t, "https://covers.openlibrary.org/b/id/8231856-L.jpg", book.CoverImage)
+		assert.Equal(t, "OL893415W", book.WorkKey)
+		assert.Equal(t, []string{"OL79034A"}, book.AuthorKeys)
+		// The work's description is preferred to the edition's.
+		assert.Equal(t, "A desert planet.", book.Description)
+		assert.Equal(t, []string{"Science fiction", "Arrakis"}, book.Subjects)
+		assert.Equal(t, 120, book.EditionCount)
+		require.Len(t, book.Editions, 2)
+		assert.Equal(t, "OL26242482M", book.Editions[1].Key)
+		assert.Equal(t, "0441013597", book.Editions[1].ISBN)
+	})
+
+	t.Run("by isbn", func(t *testing.T) {
+		t.Parallel()
+		book, err := newTestClient(t, routes(t, found)).GetBook(context.Background(), "isbn:978-0-8019-5077-3")
+		require.NoError(t, err)
+		assert.Equal(t, "OL7353617M", book.Key)
+		assert.Equal(t, "OL893415W", book.WorkKey)
+	})
+
+	t.Run("by work", func(t *testing.T) {
+		t.Parallel()
+		book, err := newTestClient(t, routes(t, found)).GetBook(context.Background(), "OL893415W")
+		require.NoError(t, err)
+		assert.Equal(t, "OL893415W", book.Key)
+		assert.Equal(t, "Dune", book.Title)
+		assert.Equal(t, "https://covers.openlibrary.org/b/id/11481354-L.jpg", book.CoverImage)
+		assert.Equal(t, "A desert planet.", book.Description)
+		assert.Equal(t, []string{"OL79034A"}, book.AuthorKeys)
+		assert.Empty(t, book.Publishers)
+		assert.Len(t, book.Editions, 2)
+	})
+
+	t.Run("edition without a work", func(t *testing.T) {
+		t.Parallel()
+		book, err := newTestClient(t, routes(t, map[string]string{
+			"/books/OL1M.json": `{"key": "/books/OL1M", "title": "Pamphlet", "description": "Plain text."}`,
+		})).GetBook(context.Background(), "OL1M")
+		require.NoError(t, err)
+		assert.Equal(t, "Pamphlet", book.Title)
+		assert.Equal(t, "Plain text.", book.Description)
+		assert.Empty(t, book.WorkKey)
+		assert.Empty(t, book.Editions)
+	})
+
+	errs := []struct {
+		name    string
+		id      string
+		doer    library.HttpRequestDoer
+		wantErr error
+	}{
+		{
+			name:    "invalid id",
+			id:      "dune",
+			doer:    routes(t, found),
+			wantErr: ErrBadRequest,
+		},
+		{
+			name:    "short isbn",
+			id:      "978080195",
+			doer:    routes(t, found),
+			wantErr: ErrBadRequest,
+		},
+		{
+			name:    "unknown edition",
+			id:      "OL1M",
+			doer:    routes(t, found),
+			wantErr: ErrNotFound,
+		},
+		{
+			name: "missing work",
+			id:   "OL7353617M",
+			doer: routes(t, map[string]string{
+				"/books/OL7353617M.json": duneEdition,
+			}),
+			wantErr: ErrNotFound,
+		},
+		{
+			name: "request error",
+			id:   "OL7353617M",
+			doer: &MockHTTPClient{DoFunc: func(*http.Request) (*http.Response, error) {
+				return nil, errors.New("connection refused")
+			}},
+			wantErr: ErrInternalServer,
+		},
+		{
+			name: "server error",
+			id:   "OL7353617M",
+			doer: &MockHTTPClient{DoFunc: func(*http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: http.StatusServiceUnavailable,
+					Body:       io.NopCloser(bytes.NewBufferString("unavailable")),
+				}, nil
+			}},
+			wantErr: ErrInternalServer,
+		},
+		{
+			name: "malformed response",
+			id:   "OL7353617M",
+			doer: routes(t, map[string]string{
+				"/books/OL7353617M.json": `{"key": `,
+			}),
+			wantErr: ErrInternalServer,
+		},
+	}
+	for _, tt := range errs {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := newTestClient(t, tt.doer).GetBook(context.Background(), tt.id)
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGetEditionByISBN(t *testing.T) {
+	t.Parallel()
+
+	// Only the edition is read, so the work is never asked for.
+	doer := routes(t, map[string]string{"/isbn/9780801950773.json": duneEdition})
+
+	book, err := newTestClient(t, doer).GetEditionByISBN(context.Background(), "978-0-8019-5077-3")
+	require.NoError(t, err)
+	assert.Equal(t, "OL7353617M", book.Key)
+	assert.Equal(t, "Dune", book.Title)
+	assert.Equal(t, 412, book.PageCount)
+	assert.Equal(t, "OL893415W", book.WorkKey)
+	assert.Equal(t, []string{"OL79034A"}, book.AuthorKeys)
+	assert.Equal(t, "The edition's blurb.", book.Description)
+	assert.Empty(t, book.Editions)
+
+	_, err = newTestClient(t, doer).GetEditionByISBN(context.Background(), "9780441013593")
+	require.ErrorIs(t, err, ErrNotFound)
+	_, err = newTestClient(t, doer).GetEditionByISBN(context.Background(), "OL7353617M")
+	require.ErrorIs(t, err, ErrBadRequest)
+}
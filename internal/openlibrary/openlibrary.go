@@ -6,14 +6,28 @@ import (
 	"net/url"
 
 	library "github.com/celestialdragonfly/betterreads/internal/openlibrary/contracts"
+	"github.com/celestialdragonfly/betterreads/internal/provider"
 )
 
+// userAgent identifies BetterReads to Open Library, which asks clients to
+// send a contact address.
+const userAgent = "BetterReads robertjhird@gmail.com"
+
 //go:generate go run -mod=mod github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen@latest --generate=client,types -package=openlibrary -o ./contracts/openlibrary.gen.go ./contracts/openlibrary.yaml
 
 // ClientInterface defines the interface for interacting with the OpenLibrary API.
 type ClientInterface interface {
+	provider.Provider
 	// SearchBooks searches for books in the OpenLibrary based on a query string
 	SearchBooks(ctx context.Context, query string, title, author, subject *string) (*SearchBooksResponse, error)
+	// GetBook returns the details of one book by edition id, work id or ISBN
+	GetBook(ctx context.Context, id string) (*BookDetails, error)
+	// GetEditionByISBN returns the edition with an ISBN and the key of its work
+	GetEditionByISBN(ctx context.Context, isbn string) (*BookDetails, error)
+	// GetAuthor returns an author by author id
+	GetAuthor(ctx context.Context, id string) (*Author, error)
+	// GetAuthorWorks returns one page of an author's works
+	GetAuthorWorks(ctx context.Context, id string, limit, offset int) (*AuthorWorks, error)
 }
 
 type Client struct {
@@ -9,7 +9,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/celestialdragonfly/betterreads/internal/data"
 	library "github.com/celestialdragonfly/betterreads/internal/openlibrary/contracts"
+	"github.com/celestialdragonfly/betterreads/internal/provider"
 )
 
 // MockHTTPClient is a custom HTTP client for testing purposes.
@@ -451,3 +453,59 @@ func TestSearchBooksIntegration(t *testing.T) {
 		t.Errorf("Book source mismatch. Expected: %s, Got: %s", expectedFirstBook.Source, book.Source)
 	}
 }
+
+// TestClient_Search tests the provider.Provider implementation.
+func TestClient_Search(t *testing.T) {
+	t.Parallel()
+
+	mockClient := &MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			// Empty fields are not sent.
+			if req.URL.Query().Has("title") || req.URL.Query().Has("subject") {
+				t.Errorf("Expected no title or subject, got %s", req.URL.RawQuery)
+			}
+			if got := req.URL.Query().Get("author"); got != "Frank Herbert" {
+				t.Errorf("Expected author 'Frank Herbert', got %q", got)
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(bytes.NewBufferString(`{
+					"numFound": 1, "start": 0, "numFoundExact": true, "q": "dune", "offset": null,
+					"documentation_url": "https://openlibrary.org/dev/docs/api/search",
+					"docs": [{
+						"key": "/works/OL893415W", "title": "Dune", "cover_edition_key": "OL26242482M",
+						"author_name": ["Frank Herbert"], "author_key": ["OL79034A"], "isbn": ["0441013597"],
+						"publish_year": [1965], "ratings_average": 4.3, "ratings_count": 900, "number_of_pages_median": 604
+					}]
+				}`)),
+				Header: http.Header{"Content-Type": []string{"application/json"}},
+			}, nil
+		},
+	}
+	libraryClient, err := library.NewClient("https://openlibrary.org", library.WithHTTPClient(mockClient))
+	if err != nil {
+		t.Fatalf("Failed to create library client: %v", err)
+	}
+	client := &Client{Client: libraryClient}
+
+	books, err := client.Search(context.Background(), provider.Query{Query: "dune", Author: "Frank Herbert"})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(books) != 1 {
+		t.Fatalf("Search() returned %d books, expected 1", len(books))
+	}
+	book := books[0]
+	if book.Source != data.BookSourceOpenLibrary || book.ExternalID != "OL26242482M" || book.WorkKey != "OL893415W" {
+		t.Errorf("Search() returned source %d, id %q, work %q", book.Source, book.ExternalID, book.WorkKey)
+	}
+	if book.ISBN != "0441013597" || book.PublishYear != 1965 || book.RatingCount != 900 {
+		t.Errorf("Search() returned isbn %q, year %d, rating count %d", book.ISBN, book.PublishYear, book.RatingCount)
+	}
+	if book.PageCount != 604 {
+		t.Errorf("Search() returned page count %d, expected 604", book.PageCount)
+	}
+	if book.UpdatedAt.IsZero() {
+		t.Error("Search() returned a book without UpdatedAt")
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/celestialdragonfly/betterreads/internal/data"
 	"github.com/celestialdragonfly/betterreads/internal/logger"
 	library "github.com/celestialdragonfly/betterreads/internal/openlibrary/contracts"
+	"github.com/celestialdragonfly/betterreads/internal/provider"
 )
 
 type Book struct {
@@ -16,15 +19,65 @@ type Book struct {
 	CoverImage      string
 	ISBN            string
 	Title           string
+	WorkKey         string
 	RatingAverage   float32
 	RatingCount     int
 	PublishYear     int
+	PageCount       int
 	Source          string
 }
 type SearchBooksResponse struct {
 	Books []Book
 }
 
+// CatalogEntry returns the catalog entry for the search result, read at the
+// given time.
+func (b Book) CatalogEntry(at time.Time) *data.Book {
+	return &data.Book{
+		Source:        data.BookSourceOpenLibrary,
+		ExternalID:    b.CoverEditionKey,
+		WorkKey:       b.WorkKey,
+		Title:         b.Title,
+		AuthorName:    b.AuthorName,
+		AuthorKey:     b.AuthorKey,
+		BookImage:     b.CoverImage,
+		ISBN:          b.ISBN,
+		PublishYear:   int32(b.PublishYear), //nolint:gosec // integer overflow unlikely
+		PageCount:     int32(b.PageCount),   //nolint:gosec // integer overflow unlikely
+		RatingAverage: b.RatingAverage,
+		RatingCount:   int32(b.RatingCount), //nolint:gosec // integer overflow unlikely
+		CreatedAt:     at,
+		UpdatedAt:     at,
+	}
+}
+
+// Source implements provider.Provider.
+func (c *Client) Source() int32 {
+	return data.BookSourceOpenLibrary
+}
+
+// Search implements provider.Provider with SearchBooks.
+func (c *Client) Search(ctx context.Context, q provider.Query) ([]*data.Book, error) {
+	resp, err := c.SearchBooks(ctx, q.Query, optional(q.Title), optional(q.Author), optional(q.Subject))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	books := make([]*data.Book, 0, len(resp.Books))
+	for _, book := range resp.Books {
+		books = append(books, book.CatalogEntry(now))
+	}
+	return books, nil
+}
+
+// optional returns nil for an empty search field, so it is not sent.
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
 // SearchBooks queries the open library API for books matching the given query string.
 // It fetches book details such as author, edition key, ISBN, title, ratings, and publication year.
 // The function returns a SearchBooksResponse containing a list of books or an error if the search fails.
@@ -44,16 +97,18 @@ func (c *Client) SearchBooks(ctx context.Context, query string, title, author, s
 				"author_name",
 				"cover_edition_key", // book id
 				"isbn",
+				"key", // work id
 				"title",
 				"ratings_average",
 				"ratings_count",
 				"publish_year",
+				"number_of_pages_median",
 			}, ",")
 		resultLimit     = 15
 		defaultLanguage = "en"
 	)
 	resp, err := c.Client.SearchBooks(ctx, &library.SearchBooksParams{
-		UserAgent: "BetterReads robertjhird@gmail.com",
+		UserAgent: userAgent,
 		Q:         query,
 		Title:     title,
 		Author:    author,
@@ -96,9 +151,11 @@ func (c *Client) SearchBooks(ctx context.Context, query string, title, author, s
 			CoverImage:      fmt.Sprintf("https://covers.openlibrary.org/b/olid/%s-L.jpg", olid),
 			ISBN:            getFirstValue(book.Isbn),
 			Title:           book.Title,
+			WorkKey:         keyID(book.Key),
 			RatingAverage:   book.RatingsAverage,
 			RatingCount:     book.RatingsCount,
 			PublishYear:     getFirstValue(book.PublishYear),
+			PageCount:       deref(book.NumberOfPagesMedian),
 			Source:          "open_library",
 		})
 	}
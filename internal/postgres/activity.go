@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// libraryBookState is the part of a library book that activity posts report on.
+type libraryBookState struct {
+	ReadingStatus int32
+	Rating        int32
+}
+
+// lockLibraryBookState locks the user's library entry for the rest of the
+// transaction and returns its current state, or nil if the book is not in the
+// library yet.
+func lockLibraryBookState(ctx context.Context, tx pgx.Tx, userID, bookID string) (*libraryBookState, error) {
+	query := `
+		SELECT reading_status, COALESCE(rating, 0)
+		FROM library_books
+		WHERE user_id = $1 AND book_id = $2
+		FOR UPDATE
+	`
+	var state libraryBookState
+	err := tx.QueryRow(ctx, query, userID, bookID).Scan(&state.ReadingStatus, &state.Rating)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil //nolint: nilnil // a missing entry is not an error
+		}
+		return nil, fmt.Errorf("lock library book: %w", err)
+	}
+	return &state, nil
+}
+
+// createActivityPost writes a system-generated post describing the change from
+// previous to book, unless nothing post-worthy changed or the user opted out.
+func createActivityPost(ctx context.Context, tx pgx.Tx, previous *libraryBookState, book *data.LibraryBook) error {
+	description := activityDescription(previous, book)
+	if description == "" {
+		return nil
+	}
+
+	var enabled bool
+	err := tx.QueryRow(ctx, `SELECT activity_posts_enabled FROM users WHERE id = $1`, book.UserID).Scan(&enabled)
+	if err != nil {
+		return fmt.Errorf("read activity setting: %w", err)
+	}
+	if !enabled {
+		return nil
+	}
+
+	query := `
+		INSERT INTO posts AS p (id, user_id, book_id, book_author, book_title, book_image, book_rating, description, summary, system_generated, highlight_id, quote, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, '')::uuid, $12, $13, $14)
+		RETURNING ` + postColumns
+
+	_, err = insertPost(ctx, tx, query, &data.Post{
+		ID:     uuid.New().String(),
+		UserID: book.UserID,
+		Book: data.BookDetails{
+			ID:       book.BookID,
+			Author:   book.AuthorName,
+			Title:    book.Title,
+			ImageURL: book.BookImage,
+			Rating:   book.Rating,
+		},
+		Description:     description,
+		SystemGenerated: true,
+		CreatedAt:       book.UpdatedAt,
+		UpdatedAt:       book.UpdatedAt,
+	})
+	return err
+}
+
+// activityDescription returns the text of the activity post for moving a
+// library book from previous to book, or "" if neither the reading status nor
+// the rating changed. A status change takes precedence over a rating change.
+func activityDescription(previous *libraryBookState, book *data.LibraryBook) string {
+	var before libraryBookState
+	if previous != nil {
+		before = *previous
+	}
+
+	if book.ReadingStatus != before.ReadingStatus {
+		switch book.ReadingStatus {
+		case data.ReadingStatusRead:
+			if book.Rating > 0 {
+				return fmt.Sprintf("Finished reading %s and rated it %d out of 5", book.Title, book.Rating)
+			}
+			return "Finished reading " + book.Title
+		case data.ReadingStatusReading:
+			return "Started reading " + book.Title
+		case data.ReadingStatusWantToRead:
+			return "Wants to read " + book.Title
+		case data.ReadingStatusDidNotFinish:
+			return "Stopped reading " + book.Title
+		}
+	}
+
+	if book.Rating > 0 && book.Rating != before.Rating {
+		return fmt.Sprintf("Rated %s %d out of 5", book.Title, book.Rating)
+	}
+
+	return ""
+}
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_activityDescription(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		previous *libraryBookState
+		status   int32
+		rating   int32
+		want     string
+	}{
+		{
+			name:   "new book being read",
+			status: data.ReadingStatusReading,
+			want:   "Started reading Dune",
+		},
+		{
+			name:   "new book added to want to read",
+			status: data.ReadingStatusWantToRead,
+			want:   "Wants to read Dune",
+		},
+		{
+			name:     "finished without rating",
+			previous: &libraryBookState{ReadingStatus: data.ReadingStatusReading},
+			status:   data.ReadingStatusRead,
+			want:     "Finished reading Dune",
+		},
+		{
+			name:     "finished with rating",
+			previous: &libraryBookState{ReadingStatus: data.ReadingStatusReading},
+			status:   data.ReadingStatusRead,
+			rating:   5,
+			want:     "Finished reading Dune and rated it 5 out of 5",
+		},
+		{
+			name:     "did not finish",
+			previous: &libraryBookState{ReadingStatus: data.ReadingStatusReading},
+			status:   data.ReadingStatusDidNotFinish,
+			want:     "Stopped reading Dune",
+		},
+		{
+			name:     "rating changed",
+			previous: &libraryBookState{ReadingStatus: data.ReadingStatusRead, Rating: 3},
+			status:   data.ReadingStatusRead,
+			rating:   4,
+			want:     "Rated Dune 4 out of 5",
+		},
+		{
+			name:     "rating cleared",
+			previous: &libraryBookState{ReadingStatus: data.ReadingStatusRead, Rating: 3},
+			status:   data.ReadingStatusRead,
+			want:     "",
+		},
+		{
+			name:     "nothing changed",
+			previous: &libraryBookState{ReadingStatus: data.ReadingStatusRead, Rating: 4},
+			status:   data.ReadingStatusRead,
+			rating:   4,
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			book := &data.LibraryBook{Title: "Dune", ReadingStatus: tt.status, Rating: tt.rating}
+			assert.Equal(t, tt.want, activityDescription(tt.previous, book))
+		})
+	}
+}
+
+func TestClient_UpdateLibraryBook_ActivityPosts(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+
+	update := func(status, rating int32) {
+		t.Helper()
+		now := time.Now()
+		require.NoError(t, client.UpdateLibraryBook(ctx, &data.LibraryBook{
+			UserID:        user.ID,
+			BookID:        "OL456M",
+			Title:         "Dune",
+			AuthorName:    "Frank Herbert",
+			Rating:        rating,
+			Source:        data.BookSourceOpenLibrary,
+			ReadingStatus: status,
+			AddedAt:       now,
+			UpdatedAt:     now,
+		}))
+	}
+
+	update(data.ReadingStatusReading, 0)
+	update(data.ReadingStatusReading, 0)
+	update(data.ReadingStatusRead, 5)
+
+	posts, total, err := client.GetUserFeed(ctx, user.ID, data.Page{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), total)
+	require.Len(t, posts, 2)
+	assert.True(t, posts[0].SystemGenerated)
+	assert.Equal(t, "Finished reading Dune and rated it 5 out of 5", posts[0].Description)
+	assert.Equal(t, "Started reading Dune", posts[1].Description)
+
+	require.NoError(t, client.SetActivityPostsEnabled(ctx, user.ID, false))
+	update(data.ReadingStatusRead, 4)
+
+	_, total, err = client.GetUserFeed(ctx, user.ID, data.Page{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), total)
+}
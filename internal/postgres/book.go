@@ -0,0 +1,260 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrCatalogBookNotFound = errors.New("book not found in catalog")
+
+// catalogJoin joins the catalog entry of each library book lb as b.
+const catalogJoin = `
+	INNER JOIN books b ON b.source = lb.source AND b.external_id = lb.book_id
+`
+
+// bookColumns is the column list scanned by scanBook.
+const bookColumns = `
+	source, external_id, work_key, title, author_name, author_key, authors, book_image, isbn, page_count, publish_year,
+	rating_average, rating_count, COALESCE(created_by::text, ''), refreshed_at, checked_at, created_at, updated_at
+`
+
+func scanBook(row pgx.Row) (*data.Book, error) {
+	var b data.Book
+	if err := row.Scan(
+		&b.Source,
+		&b.ExternalID,
+		&b.WorkKey,
+		&b.Title,
+		&b.AuthorName,
+		&b.AuthorKey,
+		&b.Authors,
+		&b.BookImage,
+		&b.ISBN,
+		&b.PageCount,
+		&b.PublishYear,
+		&b.RatingAverage,
+		&b.RatingCount,
+		&b.CreatedBy,
+		&b.RefreshedAt,
+		&b.CheckedAt,
+		&b.CreatedAt,
+		&b.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// catalogBook reads the title, author, image and ISBN of the catalog entry
+// book refers to into book. Only an Open Library book the catalog has never
+// seen is added from what the client sent, as the refresh checks it against
+// Open Library; no other source can be checked, so books from them must
+// already be in the catalog, from a search, CreateBook or an import, and
+// ErrCatalogBookNotFound is returned otherwise. An existing entry is shared
+// with other libraries and is never changed by one of them. When it has no
+// work key it takes the one book carries, or is otherwise queued for the
+// refresh to look the work up, so the book matches its author's works.
+func catalogBook(ctx context.Context, tx pgx.Tx, book *data.LibraryBook) error {
+	if book.Source == data.BookSourceOpenLibrary {
+		query := `
+			INSERT INTO books (source, external_id, title, author_name, book_image, isbn, work_key, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)
+			ON CONFLICT (source, external_id) DO UPDATE
+			SET work_key = EXCLUDED.work_key,
+				checked_at = CASE WHEN EXCLUDED.work_key = '' THEN NULL ELSE books.checked_at END
+			WHERE books.work_key = ''
+		`
+		_, err := tx.Exec(ctx, query,
+			book.Source,
+			book.BookID,
+			book.Title,
+			book.AuthorName,
+			book.BookImage,
+			book.ISBN,
+			book.WorkKey,
+			book.UpdatedAt,
+		)
+		if err != nil {
+			return fmt.Errorf("add catalog entry: %w", err)
+		}
+	}
+	return readCatalogBook(ctx, tx, book)
+}
+
+// importCatalogBook adds the catalog entry for an imported book that Open
+// Library does not have if the catalog does not have it yet, recording the
+// importing user as its creator, then reads the entry into book like
+// catalogBook. Such ids are scoped to the user importing them, so the entry is
+// never shared with another library.
+func importCatalogBook(ctx context.Context, tx pgx.Tx, book *data.LibraryBook) error {
+	query := `
+		INSERT INTO books (source, external_id, title, author_name, book_image, isbn, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $8)
+		ON CONFLICT (source, external_id) DO NOTHING
+	`
+	_, err := tx.Exec(ctx, query,
+		book.Source,
+		book.BookID,
+		book.Title,
+		book.AuthorName,
+		book.BookImage,
+		book.ISBN,
+		book.UserID,
+		book.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("add catalog entry: %w", err)
+	}
+	return readCatalogBook(ctx, tx, book)
+}
+
+// readCatalogBook reads the title, author, image and ISBN of the catalog
+// entry book refers to into book.
+func readCatalogBook(ctx context.Context, tx pgx.Tx, book *data.LibraryBook) error {
+	query := `SELECT title, author_name, book_image, isbn FROM books WHERE source = $1 AND external_id = $2`
+	err := tx.QueryRow(ctx, query, book.Source, book.BookID).Scan(&book.Title, &book.AuthorName, &book.BookImage, &book.ISBN)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCatalogBookNotFound
+		}
+		return fmt.Errorf("read catalog entry: %w", err)
+	}
+	return nil
+}
+
+// CreateBook adds a book entered by a user to the catalog. Its id must be new.
+func (db *Client) CreateBook(ctx context.Context, book *data.Book) error {
+	query := `
+		INSERT INTO books (
+			source, external_id, title, author_name, authors, book_image, isbn, page_count, publish_year, created_by,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, NULLIF($10, '')::uuid, $11, $11)
+	`
+	_, err := db.DB.Exec(ctx, query,
+		book.Source,
+		book.ExternalID,
+		book.Title,
+		book.AuthorName,
+		book.Authors,
+		book.BookImage,
+		book.ISBN,
+		book.PageCount,
+		book.PublishYear,
+		book.CreatedBy,
+		book.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("CreateBook: %w", err)
+	}
+	return nil
+}
+
+// UpsertBooks records books as read from their source, replacing what the
+// catalog held for them. Each book's UpdatedAt is taken as the time the
+// source was read.
+func (db *Client) UpsertBooks(ctx context.Context, books []*data.Book) error {
+	query := `
+		INSERT INTO books (
+			source, external_id, title, author_name, author_key, book_image, isbn, publish_year, rating_average, rating_count,
+			refreshed_at, checked_at, created_at, updated_at, work_key, page_count
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $11, $11, $11, $12, $13)
+		ON CONFLICT (source, external_id) DO UPDATE
+		SET title = EXCLUDED.title,
+			work_key = COALESCE(NULLIF(EXCLUDED.work_key, ''), books.work_key),
+			author_name = EXCLUDED.author_name,
+			author_key = EXCLUDED.author_key,
+			book_image = EXCLUDED.book_image,
+			isbn = COALESCE(NULLIF(EXCLUDED.isbn, ''), books.isbn),
+			page_count = COALESCE(NULLIF(EXCLUDED.page_count, 0), books.page_count),
+			publish_year = EXCLUDED.publish_year,
+			rating_average = EXCLUDED.rating_average,
+			rating_count = EXCLUDED.rating_count,
+			refreshed_at = EXCLUDED.refreshed_at,
+			checked_at = EXCLUDED.checked_at,
+			updated_at = EXCLUDED.updated_at
+	`
+	batch := &pgx.Batch{}
+	for _, b := range books {
+		batch.Queue(query,
+			b.Source,
+			b.ExternalID,
+			b.Title,
+			b.AuthorName,
+			b.AuthorKey,
+			b.BookImage,
+			b.ISBN,
+			b.PublishYear,
+			b.RatingAverage,
+			b.RatingCount,
+			b.UpdatedAt,
+			b.WorkKey,
+			b.PageCount,
+		)
+	}
+	if err := db.DB.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("UpsertBooks: %w", err)
+	}
+	return nil
+}
+
+// GetBook returns the catalog entry for a book from source.
+func (db *Client) GetBook(ctx context.Context, source int32, externalID string) (*data.Book, error) {
+	query := `SELECT ` + bookColumns + ` FROM books WHERE source = $1 AND external_id = $2`
+	book, err := scanBook(db.DB.QueryRow(ctx, query, source, externalID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrCatalogBookNotFound
+		}
+		return nil, fmt.Errorf("GetBook: %w", err)
+	}
+	return book, nil
+}
+
+// ClaimStaleBooks returns up to limit catalog entries from source that have
+// not been checked against it since checkedBefore, those never checked first,
+// and marks them checked at at. Entries another caller is claiming are
+// skipped, so concurrent callers never check the same entry.
+func (db *Client) ClaimStaleBooks(ctx context.Context, source int32, checkedBefore, at time.Time, limit int32) ([]*data.Book, error) {
+	query := `
+		WITH claimed AS (
+			SELECT external_id AS claimed_id
+			FROM books
+			WHERE source = $1 AND (checked_at IS NULL OR checked_at < $2)
+			ORDER BY checked_at NULLS FIRST, external_id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE books
+		SET checked_at = $4
+		FROM claimed
+		WHERE source = $1 AND external_id = claimed_id
+		RETURNING ` + bookColumns
+	rows, err := db.DB.Query(ctx, query, source, checkedBefore, limit, at)
+	if err != nil {
+		return nil, fmt.Errorf("ClaimStaleBooks: %w", err)
+	}
+	books, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*data.Book, error) {
+		return scanBook(row)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ClaimStaleBooks: %w", err)
+	}
+	return books, nil
+}
+
+// MarkBooksChecked records that the source was last asked about the given
+// entries at, without changing them, which decides when they are stale again.
+func (db *Client) MarkBooksChecked(ctx context.Context, source int32, externalIDs []string, at time.Time) error {
+	query := `UPDATE books SET checked_at = $3 WHERE source = $1 AND external_id = ANY($2)`
+	if _, err := db.DB.Exec(ctx, query, source, externalIDs, at); err != nil {
+		return fmt.Errorf("MarkBooksChecked: %w", err)
+	}
+	return nil
+}
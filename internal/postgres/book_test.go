@@ -0,0 +1,179 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Catalog(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	other := seedUser(t, client)
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	// Ids unique to the run, so entries left by other tests are not stale
+	// candidates here.
+	duneID, emmaID := "OL"+uuid.NewString()+"M", "OL"+uuid.NewString()+"M"
+
+	// The first library to add a book describes it until the source does.
+	add := func(userID, bookID, title string) {
+		t.Helper()
+		require.NoError(t, client.UpdateLibraryBook(ctx, &data.LibraryBook{
+			UserID:        userID,
+			BookID:        bookID,
+			Title:         title,
+			AuthorName:    "Frank Herbert",
+			Source:        data.BookSourceOpenLibrary,
+			ReadingStatus: data.ReadingStatusWantToRead,
+			AddedAt:       now,
+			UpdatedAt:     now,
+		}))
+	}
+	add(user.ID, duneID, "Dune")
+	add(other.ID, duneID, "Dune (spoofed)")
+
+	title := func(userID string) string {
+		t.Helper()
+		books, _, err := client.GetUserLibrary(ctx, userID, data.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, books, 1)
+		return books[0].Title
+	}
+	assert.Equal(t, "Dune", title(user.ID))
+	assert.Equal(t, "Dune", title(other.ID))
+
+	// Entries never checked against the source, or not checked recently, are
+	// stale, and claiming them marks them checked so no other refresh claims
+	// them too.
+	require.NoError(t, client.UpsertBooks(ctx, []*data.Book{{
+		Source:     data.BookSourceOpenLibrary,
+		ExternalID: emmaID,
+		Title:      "Emma",
+		AuthorName: "Jane Austen",
+		UpdatedAt:  now.Add(-time.Hour),
+	}}))
+	claimIDs := func() []string {
+		t.Helper()
+		stale, err := client.ClaimStaleBooks(ctx, data.BookSourceOpenLibrary, now.Add(-time.Minute), now, 1000)
+		require.NoError(t, err)
+		ids := make([]string, 0, len(stale))
+		for _, b := range stale {
+			ids = append(ids, b.ExternalID)
+		}
+		return ids
+	}
+	ids := claimIDs()
+	require.Contains(t, ids, duneID)
+	require.Contains(t, ids, emmaID)
+	assert.NotContains(t, claimIDs(), duneID)
+
+	// A refresh replaces the entry in every library.
+	require.NoError(t, client.UpsertBooks(ctx, []*data.Book{{
+		Source:      data.BookSourceOpenLibrary,
+		ExternalID:  duneID,
+		WorkKey:     "OL893415W",
+		Title:       "Dune",
+		AuthorName:  "Frank Herbert",
+		AuthorKey:   "OL79034A",
+		BookImage:   "https://covers.openlibrary.org/b/olid/OL456M-L.jpg",
+		PublishYear: 1965,
+		PageCount:   604,
+		UpdatedAt:   now,
+	}}))
+	books, _, err := client.GetUserLibrary(ctx, other.ID, data.Page{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, books, 1)
+	assert.Equal(t, "Dune", books[0].Title)
+	assert.Equal(t, "https://covers.openlibrary.org/b/olid/OL456M-L.jpg", books[0].BookImage)
+
+	book, err := client.GetBook(ctx, data.BookSourceOpenLibrary, duneID)
+	require.NoError(t, err)
+	assert.Equal(t, "OL79034A", book.AuthorKey)
+	assert.Equal(t, "OL893415W", book.WorkKey)
+	assert.Equal(t, int32(1965), book.PublishYear)
+	assert.Equal(t, int32(604), book.PageCount)
+	require.NotNil(t, book.RefreshedAt)
+	_, err = client.GetBook(ctx, data.BookSourceManual, duneID)
+	require.ErrorIs(t, err, ErrCatalogBookNotFound)
+
+	// An entry checked long ago is stale again.
+	require.NoError(t, client.MarkBooksChecked(ctx, data.BookSourceOpenLibrary, []string{emmaID}, now.Add(-time.Hour)))
+	ids = claimIDs()
+	assert.Contains(t, ids, emmaID)
+	assert.NotContains(t, ids, duneID)
+}
+
+func TestClient_Catalog_UnverifiedSources(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	// Only Open Library can confirm what a client says about a book, so books
+	// from other sources are never added to the catalog from it.
+	for _, source := range []int32{data.BookSourceUnspecified, data.BookSourceGoogleBooks, data.BookSourceManual} {
+		err := client.UpdateLibraryBook(ctx, &data.LibraryBook{
+			UserID:        user.ID,
+			BookID:        "manual:" + uuid.NewString(),
+			Title:         "Dune (spoofed)",
+			Source:        source,
+			ReadingStatus: data.ReadingStatusWantToRead,
+			AddedAt:       now,
+			UpdatedAt:     now,
+		})
+		require.ErrorIs(t, err, ErrCatalogBookNotFound)
+	}
+
+	books, _, err := client.GetUserLibrary(ctx, user.ID, data.Page{Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, books)
+}
+
+func TestClient_CreateBook(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	id := "manual:" + uuid.NewString()
+
+	require.NoError(t, client.CreateBook(ctx, &data.Book{
+		Source:      data.BookSourceManual,
+		ExternalID:  id,
+		Title:       "The Zine",
+		AuthorName:  "Ana Ruiz, Ben Ode",
+		Authors:     []string{"Ana Ruiz", "Ben Ode"},
+		ISBN:        "9780306406157",
+		PageCount:   48,
+		PublishYear: 2024,
+		CreatedBy:   user.ID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}))
+	book, err := client.GetBook(ctx, data.BookSourceManual, id)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Ana Ruiz", "Ben Ode"}, book.Authors)
+	assert.Equal(t, int32(48), book.PageCount)
+	assert.Equal(t, user.ID, book.CreatedBy)
+
+	// Shelving the book keeps what the user entered.
+	require.NoError(t, client.UpdateLibraryBook(ctx, &data.LibraryBook{
+		UserID:        user.ID,
+		BookID:        id,
+		Title:         "Untitled",
+		Source:        data.BookSourceManual,
+		ReadingStatus: data.ReadingStatusWantToRead,
+		AddedAt:       now,
+		UpdatedAt:     now,
+	}))
+	books, _, err := client.GetUserLibrary(ctx, user.ID, data.Page{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, books, 1)
+	assert.Equal(t, "The Zine", books[0].Title)
+	assert.Equal(t, "Ana Ruiz, Ben Ode", books[0].AuthorName)
+}
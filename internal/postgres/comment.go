@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+	ErrCommentNotFound       = errors.New("comment not found")
+	ErrCannotDeleteComment   = errors.New("only the comment author or post author may delete the comment")
+	ErrCommentAuthorNotFound = errors.New("comment author not found")
+)
+
+// GetCommentsForPost returns a page of comments on a post, oldest first, along
+// with the total number of comments on the post. Both are read from one
+// snapshot so the total matches the page even while comments are added.
+func (db *Client) GetCommentsForPost(ctx context.Context, postID string, page data.Page) ([]*data.Comment, int32, error) {
+	tx, err := db.DB.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, 0, fmt.Errorf("GetCommentsForPost: failed to start transaction: %w", err)
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			logger.Error("GetCommentsForPost: failed to rollback transaction", "post_id", postID, "error", rollbackErr)
+		}
+	}()
+
+	var total int32
+	err = tx.QueryRow(ctx, `SELECT comment_count FROM posts WHERE id = $1`, postID).Scan(&total)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, 0, ErrPostNotFound
+		}
+		return nil, 0, fmt.Errorf("GetCommentsForPost (count): %w", err)
+	}
+
+	query := `
+		SELECT id, post_id, user_id, content, created_at
+		FROM comments
+		WHERE post_id = $1
+		  AND ($4::timestamptz IS NULL OR (created_at, id) > ($4, $5::uuid))
+		ORDER BY created_at ASC, id ASC
+		LIMIT $2 OFFSET $3
+	`
+	afterTime, afterID := cursorArgs(page.After)
+	rows, err := tx.Query(ctx, query, postID, page.Limit, offset(page), afterTime, afterID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("GetCommentsForPost: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []*data.Comment
+	for rows.Next() {
+		var c data.Comment
+		if err := rows.Scan(&c.ID, &c.PostID, &c.UserID, &c.Content, &c.CreatedAt); err != nil {
+			return nil, 0, fmt.Errorf("GetCommentsForPost scan: %w", err)
+		}
+		comments = append(comments, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("GetCommentsForPost rows: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, 0, fmt.Errorf("GetCommentsForPost: committing transaction: %w", err)
+	}
+
+	return comments, total, nil
+}
+
+// AddComment inserts a comment and bumps the post's comment_count in the same transaction.
+func (db *Client) AddComment(ctx context.Context, comment *data.Comment) (*data.Comment, error) {
+	tx, err := db.DB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("AddComment: failed to start transaction: %w", err)
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			logger.Error("AddComment: failed to rollback transaction", "post_id", comment.PostID, "error", rollbackErr)
+		}
+	}()
+
+	query := `
+		INSERT INTO comments (id, post_id, user_id, content, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at
+	`
+	err = tx.QueryRow(ctx, query, comment.ID, comment.PostID, comment.UserID, comment.Content, comment.CreatedAt).
+		Scan(&comment.CreatedAt)
+	if err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == ForeignKeyViolation {
+				switch pgErr.ConstraintName {
+				case "comments_post_id_fkey":
+					return nil, ErrPostNotFound
+				case "comments_user_id_fkey":
+					return nil, ErrCommentAuthorNotFound
+				default:
+					return nil, fmt.Errorf("AddComment FK violation: %w", err)
+				}
+			}
+		}
+		return nil, fmt.Errorf("AddComment: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE posts SET comment_count = comment_count + 1 WHERE id = $1`, comment.PostID); err != nil {
+		return nil, fmt.Errorf("AddComment (count): %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("AddComment: committing transaction: %w", err)
+	}
+
+	return comment, nil
+}
+
+// DeleteComment removes a comment if userID wrote either the comment or the
+// post it belongs to, and decrements the post's comment_count.
+func (db *Client) DeleteComment(ctx context.Context, userID, postID, commentID string) error {
+	tx, err := db.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("DeleteComment: failed to start transaction: %w", err)
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			logger.Error("DeleteComment: failed to rollback transaction", "comment_id", commentID, "error", rollbackErr)
+		}
+	}()
+
+	query := `
+		SELECT c.user_id, p.user_id
+		FROM comments c
+		INNER JOIN posts p ON p.id = c.post_id
+		WHERE c.id = $1 AND c.post_id = $2
+		FOR UPDATE OF c
+	`
+	var commentAuthorID, postAuthorID string
+	if err := tx.QueryRow(ctx, query, commentID, postID).Scan(&commentAuthorID, &postAuthorID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCommentNotFound
+		}
+		return fmt.Errorf("DeleteComment (lookup): %w", err)
+	}
+	if userID != commentAuthorID && userID != postAuthorID {
+		return ErrCannotDeleteComment
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM comments WHERE id = $1`, commentID); err != nil {
+		return fmt.Errorf("DeleteComment: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE posts SET comment_count = comment_count - 1 WHERE id = $1`, postID); err != nil {
+		return fmt.Errorf("DeleteComment (count): %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("DeleteComment: committing transaction: %w", err)
+	}
+
+	return nil
+}
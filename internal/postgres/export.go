@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/logger"
+	"github.com/jackc/pgx/v5"
+)
+
+// ExportLibrary reads the user's whole library from a single snapshot. It
+// passes every shelf to shelves first, then each book to book, oldest first, as
+// its row arrives, so a library is never held in memory. An error from either
+// callback stops the export and is returned as is.
+func (db *Client) ExportLibrary(
+	ctx context.Context,
+	userID string,
+	shelves func([]*data.Shelf) error,
+	book func(*data.LibraryBook) error,
+) error {
+	tx, err := db.DB.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return fmt.Errorf("ExportLibrary: failed to start transaction: %w", err)
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			logger.Error("ExportLibrary: failed to rollback transaction", "user_id", userID, "error", rollbackErr)
+		}
+	}()
+
+	shelfQuery := `
+		SELECT id, name, user_id, created_at, updated_at
+		FROM shelves
+		WHERE user_id = $1
+		ORDER BY created_at ASC
+	`
+	shelfRows, err := tx.Query(ctx, shelfQuery, userID)
+	if err != nil {
+		return fmt.Errorf("ExportLibrary (shelves): %w", err)
+	}
+	userShelves, err := pgx.CollectRows(shelfRows, func(row pgx.CollectableRow) (*data.Shelf, error) {
+		var s data.Shelf
+		err := row.Scan(&s.ID, &s.Name, &s.UserID, &s.CreatedAt, &s.UpdatedAt)
+		return &s, err
+	})
+	if err != nil {
+		return fmt.Errorf("ExportLibrary (shelves): %w", err)
+	}
+	if err := shelves(userShelves); err != nil {
+		return err
+	}
+
+	query := `
+		SELECT ` + libraryBookColumns + `
+		FROM library_books lb
+		` + catalogJoin + `
+		WHERE lb.user_id = $1
+		ORDER BY lb.added_at ASC, lb.book_id ASC
+	`
+	rows, err := tx.Query(ctx, query, userID)
+	if err != nil {
+		return fmt.Errorf("ExportLibrary (books): %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		b, err := scanLibraryBook(rows)
+		if err != nil {
+			return fmt.Errorf("ExportLibrary: %w", err)
+		}
+		if err := book(b); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("ExportLibrary (books): %w", err)
+	}
+	return nil
+}
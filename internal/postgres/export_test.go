@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ExportLibrary(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	shelf := seedShelf(t, client, user.ID, "Favourites")
+
+	base := time.Now().Add(-time.Hour)
+	seedLibraryBook(t, client, user.ID, "OL2M", "Foundation", "Isaac Asimov", base.Add(time.Minute))
+	seedLibraryBook(t, client, user.ID, "OL1M", "Dune", "Frank Herbert", base, shelf.ID)
+
+	var shelves []*data.Shelf
+	var books []*data.LibraryBook
+	err := client.ExportLibrary(ctx, user.ID,
+		func(s []*data.Shelf) error {
+			assert.Empty(t, books, "shelves must come before books")
+			shelves = s
+			return nil
+		},
+		func(b *data.LibraryBook) error {
+			books = append(books, b)
+			return nil
+		},
+	)
+	require.NoError(t, err)
+
+	require.Len(t, shelves, 1)
+	assert.Equal(t, shelf.ID, shelves[0].ID)
+	require.Len(t, books, 2)
+	assert.Equal(t, "OL1M", books[0].BookID, "oldest first")
+	assert.Equal(t, []string{shelf.ID}, books[0].ShelfIDs)
+	assert.Equal(t, "OL2M", books[1].BookID)
+
+	// A failing callback stops the export with its error.
+	stop := errors.New("client went away")
+	calls := 0
+	err = client.ExportLibrary(ctx, user.ID,
+		func([]*data.Shelf) error { return nil },
+		func(*data.LibraryBook) error {
+			calls++
+			return stop
+		},
+	)
+	require.ErrorIs(t, err, stop)
+	assert.Equal(t, 1, calls)
+}
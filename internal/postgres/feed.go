@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+)
+
+// feedTotalLimit caps the total GetPersonalizedFeed reports. Counting every
+// post by every followee would read all of them on each page.
+const feedTotalLimit = 1000
+
+// GetPersonalizedFeed returns a page of posts written by the users userID
+// follows, newest first, along with the number of matching posts capped at
+// feedTotalLimit. When since is set only posts created after it are returned.
+func (db *Client) GetPersonalizedFeed(ctx context.Context, userID string, since *time.Time, page data.Page) ([]*data.Post, int32, error) {
+	// Both queries walk the follows primary key (follower_id, followee_id) and
+	// read at most a bounded number of rows per followee from
+	// posts_user_id_created_at_idx (user_id, created_at DESC, id DESC), so
+	// neither sorts or counts a followee's whole history. Their cost grows
+	// with the number of follows times the rows wanted, not with the number
+	// of posts.
+	countQuery := `
+		SELECT COUNT(*)
+		FROM (
+			SELECT 1
+			FROM follows f
+			CROSS JOIN LATERAL (
+				SELECT 1
+				FROM posts
+				WHERE user_id = f.followee_id
+				  AND ($2::timestamptz IS NULL OR created_at > $2)
+				LIMIT $3
+			) fp
+			WHERE f.follower_id = $1
+			LIMIT $3
+		) capped
+	`
+	var total int32
+	if err := db.DB.QueryRow(ctx, countQuery, userID, since, feedTotalLimit).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("GetPersonalizedFeed (count): %w", err)
+	}
+
+	// Each followee contributes at most LIMIT + OFFSET of its newest posts,
+	// which is all the merged page can need from it.
+	query := `
+		SELECT ` + postColumns + `
+		FROM follows f
+		CROSS JOIN LATERAL (
+			SELECT *
+			FROM posts
+			WHERE user_id = f.followee_id
+			  AND ($2::timestamptz IS NULL OR created_at > $2)
+			  AND ($5::timestamptz IS NULL OR (created_at, id) < ($5, $6::uuid))
+			ORDER BY created_at DESC, id DESC
+			LIMIT $3::bigint + $4::bigint
+		) p
+		WHERE f.follower_id = $1
+		ORDER BY p.created_at DESC, p.id DESC
+		LIMIT $3 OFFSET $4
+	`
+	afterTime, afterID := cursorArgs(page.After)
+	posts, err := db.queryPosts(ctx, query, userID, since, page.Limit, offset(page), afterTime, afterID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("GetPersonalizedFeed: %w", err)
+	}
+	return posts, total, nil
+}
+
+// GetUserFeed returns a page of the posts written by userID, newest first,
+// along with the total number of posts they have written.
+func (db *Client) GetUserFeed(ctx context.Context, userID string, page data.Page) ([]*data.Post, int32, error) {
+	countQuery := `
+		SELECT EXISTS (SELECT 1 FROM users WHERE id = $1),
+			(SELECT COUNT(*) FROM posts WHERE user_id = $1)
+	`
+	var (
+		exists bool
+		total  int32
+	)
+	if err := db.DB.QueryRow(ctx, countQuery, userID).Scan(&exists, &total); err != nil {
+		return nil, 0, fmt.Errorf("GetUserFeed (count): %w", err)
+	}
+	if !exists {
+		return nil, 0, ErrUserNotFound
+	}
+
+	query := `
+		SELECT ` + postColumns + `
+		FROM posts p
+		WHERE p.user_id = $1
+		  AND ($4::timestamptz IS NULL OR (p.created_at, p.id) < ($4, $5::uuid))
+		ORDER BY p.created_at DESC, p.id DESC
+		LIMIT $2 OFFSET $3
+	`
+	afterTime, afterID := cursorArgs(page.After)
+	posts, err := db.queryPosts(ctx, query, userID, page.Limit, offset(page), afterTime, afterID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("GetUserFeed: %w", err)
+	}
+	return posts, total, nil
+}
+
+func (db *Client) queryPosts(ctx context.Context, query string, args ...any) ([]*data.Post, error) {
+	rows, err := db.DB.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var posts []*data.Post
+	for rows.Next() {
+		p, err := scanPost(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan post: %w", err)
+		}
+		posts = append(posts, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return posts, nil
+}
@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetPersonalizedFeed(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	reader := seedUser(t, client)
+	followee := seedUser(t, client)
+	stranger := seedUser(t, client)
+	require.NoError(t, client.FollowUser(ctx, reader.ID, followee.ID))
+
+	base := time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond)
+	oldest := seedPost(t, client, followee.ID, base)
+	middle := seedPost(t, client, followee.ID, base.Add(time.Minute))
+	newest := seedPost(t, client, followee.ID, base.Add(2*time.Minute))
+	seedPost(t, client, stranger.ID, base.Add(3*time.Minute))
+	seedPost(t, client, reader.ID, base.Add(4*time.Minute))
+
+	posts, total, err := client.GetPersonalizedFeed(ctx, reader.ID, nil, data.Page{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), total)
+	require.Len(t, posts, 2)
+	assert.Equal(t, newest.ID, posts[0].ID)
+	assert.Equal(t, middle.ID, posts[1].ID)
+
+	posts, total, err = client.GetPersonalizedFeed(ctx, reader.ID, nil, data.Page{Limit: 2, Offset: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), total)
+	require.Len(t, posts, 1)
+	assert.Equal(t, oldest.ID, posts[0].ID)
+
+	since := oldest.CreatedAt
+	posts, total, err = client.GetPersonalizedFeed(ctx, reader.ID, &since, data.Page{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), total)
+	require.Len(t, posts, 2)
+	assert.Equal(t, newest.ID, posts[0].ID)
+	assert.Equal(t, middle.ID, posts[1].ID)
+}
+
+func TestClient_GetPersonalizedFeed_TotalLimit(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	reader := seedUser(t, client)
+	followee := seedUser(t, client)
+	require.NoError(t, client.FollowUser(ctx, reader.ID, followee.ID))
+
+	now := time.Now()
+	followeeID := uuid.MustParse(followee.ID)
+	rows := make([][]any, 0, feedTotalLimit+5)
+	for i := range feedTotalLimit + 5 {
+		createdAt := now.Add(-time.Duration(i) * time.Minute)
+		rows = append(rows, []any{uuid.New(), followeeID, "OL456M", "Frank Herbert", "Dune", "", createdAt, createdAt})
+	}
+	_, err := client.DB.CopyFrom(
+		ctx,
+		pgx.Identifier{"posts"},
+		[]string{"id", "user_id", "book_id", "book_author", "book_title", "description", "created_at", "updated_at"},
+		pgx.CopyFromRows(rows),
+	)
+	require.NoError(t, err)
+
+	posts, total, err := client.GetPersonalizedFeed(ctx, reader.ID, nil, data.Page{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int32(feedTotalLimit), total)
+	require.Len(t, posts, 10)
+
+	last := posts[len(posts)-1]
+	next, _, err := client.GetPersonalizedFeed(ctx, reader.ID, nil, data.Page{Limit: 10, After: &data.Cursor{Time: last.CreatedAt, ID: last.ID}})
+	require.NoError(t, err)
+	require.Len(t, next, 10)
+	assert.True(t, next[0].CreatedAt.Before(last.CreatedAt))
+}
+
+func TestClient_GetUserFeed(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	author := seedUser(t, client)
+	other := seedUser(t, client)
+
+	base := time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond)
+	older := seedPost(t, client, author.ID, base)
+	newer := seedPost(t, client, author.ID, base.Add(time.Minute))
+	seedPost(t, client, other.ID, base.Add(2*time.Minute))
+
+	posts, total, err := client.GetUserFeed(ctx, author.ID, data.Page{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), total)
+	require.Len(t, posts, 2)
+	assert.Equal(t, newer.ID, posts[0].ID)
+	assert.Equal(t, older.ID, posts[1].ID)
+
+	posts, _, err = client.GetUserFeed(ctx, author.ID, data.Page{Limit: 10, After: &data.Cursor{Time: newer.CreatedAt, ID: newer.ID}})
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Equal(t, older.ID, posts[0].ID)
+
+	_, _, err = client.GetUserFeed(ctx, uuid.New().String(), data.Page{Limit: 10})
+	require.ErrorIs(t, err, ErrUserNotFound)
+}
+
+// BenchmarkClient_GetPersonalizedFeed reads the first page of a feed for a
+// user following thousands of active accounts, with an equal amount of
+// unrelated traffic in the posts table.
+func BenchmarkClient_GetPersonalizedFeed(b *testing.B) {
+	const (
+		followees    = 2000
+		strangers    = 2000
+		postsPerUser = 25
+	)
+
+	ctx := context.Background()
+	client := newTestClient(b)
+	reader := seedUser(b, client)
+
+	followeeIDs := seedBenchUsers(b, client, followees)
+	strangerIDs := seedBenchUsers(b, client, strangers)
+
+	readerID := uuid.MustParse(reader.ID)
+	follows := make([][]any, 0, len(followeeIDs))
+	for _, id := range followeeIDs {
+		follows = append(follows, []any{readerID, id})
+	}
+	_, err := client.DB.CopyFrom(ctx, pgx.Identifier{"follows"}, []string{"follower_id", "followee_id"}, pgx.CopyFromRows(follows))
+	require.NoError(b, err)
+
+	now := time.Now()
+	var posts [][]any
+	for _, id := range append(followeeIDs, strangerIDs...) {
+		for i := range postsPerUser {
+			createdAt := now.Add(-time.Duration(i) * time.Hour)
+			posts = append(posts, []any{uuid.New(), id, "OL456M", "Frank Herbert", "Dune", "", createdAt, createdAt})
+		}
+	}
+	_, err = client.DB.CopyFrom(
+		ctx,
+		pgx.Identifier{"posts"},
+		[]string{"id", "user_id", "book_id", "book_author", "book_title", "description", "created_at", "updated_at"},
+		pgx.CopyFromRows(posts),
+	)
+	require.NoError(b, err)
+	_, err = client.DB.Exec(ctx, `ANALYZE posts; ANALYZE follows;`)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for b.Loop() {
+		if _, _, err := client.GetPersonalizedFeed(ctx, reader.ID, nil, data.Page{Limit: 20}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// seedBenchUsers bulk inserts n users and removes them (and everything
+// cascading from them) when the benchmark ends. IDs are returned as uuid.UUID
+// because COPY uses the binary protocol, which cannot encode UUIDs from text.
+func seedBenchUsers(b *testing.B, client *Client, n int) []uuid.UUID {
+	b.Helper()
+	ctx := context.Background()
+
+	ids := make([]uuid.UUID, 0, n)
+	rows := make([][]any, 0, n)
+	for range n {
+		id := uuid.New()
+		ids = append(ids, id)
+		rows = append(rows, []any{id, "bench-" + id.String(), "Bench", "User", fmt.Sprintf("%s@example.com", id)})
+	}
+	_, err := client.DB.CopyFrom(
+		ctx,
+		pgx.Identifier{"users"},
+		[]string{"id", "username", "first_name", "last_name", "email"},
+		pgx.CopyFromRows(rows),
+	)
+	require.NoError(b, err)
+	b.Cleanup(func() {
+		_, _ = client.DB.Exec(ctx, `DELETE FROM users WHERE id = ANY($1)`, ids)
+	})
+	return ids
+}
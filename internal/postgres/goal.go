@@ -0,0 +1,108 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrReadingGoalNotFound = errors.New("reading goal not found")
+
+// SetReadingGoal creates or replaces the user's goal for goal.Year.
+func (db *Client) SetReadingGoal(ctx context.Context, goal *data.ReadingGoal) (*data.ReadingGoal, error) {
+	query := `
+		INSERT INTO reading_goals (user_id, year, target_books, target_pages, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		ON CONFLICT (user_id, year) DO UPDATE
+		SET target_books = EXCLUDED.target_books, target_pages = EXCLUDED.target_pages, updated_at = EXCLUDED.updated_at
+		RETURNING created_at, updated_at
+	`
+	err := db.DB.QueryRow(ctx, query,
+		goal.UserID,
+		goal.Year,
+		goal.TargetBooks,
+		goal.TargetPages,
+		goal.UpdatedAt,
+	).Scan(&goal.CreatedAt, &goal.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("SetReadingGoal: %w", err)
+	}
+	return goal, nil
+}
+
+func (db *Client) GetReadingGoal(ctx context.Context, userID string, year int32) (*data.ReadingGoal, error) {
+	query := `
+		SELECT user_id, year, target_books, target_pages, created_at, updated_at
+		FROM reading_goals
+		WHERE user_id = $1 AND year = $2
+	`
+	var g data.ReadingGoal
+	err := db.DB.QueryRow(ctx, query, userID, year).Scan(
+		&g.UserID,
+		&g.Year,
+		&g.TargetBooks,
+		&g.TargetPages,
+		&g.CreatedAt,
+		&g.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrReadingGoalNotFound
+		}
+		return nil, fmt.Errorf("GetReadingGoal: %w", err)
+	}
+	return &g, nil
+}
+
+func (db *Client) DeleteReadingGoal(ctx context.Context, userID string, year int32) error {
+	query := `DELETE FROM reading_goals WHERE user_id = $1 AND year = $2`
+	tag, err := db.DB.Exec(ctx, query, userID, year)
+	if err != nil {
+		return fmt.Errorf("DeleteReadingGoal: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrReadingGoalNotFound
+	}
+	return nil
+}
+
+// GetGoalBooks returns the reads the user finished during year (UTC), most
+// recently finished first. Every finished read counts, so a book read twice
+// appears twice; abandoned reads do not count. The page count of a book is
+// the latest one given with its reading progress, or else the catalog's.
+func (db *Client) GetGoalBooks(ctx context.Context, userID string, year int32) ([]*data.GoalBook, error) {
+	from := time.Date(int(year), time.January, 1, 0, 0, 0, 0, time.UTC)
+	query := `
+		SELECT ` + libraryBookColumns + `, rs.finished_at, COALESCE(rp.total_pages, b.page_count)
+		FROM reading_sessions rs
+		INNER JOIN library_books lb ON lb.user_id = rs.user_id AND lb.book_id = rs.book_id
+		` + catalogJoin + bookPagesJoin + `
+		WHERE rs.user_id = $1
+		  AND rs.finished_at >= $2 AND rs.finished_at < $3
+		  AND NOT rs.did_not_finish
+		ORDER BY rs.finished_at DESC, rs.id DESC
+	`
+	rows, err := db.DB.Query(ctx, query, userID, from, from.AddDate(1, 0, 0))
+	if err != nil {
+		return nil, fmt.Errorf("GetGoalBooks: %w", err)
+	}
+	defer rows.Close()
+
+	var books []*data.GoalBook
+	for rows.Next() {
+		var gb data.GoalBook
+		gb.Book, err = scanLibraryBook(rows, &gb.FinishedAt, &gb.Pages)
+		if err != nil {
+			return nil, fmt.Errorf("GetGoalBooks: %w", err)
+		}
+		books = append(books, &gb)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("GetGoalBooks: %w", err)
+	}
+	return books, nil
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ReadingGoal(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+
+	_, err := client.GetReadingGoal(ctx, user.ID, 2025)
+	require.ErrorIs(t, err, ErrReadingGoalNotFound)
+
+	now := time.Now()
+	_, err = client.SetReadingGoal(ctx, &data.ReadingGoal{UserID: user.ID, Year: 2025, TargetBooks: 12, UpdatedAt: now})
+	require.NoError(t, err)
+	_, err = client.SetReadingGoal(ctx, &data.ReadingGoal{UserID: user.ID, Year: 2025, TargetPages: 5000, UpdatedAt: now})
+	require.NoError(t, err)
+
+	goal, err := client.GetReadingGoal(ctx, user.ID, 2025)
+	require.NoError(t, err)
+	assert.Zero(t, goal.TargetBooks)
+	assert.Equal(t, int32(5000), goal.TargetPages)
+
+	require.NoError(t, client.DeleteReadingGoal(ctx, user.ID, 2025))
+	require.ErrorIs(t, client.DeleteReadingGoal(ctx, user.ID, 2025), ErrReadingGoalNotFound)
+}
+
+func TestClient_GetGoalBooks(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	addedAt := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	seedLibraryBook(t, client, user.ID, "OL1M", "Dune", "Frank Herbert", addedAt)
+	seedLibraryBook(t, client, user.ID, "OL2M", "Emma", "Jane Austen", addedAt)
+
+	session := func(bookID string, finishedAt time.Time, dnf bool) {
+		t.Helper()
+		_, err := client.CreateReadingSession(ctx, &data.ReadingSession{
+			ID:           uuid.New().String(),
+			UserID:       user.ID,
+			BookID:       bookID,
+			FinishedAt:   &finishedAt,
+			DidNotFinish: dnf,
+			CreatedAt:    finishedAt,
+			UpdatedAt:    finishedAt,
+		})
+		require.NoError(t, err)
+	}
+	session("OL1M", time.Date(2024, 12, 31, 23, 0, 0, 0, time.UTC), false)
+	session("OL1M", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), false)
+	session("OL1M", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), false)
+	session("OL2M", time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), true)
+
+	_, err := client.LogReadingProgress(ctx, &data.ReadingProgress{
+		ID: uuid.New().String(), UserID: user.ID, BookID: "OL1M", Page: 10, TotalPages: 412, CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	books, err := client.GetGoalBooks(ctx, user.ID, 2025)
+	require.NoError(t, err)
+	require.Len(t, books, 2)
+	assert.Equal(t, time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), books[0].FinishedAt.UTC())
+	assert.Equal(t, "Dune", books[0].Book.Title)
+	assert.Equal(t, int32(412), books[0].Pages)
+	assert.Equal(t, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC), books[1].FinishedAt.UTC())
+
+	// Without progress the page count comes from the catalog, and is zero
+	// when the source did not give one.
+	session("OL2M", time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC), false)
+	books, err = client.GetGoalBooks(ctx, user.ID, 2025)
+	require.NoError(t, err)
+	require.Len(t, books, 3)
+	assert.Equal(t, "Emma", books[1].Book.Title)
+	assert.Zero(t, books[1].Pages)
+
+	require.NoError(t, client.UpsertBooks(ctx, []*data.Book{{
+		Source: data.BookSourceOpenLibrary, ExternalID: "OL2M", Title: "Emma", AuthorName: "Jane Austen", PageCount: 474, UpdatedAt: time.Now(),
+	}}))
+	books, err = client.GetGoalBooks(ctx, user.ID, 2025)
+	require.NoError(t, err)
+	require.Len(t, books, 3)
+	assert.Equal(t, int32(474), books[1].Pages)
+}
@@ -0,0 +1,202 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var ErrHighlightNotFound = errors.New("highlight not found")
+
+// highlightColumns is the column list scanned by scanHighlight. It needs
+// highlightBookJoin.
+const highlightColumns = `
+	h.id, h.user_id, h.book_id, b.title, b.author_name, b.book_image,
+	h.quote, h.page, h.location, h.comment, h.is_public, h.created_at, h.updated_at
+`
+
+// highlightBookJoin joins the library entry each highlight h belongs to, and
+// its catalog entry.
+const highlightBookJoin = `
+	JOIN library_books lb ON lb.user_id = h.user_id AND lb.book_id = h.book_id
+` + catalogJoin
+
+func scanHighlight(row pgx.Row) (*data.Highlight, error) {
+	var h data.Highlight
+	if err := row.Scan(
+		&h.ID,
+		&h.UserID,
+		&h.BookID,
+		&h.BookTitle,
+		&h.AuthorName,
+		&h.BookImage,
+		&h.Quote,
+		&h.Page,
+		&h.Location,
+		&h.Comment,
+		&h.IsPublic,
+		&h.CreatedAt,
+		&h.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+// CreateHighlight saves a highlight on a book in the user's library.
+func (db *Client) CreateHighlight(ctx context.Context, h *data.Highlight) (*data.Highlight, error) {
+	query := `
+		WITH h AS (
+			INSERT INTO highlights (id, user_id, book_id, quote, page, location, comment, is_public, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			RETURNING *
+		)
+		SELECT ` + highlightColumns + ` FROM h` + highlightBookJoin
+	created, err := scanHighlight(db.DB.QueryRow(ctx, query,
+		h.ID,
+		h.UserID,
+		h.BookID,
+		h.Quote,
+		h.Page,
+		h.Location,
+		h.Comment,
+		h.IsPublic,
+		h.CreatedAt,
+		h.UpdatedAt,
+	))
+	if err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "highlights_user_id_book_id_fkey" {
+			return nil, ErrBookNotFound
+		}
+		return nil, fmt.Errorf("CreateHighlight: %w", err)
+	}
+	return created, nil
+}
+
+// ImportHighlight saves a highlight unless the user already has one with the
+// same quote on the book, reporting whether it was added.
+func (db *Client) ImportHighlight(ctx context.Context, h *data.Highlight) (bool, error) {
+	query := `
+		INSERT INTO highlights (id, user_id, book_id, quote, page, location, comment, is_public, created_at, updated_at)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		WHERE NOT EXISTS (
+			SELECT 1 FROM highlights WHERE user_id = $2 AND book_id = $3 AND quote = $4
+		)
+	`
+	tag, err := db.DB.Exec(ctx, query,
+		h.ID,
+		h.UserID,
+		h.BookID,
+		h.Quote,
+		h.Page,
+		h.Location,
+		h.Comment,
+		h.IsPublic,
+		h.CreatedAt,
+		h.UpdatedAt,
+	)
+	if err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "highlights_user_id_book_id_fkey" {
+			return false, ErrBookNotFound
+		}
+		return false, fmt.Errorf("ImportHighlight: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// UpdateHighlight replaces the quote, position, comment and visibility of one
+// of the user's highlights.
+func (db *Client) UpdateHighlight(ctx context.Context, h *data.Highlight) (*data.Highlight, error) {
+	query := `
+		WITH h AS (
+			UPDATE highlights
+			SET quote = $3, page = $4, location = $5, comment = $6, is_public = $7, updated_at = $8
+			WHERE id = $1 AND user_id = $2
+			RETURNING *
+		)
+		SELECT ` + highlightColumns + ` FROM h` + highlightBookJoin
+	updated, err := scanHighlight(db.DB.QueryRow(ctx, query,
+		h.ID,
+		h.UserID,
+		h.Quote,
+		h.Page,
+		h.Location,
+		h.Comment,
+		h.IsPublic,
+		h.UpdatedAt,
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrHighlightNotFound
+		}
+		return nil, fmt.Errorf("UpdateHighlight: %w", err)
+	}
+	return updated, nil
+}
+
+func (db *Client) DeleteHighlight(ctx context.Context, userID, id string) error {
+	tag, err := db.DB.Exec(ctx, `DELETE FROM highlights WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return fmt.Errorf("DeleteHighlight: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrHighlightNotFound
+	}
+	return nil
+}
+
+// GetHighlight returns one of the user's highlights.
+func (db *Client) GetHighlight(ctx context.Context, userID, id string) (*data.Highlight, error) {
+	query := `SELECT ` + highlightColumns + ` FROM highlights h` + highlightBookJoin + ` WHERE h.id = $1 AND h.user_id = $2`
+	h, err := scanHighlight(db.DB.QueryRow(ctx, query, id, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrHighlightNotFound
+		}
+		return nil, fmt.Errorf("GetHighlight: %w", err)
+	}
+	return h, nil
+}
+
+// GetHighlights returns a page of the user's highlights, newest first, along
+// with the total number of them. An empty bookID lists highlights across the
+// whole library; publicOnly leaves out private highlights.
+func (db *Client) GetHighlights(ctx context.Context, userID, bookID string, publicOnly bool, page data.Page) ([]*data.Highlight, int32, error) {
+	const match = `
+		h.user_id = $1
+		AND ($2 = '' OR h.book_id = $2)
+		AND (NOT $3 OR h.is_public)
+	`
+
+	countQuery := `SELECT COUNT(*) FROM highlights h WHERE ` + match
+	var total int32
+	if err := db.DB.QueryRow(ctx, countQuery, userID, bookID, publicOnly).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("GetHighlights (count): %w", err)
+	}
+
+	query := `
+		SELECT ` + highlightColumns + ` FROM highlights h` + highlightBookJoin + `
+		WHERE ` + match + `
+		  AND ($6::timestamptz IS NULL OR (h.created_at, h.id) < ($6, $7::uuid))
+		ORDER BY h.created_at DESC, h.id DESC
+		LIMIT $4 OFFSET $5
+	`
+	afterTime, afterID := cursorArgs(page.After)
+	rows, err := db.DB.Query(ctx, query, userID, bookID, publicOnly, page.Limit, offset(page), afterTime, afterID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("GetHighlights: %w", err)
+	}
+	highlights, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*data.Highlight, error) {
+		return scanHighlight(row)
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("GetHighlights: %w", err)
+	}
+	return highlights, total, nil
+}
@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Highlights(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	start := time.Now().Add(-time.Hour).UTC().Truncate(time.Millisecond)
+	seedLibraryBook(t, client, user.ID, "OL456M", "Dune", "Frank Herbert", start)
+	seedLibraryBook(t, client, user.ID, "OL1M", "Piranesi", "Susanna Clarke", start)
+
+	highlight := func(bookID, quote string, public bool, at time.Time) *data.Highlight {
+		return &data.Highlight{
+			ID:        uuid.New().String(),
+			UserID:    user.ID,
+			BookID:    bookID,
+			Quote:     quote,
+			IsPublic:  public,
+			CreatedAt: at,
+			UpdatedAt: at,
+		}
+	}
+
+	litany, err := client.CreateHighlight(ctx, highlight("OL456M", "Fear is the mind-killer.", false, start))
+	require.NoError(t, err)
+	assert.Equal(t, "Dune", litany.BookTitle)
+	assert.Equal(t, "Frank Herbert", litany.AuthorName)
+
+	_, err = client.CreateHighlight(ctx, highlight("OL1M", "The Beauty of the House is immeasurable.", true, start.Add(time.Minute)))
+	require.NoError(t, err)
+
+	_, err = client.CreateHighlight(ctx, highlight("missing", "quote", false, start))
+	require.ErrorIs(t, err, ErrBookNotFound)
+
+	// Importing the same quote again is a no-op.
+	added, err := client.ImportHighlight(ctx, highlight("OL456M", "Fear is the mind-killer.", false, start))
+	require.NoError(t, err)
+	assert.False(t, added)
+	added, err = client.ImportHighlight(ctx, highlight("OL456M", "The spice must flow.", false, start.Add(2*time.Minute)))
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	all, total, err := client.GetHighlights(ctx, user.ID, "", false, data.Page{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), total)
+	require.Len(t, all, 2)
+	assert.Equal(t, "The spice must flow.", all[0].Quote)
+
+	rest, _, err := client.GetHighlights(ctx, user.ID, "", false, data.Page{
+		Limit: 2,
+		After: &data.Cursor{Time: all[1].CreatedAt, ID: all[1].ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, rest, 1)
+	assert.Equal(t, litany.ID, rest[0].ID)
+
+	dune, total, err := client.GetHighlights(ctx, user.ID, "OL456M", false, data.Page{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), total)
+	assert.Len(t, dune, 2)
+
+	public, total, err := client.GetHighlights(ctx, user.ID, "", true, data.Page{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), total)
+	require.Len(t, public, 1)
+	assert.Equal(t, "Piranesi", public[0].BookTitle)
+
+	litany.Comment = "The litany against fear"
+	litany.IsPublic = true
+	litany.UpdatedAt = time.Now()
+	updated, err := client.UpdateHighlight(ctx, litany)
+	require.NoError(t, err)
+	assert.Equal(t, "The litany against fear", updated.Comment)
+	assert.True(t, updated.IsPublic)
+
+	other := seedUser(t, client)
+	_, err = client.GetHighlight(ctx, other.ID, litany.ID)
+	require.ErrorIs(t, err, ErrHighlightNotFound)
+	require.ErrorIs(t, client.DeleteHighlight(ctx, other.ID, litany.ID), ErrHighlightNotFound)
+
+	// Sharing keeps the quote on the post after the highlight is deleted.
+	post, err := client.CreatePost(ctx, &data.Post{
+		ID:          uuid.New().String(),
+		UserID:      user.ID,
+		Book:        data.BookDetails{ID: "OL456M", Title: "Dune", Author: "Frank Herbert"},
+		Description: "The best line in the book",
+		HighlightID: litany.ID,
+		Quote:       litany.Quote,
+		CreatedAt:   start,
+		UpdatedAt:   start,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, litany.ID, post.HighlightID)
+
+	require.NoError(t, client.DeleteHighlight(ctx, user.ID, litany.ID))
+	posts, _, err := client.GetUserFeed(ctx, user.ID, data.Page{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, posts, 1)
+	assert.Empty(t, posts[0].HighlightID)
+	assert.Equal(t, "Fear is the mind-killer.", posts[0].Quote)
+
+	// Removing a book from the library removes its highlights.
+	require.NoError(t, client.RemoveLibraryBook(ctx, user.ID, "OL1M"))
+	_, total, err = client.GetHighlights(ctx, user.ID, "OL1M", false, data.Page{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int32(0), total)
+}
+
+func TestClient_FindLibraryBookByTitle(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	now := time.Now()
+	seedLibraryBook(t, client, user.ID, "OL1M", "The Shining", "Someone Else", now)
+	seedLibraryBook(t, client, user.ID, "OL2M", "The Shining", "Stephen King", now.Add(-time.Hour))
+
+	book, err := client.FindLibraryBookByTitle(ctx, user.ID, "the shining", "stephen king")
+	require.NoError(t, err)
+	assert.Equal(t, "OL2M", book.BookID)
+
+	book, err = client.FindLibraryBookByTitle(ctx, user.ID, "THE SHINING", "")
+	require.NoError(t, err)
+	assert.Equal(t, "OL1M", book.BookID)
+
+	_, err = client.FindLibraryBookByTitle(ctx, user.ID, "Carrie", "Stephen King")
+	require.ErrorIs(t, err, ErrBookNotFound)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/celestialdragonfly/betterreads/internal/data"
@@ -128,29 +129,97 @@ func (db *Client) GetUserShelves(ctx context.Context, userID string) ([]*data.Sh
 	return shelves, nil
 }
 
-func (db *Client) GetShelfBooks(ctx context.Context, userID, shelfID string) ([]*data.LibraryBook, error) {
-	// Use CTE to avoid double-joining shelf_books table
+// shelfSort is the ORDER BY clause for a shelf sort order and the matching
+// keyset condition, which reads the cursor from $5 onwards. book_id breaks ties
+// so that pages are stable.
+type shelfSort struct {
+	orderBy string
+	after   string
+	args    func(c *data.Cursor) []any
+}
+
+// Cursors into a shelf carry the title and author of the last book as Keys, in
+// that order; see libraryBookCursor in the server package.
+var shelfSorts = map[data.ShelfSortOrder]shelfSort{
+	data.ShelfSortOrderTitleAsc: {
+		orderBy: "lower(b.title) ASC, lb.book_id ASC",
+		after:   "(lower(b.title), lb.book_id) > (lower($5::text), $6::text)",
+		args:    func(c *data.Cursor) []any { return []any{c.Keys[0], c.ID} },
+	},
+	data.ShelfSortOrderTitleDesc: {
+		orderBy: "lower(b.title) DESC, lb.book_id DESC",
+		after:   "(lower(b.title), lb.book_id) < (lower($5::text), $6::text)",
+		args:    func(c *data.Cursor) []any { return []any{c.Keys[0], c.ID} },
+	},
+	data.ShelfSortOrderAuthorAsc: {
+		orderBy: "lower(b.author_name) ASC, lower(b.title) ASC, lb.book_id ASC",
+		after:   "(lower(b.author_name), lower(b.title), lb.book_id) > (lower($5::text), lower($6::text), $7::text)",
+		args:    func(c *data.Cursor) []any { return []any{c.Keys[1], c.Keys[0], c.ID} },
+	},
+	data.ShelfSortOrderAuthorDesc: {
+		orderBy: "lower(b.author_name) DESC, lower(b.title) DESC, lb.book_id DESC",
+		after:   "(lower(b.author_name), lower(b.title), lb.book_id) < (lower($5::text), lower($6::text), $7::text)",
+		args:    func(c *data.Cursor) []any { return []any{c.Keys[1], c.Keys[0], c.ID} },
+	},
+	data.ShelfSortOrderDateAddedAsc: {
+		orderBy: "lb.added_at ASC, lb.book_id ASC",
+		after:   "(lb.added_at, lb.book_id) > ($5::timestamptz, $6::text)",
+		args:    func(c *data.Cursor) []any { return []any{c.Time, c.ID} },
+	},
+	data.ShelfSortOrderDateAddedDesc: {
+		orderBy: "lb.added_at DESC, lb.book_id DESC",
+		after:   "(lb.added_at, lb.book_id) < ($5::timestamptz, $6::text)",
+		args:    func(c *data.Cursor) []any { return []any{c.Time, c.ID} },
+	},
+}
+
+// GetShelfBooks returns one page of the books on a shelf in the requested
+// order, along with the total number of books on the shelf. An unspecified
+// order lists the most recently added books first.
+func (db *Client) GetShelfBooks(
+	ctx context.Context,
+	userID, shelfID string,
+	sort data.ShelfSortOrder,
+	page data.Page,
+) ([]*data.LibraryBook, int32, error) {
+	order, ok := shelfSorts[sort]
+	if !ok {
+		order = shelfSorts[data.ShelfSortOrderDateAddedDesc]
+	}
+
+	countQuery := `SELECT COUNT(*) FROM shelf_books WHERE shelf_id = $1 AND user_id = $2`
+	var total int32
+	if err := db.DB.QueryRow(ctx, countQuery, shelfID, userID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("GetShelfBooks (count): %w", err)
+	}
+
+	args := []any{shelfID, userID, page.Limit, offset(page)}
+	after := ""
+	if page.After != nil {
+		if len(page.After.Keys) < 2 { //nolint: mnd // title and author
+			return nil, 0, ErrInvalidCursor
+		}
+		after = "AND " + order.after
+		args = append(args, order.args(page.After)...)
+	}
+
+	// The ORDER BY and keyset clauses come from shelfSorts, never from the caller.
 	query := `
-		WITH shelf_book_ids AS (
-			SELECT book_id
-			FROM shelf_books
-			WHERE shelf_id = $1 AND user_id = $2
-		)
-		SELECT lb.user_id, lb.book_id, lb.title, lb.author_name, lb.book_image, lb.rating, lb.source, lb.reading_status, lb.added_at, lb.updated_at,
-			   COALESCE(array_agg(sb.shelf_id) FILTER (WHERE sb.shelf_id IS NOT NULL), '{}') as shelf_ids
-		FROM library_books lb
-		INNER JOIN shelf_book_ids sbi ON lb.book_id = sbi.book_id
-		LEFT JOIN shelf_books sb ON lb.book_id = sb.book_id AND lb.user_id = sb.user_id
-		WHERE lb.user_id = $2
-		GROUP BY lb.user_id, lb.book_id, lb.title, lb.author_name, lb.book_image, lb.rating, lb.source, lb.reading_status, lb.added_at, lb.updated_at
-		ORDER BY lb.added_at DESC
+		SELECT ` + libraryBookColumns + `
+		FROM shelf_books s
+		INNER JOIN library_books lb ON lb.user_id = s.user_id AND lb.book_id = s.book_id
+		` + catalogJoin + `
+		WHERE s.shelf_id = $1 AND s.user_id = $2
+		` + after + `
+		ORDER BY ` + order.orderBy + `
+		LIMIT $3 OFFSET $4
 	`
 
-	books, err := db.queryLibraryBooks(ctx, query, shelfID, userID)
+	books, err := db.queryLibraryBooks(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("GetShelfBooks: %w", err)
+		return nil, 0, fmt.Errorf("GetShelfBooks: %w", err)
 	}
-	return books, nil
+	return books, total, nil
 }
 
 // Library Book operations
@@ -167,17 +236,34 @@ func (db *Client) UpdateLibraryBook(ctx context.Context, book *data.LibraryBook)
 		}
 	}(ctx, tx)
 
+	// Capture the state being replaced so a change can be announced as an
+	// activity post.
+	previous, err := lockLibraryBookState(ctx, tx, book.UserID, book.BookID)
+	if err != nil {
+		return fmt.Errorf("UpdateLibraryBook: %w", err)
+	}
+
+	// The title, author, image and ISBN come from the catalog, so that the
+	// activity post below describes the book as everyone else sees it.
+	if err := catalogBook(ctx, tx, book); err != nil {
+		return fmt.Errorf("UpdateLibraryBook: %w", err)
+	}
+
 	// Upsert book
 	query := `
-		INSERT INTO library_books (user_id, book_id, title, author_name, book_image, rating, source, reading_status, added_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		INSERT INTO library_books (
+			user_id, book_id, rating, source, reading_status, added_at, updated_at,
+			review, review_has_spoilers, notes, tags
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		ON CONFLICT (user_id, book_id) DO UPDATE
-		SET title = EXCLUDED.title,
-			author_name = EXCLUDED.author_name,
-			book_image = EXCLUDED.book_image,
-			rating = EXCLUDED.rating,
+		SET rating = EXCLUDED.rating,
 			source = EXCLUDED.source,
 			reading_status = EXCLUDED.reading_status,
+			review = CASE WHEN $12 THEN library_books.review ELSE EXCLUDED.review END,
+			review_has_spoilers = CASE WHEN $13 THEN library_books.review_has_spoilers ELSE EXCLUDED.review_has_spoilers END,
+			notes = CASE WHEN $14 THEN library_books.notes ELSE EXCLUDED.notes END,
+			tags = CASE WHEN $15 THEN library_books.tags ELSE EXCLUDED.tags END,
 			updated_at = EXCLUDED.updated_at
 	`
 	_, err = tx.Exec(
@@ -185,14 +271,19 @@ func (db *Client) UpdateLibraryBook(ctx context.Context, book *data.LibraryBook)
 		query,
 		book.UserID,
 		book.BookID,
-		book.Title,
-		book.AuthorName,
-		book.BookImage,
 		book.Rating,
 		book.Source,
 		book.ReadingStatus,
 		book.AddedAt,
 		book.UpdatedAt,
+		book.Review,
+		book.ReviewHasSpoilers,
+		book.Notes,
+		nonNil(book.Tags),
+		book.Keep&data.FieldReview != 0,
+		book.Keep&data.FieldReviewHasSpoilers != 0,
+		book.Keep&data.FieldNotes != 0,
+		book.Keep&data.FieldTags != 0,
 	)
 	if err != nil {
 		return fmt.Errorf("UpdateLibraryBook: %w", err)
@@ -215,6 +306,14 @@ func (db *Client) UpdateLibraryBook(ctx context.Context, book *data.LibraryBook)
 		}
 	}
 
+	if err := syncReadingSession(ctx, tx, previous, book, book.UpdatedAt); err != nil {
+		return fmt.Errorf("UpdateLibraryBook: %w", err)
+	}
+
+	if err := createActivityPost(ctx, tx, previous, book); err != nil {
+		return fmt.Errorf("UpdateLibraryBook (activity post): %w", err)
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("committing transaction: %w", err)
 	}
@@ -222,6 +321,168 @@ func (db *Client) UpdateLibraryBook(ctx context.Context, book *data.LibraryBook)
 	return nil
 }
 
+// ImportLibraryBook adds book to the library unless the user already has it,
+// and reports whether it was added. Unlike UpdateLibraryBook it never
+// overwrites an existing entry and does not create activity posts, so
+// importing the same export twice is harmless. A book the import matched to
+// an Open Library edition shares its catalog entry, as if shelved with
+// UpdateLibraryBook; any other has an entry of the user's own. The newShelves
+// the book is also put on are created with it, and only if it is added; each
+// gets the id of the user's shelf by that name when one appeared in the
+// meantime.
+func (db *Client) ImportLibraryBook(ctx context.Context, book *data.LibraryBook, newShelves []*data.Shelf) (bool, error) {
+	tx, err := db.DB.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ImportLibraryBook: failed to start transaction: %w", err)
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			logger.Error("ImportLibraryBook: failed to rollback transaction", "book_id", book.BookID, "error", rollbackErr)
+		}
+	}()
+
+	addCatalogBook := importCatalogBook
+	if book.Source == data.BookSourceOpenLibrary {
+		addCatalogBook = catalogBook
+	}
+	if err := addCatalogBook(ctx, tx, book); err != nil {
+		return false, fmt.Errorf("ImportLibraryBook: %w", err)
+	}
+
+	query := `
+		INSERT INTO library_books (
+			user_id, book_id, rating, source, reading_status, added_at, updated_at,
+			review, review_has_spoilers, notes, tags
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (user_id, book_id) DO NOTHING
+	`
+	tag, err := tx.Exec(
+		ctx,
+		query,
+		book.UserID,
+		book.BookID,
+		book.Rating,
+		book.Source,
+		book.ReadingStatus,
+		book.AddedAt,
+		book.UpdatedAt,
+		book.Review,
+		book.ReviewHasSpoilers,
+		book.Notes,
+		nonNil(book.Tags),
+	)
+	if err != nil {
+		return false, fmt.Errorf("ImportLibraryBook: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	shelfQuery := `
+		INSERT INTO shelves (id, name, user_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, name) DO UPDATE SET name = EXCLUDED.name
+		RETURNING id
+	`
+	shelfIDs := book.ShelfIDs
+	for _, shelf := range newShelves {
+		err := tx.QueryRow(ctx, shelfQuery, shelf.ID, shelf.Name, shelf.UserID, shelf.CreatedAt, shelf.UpdatedAt).Scan(&shelf.ID)
+		if err != nil {
+			return false, fmt.Errorf("ImportLibraryBook (create shelf): %w", err)
+		}
+		shelfIDs = append(shelfIDs, shelf.ID)
+	}
+
+	insertQuery := `INSERT INTO shelf_books (shelf_id, user_id, book_id, added_at) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`
+	for _, shelfID := range shelfIDs {
+		if _, err := tx.Exec(ctx, insertQuery, shelfID, book.UserID, book.BookID, book.AddedAt); err != nil {
+			pgErr := &pgconn.PgError{}
+			if errors.As(err, &pgErr) && pgErr.ConstraintName == "shelf_books_shelf_id_fkey" {
+				return false, ErrShelfNotFound
+			}
+			return false, fmt.Errorf("ImportLibraryBook (add shelf): %w", err)
+		}
+	}
+
+	if err := insertReadingSessions(ctx, tx, book.Sessions); err != nil {
+		return false, fmt.Errorf("ImportLibraryBook: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("ImportLibraryBook: committing transaction: %w", err)
+	}
+	return true, nil
+}
+
+// GetExistingLibraryBookIDs returns which of bookIDs are already in the
+// user's library, so an import can be previewed without writing anything.
+func (db *Client) GetExistingLibraryBookIDs(ctx context.Context, userID string, bookIDs []string) ([]string, error) {
+	query := `SELECT book_id FROM library_books WHERE user_id = $1 AND book_id = ANY($2)`
+	rows, err := db.DB.Query(ctx, query, userID, bookIDs)
+	if err != nil {
+		return nil, fmt.Errorf("GetExistingLibraryBookIDs: %w", err)
+	}
+	existing, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("GetExistingLibraryBookIDs: %w", err)
+	}
+	return existing, nil
+}
+
+// FindLibraryBookByTitle returns the book in the user's library whose title
+// matches case-insensitively, preferring one by author when several do.
+func (db *Client) FindLibraryBookByTitle(ctx context.Context, userID, title, author string) (*data.LibraryBook, error) {
+	query := `
+		SELECT ` + libraryBookColumns + `
+		FROM library_books lb
+		` + catalogJoin + `
+		WHERE lb.user_id = $1 AND lower(b.title) = lower($2)
+		ORDER BY lower(b.author_name) = lower($3) DESC, lb.added_at DESC, lb.book_id DESC
+		LIMIT 1
+	`
+	book, err := scanLibraryBook(db.DB.QueryRow(ctx, query, userID, title, author))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBookNotFound
+		}
+		return nil, fmt.Errorf("FindLibraryBookByTitle: %w", err)
+	}
+	return book, nil
+}
+
+// FindLibraryWorks returns which of the given works from source are in the
+// user's library, mapping each work key found to the library's book id for
+// it. When the library has several editions of a work the most recently added
+// is used.
+func (db *Client) FindLibraryWorks(ctx context.Context, userID string, source int32, workKeys []string) (map[string]string, error) {
+	query := `
+		SELECT DISTINCT ON (b.work_key) b.work_key, lb.book_id
+		FROM library_books lb
+		` + catalogJoin + `
+		WHERE lb.user_id = $1 AND lb.source = $2 AND b.work_key = ANY($3)
+		ORDER BY b.work_key, lb.added_at DESC
+	`
+	rows, err := db.DB.Query(ctx, query, userID, source, workKeys)
+	if err != nil {
+		return nil, fmt.Errorf("FindLibraryWorks: %w", err)
+	}
+	defer rows.Close()
+
+	works := make(map[string]string)
+	for rows.Next() {
+		var workKey, bookID string
+		if err := rows.Scan(&workKey, &bookID); err != nil {
+			return nil, fmt.Errorf("FindLibraryWorks: %w", err)
+		}
+		works[workKey] = bookID
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("FindLibraryWorks: %w", err)
+	}
+	return works, nil
+}
+
 func (db *Client) RemoveLibraryBook(ctx context.Context, userID, bookID string) error {
 	query := `DELETE FROM library_books WHERE user_id = $1 AND book_id = $2`
 	result, err := db.DB.Exec(ctx, query, userID, bookID)
@@ -234,21 +495,80 @@ func (db *Client) RemoveLibraryBook(ctx context.Context, userID, bookID string)
 	return nil
 }
 
-func (db *Client) GetUserLibrary(ctx context.Context, userID string) ([]*data.LibraryBook, error) {
+// GetUserLibrary returns one page of the user's library, most recently added
+// first, along with the total number of books in the library.
+func (db *Client) GetUserLibrary(ctx context.Context, userID string, page data.Page) ([]*data.LibraryBook, int32, error) {
+	countQuery := `SELECT COUNT(*) FROM library_books WHERE user_id = $1`
+	var total int32
+	if err := db.DB.QueryRow(ctx, countQuery, userID).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("GetUserLibrary (count): %w", err)
+	}
+
 	query := `
-		SELECT lb.user_id, lb.book_id, lb.title, lb.author_name, lb.book_image, lb.rating, lb.source, lb.reading_status, lb.added_at, lb.updated_at,
-			   COALESCE(array_agg(sb.shelf_id) FILTER (WHERE sb.shelf_id IS NOT NULL), '{}') as shelf_ids
+		SELECT ` + libraryBookColumns + `
 		FROM library_books lb
-		LEFT JOIN shelf_books sb ON lb.book_id = sb.book_id AND lb.user_id = sb.user_id
+		` + catalogJoin + `
 		WHERE lb.user_id = $1
-		GROUP BY lb.user_id, lb.book_id
-		ORDER BY lb.added_at DESC
+		  AND ($4::timestamptz IS NULL OR (lb.added_at, lb.book_id) < ($4, $5::text))
+		ORDER BY lb.added_at DESC, lb.book_id DESC
+		LIMIT $2 OFFSET $3
 	`
-	books, err := db.queryLibraryBooks(ctx, query, userID)
+	afterTime, afterID := cursorArgs(page.After)
+	books, err := db.queryLibraryBooks(ctx, query, userID, page.Limit, offset(page), afterTime, afterID)
 	if err != nil {
-		return nil, fmt.Errorf("GetUserLibrary: %w", err)
+		return nil, 0, fmt.Errorf("GetUserLibrary: %w", err)
 	}
-	return books, nil
+	return books, total, nil
+}
+
+// SearchLibrary returns one page of the books in the user's library that
+// match search, most recently added first, along with the number that match.
+// The query is matched as a case-insensitive substring.
+func (db *Client) SearchLibrary(ctx context.Context, userID string, search data.LibrarySearch, page data.Page) ([]*data.LibraryBook, int32, error) {
+	const match = `
+		lb.user_id = $1
+		AND ($2 = '' OR b.title ILIKE $2 OR b.author_name ILIKE $2 OR lb.review ILIKE $2 OR lb.notes ILIKE $2
+			OR EXISTS (SELECT 1 FROM unnest(lb.tags) AS t WHERE t ILIKE $2))
+		AND ($3 = '' OR lb.tags @> ARRAY[$3])
+	`
+	pattern := ""
+	if search.Query != "" {
+		pattern = "%" + likeEscaper.Replace(search.Query) + "%"
+	}
+
+	countQuery := `SELECT COUNT(*) FROM library_books lb ` + catalogJoin + ` WHERE ` + match
+	var total int32
+	if err := db.DB.QueryRow(ctx, countQuery, userID, pattern, search.Tag).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("SearchLibrary (count): %w", err)
+	}
+
+	query := `
+		SELECT ` + libraryBookColumns + `
+		FROM library_books lb
+		` + catalogJoin + `
+		WHERE ` + match + `
+		  AND ($6::timestamptz IS NULL OR (lb.added_at, lb.book_id) < ($6, $7::text))
+		ORDER BY lb.added_at DESC, lb.book_id DESC
+		LIMIT $4 OFFSET $5
+	`
+	afterTime, afterID := cursorArgs(page.After)
+	books, err := db.queryLibraryBooks(ctx, query, userID, pattern, search.Tag, page.Limit, offset(page), afterTime, afterID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("SearchLibrary: %w", err)
+	}
+	return books, total, nil
+}
+
+// likeEscaper escapes the LIKE wildcards in text matched literally.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// nonNil returns s, or an empty slice when s is nil, for NOT NULL array
+// columns.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
 }
 
 func (db *Client) AddBookToShelf(ctx context.Context, userID, bookID, shelfID string) error {
@@ -298,82 +618,55 @@ func (db *Client) queryLibraryBooks(ctx context.Context, query string, args ...a
 
 	var books []*data.LibraryBook
 	for rows.Next() {
-		var b data.LibraryBook
-		if err := rows.Scan(
-			&b.UserID,
-			&b.BookID,
-			&b.Title,
-			&b.AuthorName,
-			&b.BookImage,
-			&b.Rating,
-			&b.Source,
-			&b.ReadingStatus,
-			&b.AddedAt,
-			&b.UpdatedAt,
-			&b.ShelfIDs,
-		); err != nil {
-			return nil, fmt.Errorf("scan library book: %w", err)
+		b, err := scanLibraryBook(rows)
+		if err != nil {
+			return nil, err
 		}
-		books = append(books, &b)
+		books = append(books, b)
 	}
 	return books, nil
 }
 
-func registerLibrary(ctx context.Context, db *pgx.Conn) error {
-	createShelvesTable := `
-	CREATE TABLE IF NOT EXISTS shelves (
-		id UUID PRIMARY KEY,
-		name TEXT NOT NULL,
-		user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
-		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-		UNIQUE(user_id, name)
-	);
-	`
-	if _, err := db.Exec(ctx, createShelvesTable); err != nil {
-		return fmt.Errorf("failed to create shelves table: %w", err)
-	}
-
-	createLibraryBooksTable := `
-	CREATE TABLE IF NOT EXISTS library_books (
-		user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
-		book_id TEXT NOT NULL,
-		title TEXT NOT NULL,
-		author_name TEXT NOT NULL,
-		book_image TEXT,
-		rating INTEGER,
-		source INTEGER,
-		reading_status INTEGER NOT NULL DEFAULT 1,
-		added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-		updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-		PRIMARY KEY (user_id, book_id)
-	);
-	`
-	if _, err := db.Exec(ctx, createLibraryBooksTable); err != nil {
-		return fmt.Errorf("failed to create library_books table: %w", err)
-	}
-
-	createShelfBooksTable := `
-	CREATE TABLE IF NOT EXISTS shelf_books (
-		shelf_id UUID NOT NULL REFERENCES shelves(id) ON DELETE CASCADE,
-		user_id UUID NOT NULL,
-		book_id TEXT NOT NULL,
-		added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-		PRIMARY KEY (shelf_id, book_id),
-		FOREIGN KEY (user_id, book_id) REFERENCES library_books(user_id, book_id) ON DELETE CASCADE
-	);
-	`
-	if _, err := db.Exec(ctx, createShelfBooksTable); err != nil {
-		return fmt.Errorf("failed to create shelf_books table: %w", err)
-	}
-
-	// Migrations (run after table creation to ensure tables exist)
-	if _, err := db.Exec(ctx, "ALTER TABLE shelves DROP COLUMN IF EXISTS is_default;"); err != nil {
-		return fmt.Errorf("failed to drop is_default from shelves: %w", err)
+// libraryBookColumns is the column list scanned by scanLibraryBook. It needs
+// the catalog joined as b; see catalogJoin.
+const libraryBookColumns = `
+	lb.user_id, lb.book_id, b.title, b.author_name, b.book_image, b.isbn, lb.rating, lb.source, lb.reading_status, lb.added_at, lb.updated_at,
+	lb.review, lb.review_has_spoilers, lb.notes, lb.tags,
+	ARRAY(
+		SELECT sb.shelf_id
+		FROM shelf_books sb
+		WHERE sb.user_id = lb.user_id AND sb.book_id = lb.book_id
+	) AS shelf_ids,
+` + readingSessionsColumn
+
+// scanLibraryBook scans libraryBookColumns, followed by any extra columns
+// into extra.
+func scanLibraryBook(row pgx.Row, extra ...any) (*data.LibraryBook, error) {
+	var b data.LibraryBook
+	var sessions []byte
+	if err := row.Scan(append([]any{
+		&b.UserID,
+		&b.BookID,
+		&b.Title,
+		&b.AuthorName,
+		&b.BookImage,
+		&b.ISBN,
+		&b.Rating,
+		&b.Source,
+		&b.ReadingStatus,
+		&b.AddedAt,
+		&b.UpdatedAt,
+		&b.Review,
+		&b.ReviewHasSpoilers,
+		&b.Notes,
+		&b.Tags,
+		&b.ShelfIDs,
+		&sessions,
+	}, extra...)...); err != nil {
+		return nil, fmt.Errorf("scan library book: %w", err)
 	}
-	if _, err := db.Exec(ctx, "ALTER TABLE library_books ADD COLUMN IF NOT EXISTS reading_status INTEGER NOT NULL DEFAULT 1;"); err != nil {
-		return fmt.Errorf("failed to add reading_status to library_books: %w", err)
+	if err := decodeReadingSessions(&b, sessions); err != nil {
+		return nil, err
 	}
-
-	return nil
+	return &b, nil
 }
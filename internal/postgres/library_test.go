@@ -0,0 +1,426 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetShelfBooks_SortAndPage(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	shelf := seedShelf(t, client, user.ID, "Favourites")
+	other := seedShelf(t, client, user.ID, "Classics")
+
+	base := time.Now().Add(-time.Hour)
+	seedLibraryBook(t, client, user.ID, "OL1M", "Dune", "Frank Herbert", base, shelf.ID, other.ID)
+	seedLibraryBook(t, client, user.ID, "OL2M", "foundation", "Isaac Asimov", base.Add(time.Minute), shelf.ID)
+	seedLibraryBook(t, client, user.ID, "OL3M", "Hyperion", "Dan Simmons", base.Add(2*time.Minute), shelf.ID)
+	seedLibraryBook(t, client, user.ID, "OL4M", "Neuromancer", "William Gibson", base.Add(3*time.Minute))
+
+	bookIDs := func(books []*data.LibraryBook) []string {
+		ids := make([]string, 0, len(books))
+		for _, b := range books {
+			ids = append(ids, b.BookID)
+		}
+		return ids
+	}
+
+	tests := []struct {
+		name   string
+		sort   data.ShelfSortOrder
+		limit  int32
+		offset int32
+		want   []string
+	}{
+		{name: "default is newest first", sort: data.ShelfSortOrderUnspecified, limit: 10, want: []string{"OL3M", "OL2M", "OL1M"}},
+		{name: "title ascending ignores case", sort: data.ShelfSortOrderTitleAsc, limit: 10, want: []string{"OL1M", "OL2M", "OL3M"}},
+		{name: "title descending", sort: data.ShelfSortOrderTitleDesc, limit: 10, want: []string{"OL3M", "OL2M", "OL1M"}},
+		{name: "author ascending", sort: data.ShelfSortOrderAuthorAsc, limit: 10, want: []string{"OL3M", "OL1M", "OL2M"}},
+		{name: "author descending", sort: data.ShelfSortOrderAuthorDesc, limit: 10, want: []string{"OL2M", "OL1M", "OL3M"}},
+		{name: "date added ascending", sort: data.ShelfSortOrderDateAddedAsc, limit: 10, want: []string{"OL1M", "OL2M", "OL3M"}},
+		{name: "second page", sort: data.ShelfSortOrderTitleAsc, limit: 2, offset: 2, want: []string{"OL3M"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			books, total, err := client.GetShelfBooks(ctx, user.ID, shelf.ID, tt.sort, data.Page{Limit: tt.limit, Offset: tt.offset})
+			require.NoError(t, err)
+			assert.Equal(t, int32(3), total)
+			assert.Equal(t, tt.want, bookIDs(books))
+		})
+	}
+
+	// Walking a shelf one book at a time by cursor must visit the same books in
+	// the same order as a single offset page.
+	for sort := data.ShelfSortOrderUnspecified; sort <= data.ShelfSortOrderDateAddedDesc; sort++ {
+		want, _, err := client.GetShelfBooks(ctx, user.ID, shelf.ID, sort, data.Page{Limit: 10})
+		require.NoError(t, err)
+
+		var got []*data.LibraryBook
+		page := data.Page{Limit: 1}
+		for {
+			books, _, err := client.GetShelfBooks(ctx, user.ID, shelf.ID, sort, page)
+			require.NoError(t, err)
+			if len(books) == 0 {
+				break
+			}
+			got = append(got, books...)
+			last := books[len(books)-1]
+			page.After = &data.Cursor{Time: last.AddedAt, Keys: []string{last.Title, last.AuthorName}, ID: last.BookID}
+		}
+		assert.Equal(t, bookIDs(want), bookIDs(got), "sort order %d", sort)
+	}
+
+	books, _, err := client.GetShelfBooks(ctx, user.ID, shelf.ID, data.ShelfSortOrderTitleAsc, data.Page{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, books, 1)
+	assert.ElementsMatch(t, []string{shelf.ID, other.ID}, books[0].ShelfIDs)
+}
+
+func TestClient_GetUserLibrary_Page(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	shelf := seedShelf(t, client, user.ID, "Favourites")
+
+	base := time.Now().Add(-time.Hour)
+	seedLibraryBook(t, client, user.ID, "OL1M", "Dune", "Frank Herbert", base, shelf.ID)
+	seedLibraryBook(t, client, user.ID, "OL2M", "Foundation", "Isaac Asimov", base.Add(time.Minute))
+	seedLibraryBook(t, client, user.ID, "OL3M", "Hyperion", "Dan Simmons", base.Add(2*time.Minute))
+
+	books, total, err := client.GetUserLibrary(ctx, user.ID, data.Page{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), total)
+	require.Len(t, books, 2)
+	assert.Equal(t, "OL3M", books[0].BookID)
+	assert.Equal(t, "OL2M", books[1].BookID)
+	assert.Empty(t, books[1].ShelfIDs)
+
+	books, total, err = client.GetUserLibrary(ctx, user.ID, data.Page{Limit: 2, Offset: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), total)
+	require.Len(t, books, 1)
+	assert.Equal(t, "OL1M", books[0].BookID)
+	assert.Equal(t, []string{shelf.ID}, books[0].ShelfIDs)
+
+	// A book added while the client scrolls does not shift a cursor page.
+	after := &data.Cursor{Time: base.Add(time.Minute), ID: "OL2M"}
+	seedLibraryBook(t, client, user.ID, "OL5M", "Ubik", "Philip K. Dick", time.Now())
+	books, total, err = client.GetUserLibrary(ctx, user.ID, data.Page{Limit: 2, After: after})
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), total)
+	require.Len(t, books, 1)
+	assert.Equal(t, "OL1M", books[0].BookID)
+}
+
+func TestClient_SearchLibrary(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+
+	base := time.Now().Add(-time.Hour)
+	update := func(bookID, title, author, review, notes string, addedAt time.Time, tags ...string) {
+		t.Helper()
+		require.NoError(t, client.UpdateLibraryBook(ctx, &data.LibraryBook{
+			UserID:        user.ID,
+			BookID:        bookID,
+			Title:         title,
+			AuthorName:    author,
+			Source:        data.BookSourceOpenLibrary,
+			ReadingStatus: data.ReadingStatusWantToRead,
+			Review:        review,
+			Notes:         notes,
+			Tags:          tags,
+			AddedAt:       addedAt,
+			UpdatedAt:     addedAt,
+		}))
+	}
+	update("OL1M", "Dune", "Frank Herbert", "Sand and spice", "", base, "sci-fi", "desert")
+	update("OL2M", "Emma", "Jane Austen", "", "Gift from 100% of my book club", base.Add(time.Minute))
+	update("OL3M", "Hyperion", "Dan Simmons", "", "", base.Add(2*time.Minute), "sci-fi")
+
+	search := func(query, tag string) []string {
+		t.Helper()
+		books, total, err := client.SearchLibrary(ctx, user.ID, data.LibrarySearch{Query: query, Tag: tag}, data.Page{Limit: 10})
+		require.NoError(t, err)
+		ids := make([]string, 0, len(books))
+		for _, b := range books {
+			ids = append(ids, b.BookID)
+		}
+		assert.Len(t, ids, int(total))
+		return ids
+	}
+
+	assert.Equal(t, []string{"OL1M"}, search("SPICE", ""))
+	assert.Equal(t, []string{"OL1M"}, search("herb", ""))
+	assert.Equal(t, []string{"OL1M"}, search("deser", ""))
+	assert.Equal(t, []string{"OL2M"}, search("100%", ""))
+	assert.Empty(t, search("1000", ""))
+	assert.Equal(t, []string{"OL3M", "OL1M"}, search("", "sci-fi"))
+	assert.Equal(t, []string{"OL3M"}, search("hyper", "sci-fi"))
+
+	books, _, err := client.SearchLibrary(ctx, user.ID, data.LibrarySearch{Tag: "desert"}, data.Page{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, books, 1)
+	assert.Equal(t, "Sand and spice", books[0].Review)
+	assert.Equal(t, []string{"sci-fi", "desert"}, books[0].Tags)
+
+	// Updating without tags clears them.
+	update("OL1M", "Dune", "Frank Herbert", "", "", base)
+	assert.Empty(t, search("", "desert"))
+}
+
+func TestClient_UpdateLibraryBook_Keep(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	now := time.Now()
+
+	book := &data.LibraryBook{
+		UserID:            user.ID,
+		BookID:            "OL456M",
+		Title:             "Dune",
+		AuthorName:        "Frank Herbert",
+		Source:            data.BookSourceOpenLibrary,
+		ReadingStatus:     data.ReadingStatusReading,
+		Review:            "Sand and spice",
+		ReviewHasSpoilers: true,
+		Notes:             "Lent to Sam",
+		Tags:              []string{"sci-fi"},
+		AddedAt:           now,
+		UpdatedAt:         now,
+	}
+	require.NoError(t, client.UpdateLibraryBook(ctx, book))
+
+	// A status change from a client that does not send the user's text
+	// leaves it as it was.
+	require.NoError(t, client.UpdateLibraryBook(ctx, &data.LibraryBook{
+		UserID:        user.ID,
+		BookID:        "OL456M",
+		Source:        data.BookSourceOpenLibrary,
+		ReadingStatus: data.ReadingStatusRead,
+		Keep:          data.FieldReview | data.FieldReviewHasSpoilers | data.FieldNotes | data.FieldTags,
+		AddedAt:       now,
+		UpdatedAt:     now.Add(time.Minute),
+	}))
+
+	books, _, err := client.GetUserLibrary(ctx, user.ID, data.Page{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, books, 1)
+	assert.Equal(t, data.ReadingStatusRead, books[0].ReadingStatus)
+	assert.Equal(t, "Sand and spice", books[0].Review)
+	assert.True(t, books[0].ReviewHasSpoilers)
+	assert.Equal(t, "Lent to Sam", books[0].Notes)
+	assert.Equal(t, []string{"sci-fi"}, books[0].Tags)
+}
+
+func TestClient_ImportLibraryBook(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	shelf := seedShelf(t, client, user.ID, "Imported")
+
+	addedAt := time.Date(2012, 4, 20, 0, 0, 0, 0, time.UTC)
+	book := &data.LibraryBook{
+		UserID:        user.ID,
+		BookID:        "import:" + user.ID + ":isbn:9780439023481",
+		Title:         "The Hunger Games",
+		AuthorName:    "Suzanne Collins",
+		ISBN:          "9780439023481",
+		Rating:        4,
+		Source:        data.BookSourceManual,
+		ReadingStatus: data.ReadingStatusRead,
+		ShelfIDs:      []string{shelf.ID},
+		AddedAt:       addedAt,
+		UpdatedAt:     time.Now(),
+	}
+
+	added, err := client.ImportLibraryBook(ctx, book, nil)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// Importing again leaves the entry as it is, and creates no shelves.
+	changed := *book
+	changed.Rating = 1
+	added, err = client.ImportLibraryBook(ctx, &changed, []*data.Shelf{{
+		ID:        uuid.NewString(),
+		Name:      "Never created",
+		UserID:    user.ID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}})
+	require.NoError(t, err)
+	assert.False(t, added)
+	shelves, err := client.GetUserShelves(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, shelves, 1)
+	assert.Equal(t, shelf.ID, shelves[0].ID)
+
+	books, total, err := client.GetUserLibrary(ctx, user.ID, data.Page{Limit: 10})
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), total)
+	require.Len(t, books, 1)
+	assert.Equal(t, int32(4), books[0].Rating)
+	assert.Equal(t, "9780439023481", books[0].ISBN)
+	assert.Equal(t, []string{shelf.ID}, books[0].ShelfIDs)
+	assert.True(t, addedAt.Equal(books[0].AddedAt))
+
+	// The catalog entry belongs to the user who imported it.
+	entry, err := client.GetBook(ctx, data.BookSourceManual, book.BookID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, entry.CreatedBy)
+
+	// Imports never announce themselves in the feed.
+	posts, _, err := client.GetUserFeed(ctx, user.ID, data.Page{Limit: 10})
+	require.NoError(t, err)
+	assert.Empty(t, posts)
+}
+
+func TestClient_ImportLibraryBook_OpenLibrary(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	other := seedUser(t, client)
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	editionID := "OL" + uuid.NewString() + "M"
+
+	// An edition matched by ISBN shares one catalog entry between imports.
+	for _, userID := range []string{user.ID, other.ID} {
+		added, err := client.ImportLibraryBook(ctx, &data.LibraryBook{
+			UserID:        userID,
+			BookID:        editionID,
+			WorkKey:       "OL893415W",
+			Title:         "Dune",
+			AuthorName:    "Frank Herbert",
+			ISBN:          "9780801950773",
+			Source:        data.BookSourceOpenLibrary,
+			ReadingStatus: data.ReadingStatusRead,
+			AddedAt:       now,
+			UpdatedAt:     now,
+		}, nil)
+		require.NoError(t, err)
+		assert.True(t, added)
+	}
+
+	entry, err := client.GetBook(ctx, data.BookSourceOpenLibrary, editionID)
+	require.NoError(t, err)
+	assert.Equal(t, "OL893415W", entry.WorkKey)
+	assert.Empty(t, entry.CreatedBy)
+	// Never checked against Open Library, so it is refreshed first.
+	assert.Nil(t, entry.CheckedAt)
+
+	works, err := client.FindLibraryWorks(ctx, other.ID, data.BookSourceOpenLibrary, []string{"OL893415W"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"OL893415W": editionID}, works)
+}
+
+func TestClient_ImportLibraryBook_NewShelves(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	existing := seedShelf(t, client, user.ID, "Favorites")
+
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	newShelves := []*data.Shelf{
+		{ID: uuid.NewString(), Name: "Borrowed", UserID: user.ID, CreatedAt: now, UpdatedAt: now},
+		// Created since the import read the user's shelves.
+		{ID: uuid.NewString(), Name: "Favorites", UserID: user.ID, CreatedAt: now, UpdatedAt: now},
+	}
+	added, err := client.ImportLibraryBook(ctx, &data.LibraryBook{
+		UserID:        user.ID,
+		BookID:        "import:" + user.ID + ":goodreads:1",
+		Title:         "Piranesi",
+		AuthorName:    "Susanna Clarke",
+		Source:        data.BookSourceManual,
+		ReadingStatus: data.ReadingStatusWantToRead,
+		AddedAt:       now,
+		UpdatedAt:     now,
+	}, newShelves)
+	require.NoError(t, err)
+	assert.True(t, added)
+	assert.Equal(t, existing.ID, newShelves[1].ID)
+
+	books, _, err := client.GetUserLibrary(ctx, user.ID, data.Page{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, books, 1)
+	assert.ElementsMatch(t, []string{newShelves[0].ID, existing.ID}, books[0].ShelfIDs)
+	shelves, err := client.GetUserShelves(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Len(t, shelves, 2)
+}
+
+func TestClient_GetExistingLibraryBookIDs(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	other := seedUser(t, client)
+	seedLibraryBook(t, client, user.ID, "isbn:9780439023481", "The Hunger Games", "Suzanne Collins", time.Now())
+	seedLibraryBook(t, client, other.ID, "storygraph:a1b2c3d4", "Piranesi", "Susanna Clarke", time.Now())
+
+	existing, err := client.GetExistingLibraryBookIDs(ctx, user.ID, []string{"isbn:9780439023481", "storygraph:a1b2c3d4"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"isbn:9780439023481"}, existing)
+
+	existing, err = client.GetExistingLibraryBookIDs(ctx, user.ID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, existing)
+}
+
+func TestClient_FindLibraryWorks(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	now := time.Now().UTC()
+	first, second, other := "OL"+uuid.NewString()+"M", "OL"+uuid.NewString()+"M", "OL"+uuid.NewString()+"M"
+	work, otherWork := "OL"+uuid.NewString()+"W", "OL"+uuid.NewString()+"W"
+
+	// Two editions of one work and one of another, all from the same source.
+	require.NoError(t, client.UpsertBooks(ctx, []*data.Book{
+		{Source: data.BookSourceOpenLibrary, ExternalID: first, WorkKey: work, Title: "Dune", UpdatedAt: now},
+		{Source: data.BookSourceOpenLibrary, ExternalID: second, WorkKey: work, Title: "Dune", UpdatedAt: now},
+		{Source: data.BookSourceOpenLibrary, ExternalID: other, WorkKey: otherWork, Title: "Children of Dune", UpdatedAt: now},
+	}))
+	seedLibraryBook(t, client, user.ID, first, "Dune", "Frank Herbert", now.Add(-time.Hour))
+	seedLibraryBook(t, client, user.ID, second, "Dune", "Frank Herbert", now)
+
+	works, err := client.FindLibraryWorks(ctx, user.ID, data.BookSourceOpenLibrary, []string{work, otherWork})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{work: second}, works)
+
+	works, err = client.FindLibraryWorks(ctx, user.ID, data.BookSourceGoogleBooks, []string{work})
+	require.NoError(t, err)
+	assert.Empty(t, works)
+
+	// A book shelved with its work key records it. An entry without one is
+	// queued for a refresh each time a library shelves it, until it gets one.
+	shelved, unknown := "OL"+uuid.NewString()+"M", "OL"+uuid.NewString()+"M"
+	shelve := func(bookID, workKey string) {
+		t.Helper()
+		require.NoError(t, client.UpdateLibraryBook(ctx, &data.LibraryBook{
+			UserID: user.ID, BookID: bookID, Title: "Dune Messiah", AuthorName: "Frank Herbert", WorkKey: workKey,
+			Source: data.BookSourceOpenLibrary, ReadingStatus: data.ReadingStatusWantToRead, AddedAt: now, UpdatedAt: now,
+		}))
+	}
+	shelve(shelved, otherWork)
+	shelve(unknown, "")
+	works, err = client.FindLibraryWorks(ctx, user.ID, data.BookSourceOpenLibrary, []string{otherWork})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{otherWork: shelved}, works)
+
+	require.NoError(t, client.MarkBooksChecked(ctx, data.BookSourceOpenLibrary, []string{unknown}, now))
+	shelve(unknown, "")
+	entry, err := client.GetBook(ctx, data.BookSourceOpenLibrary, unknown)
+	require.NoError(t, err)
+	assert.Nil(t, entry.CheckedAt)
+	shelve(unknown, work)
+	entry, err = client.GetBook(ctx, data.BookSourceOpenLibrary, unknown)
+	require.NoError(t, err)
+	assert.Equal(t, work, entry.WorkKey)
+}
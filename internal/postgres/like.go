@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestialdragonfly/betterreads/internal/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+	ErrLikeAuthorNotFound = errors.New("like author not found")
+)
+
+// LikePost records that userID likes postID and increments posts.like_count in
+// the same transaction. Liking a post twice is a no-op.
+func (db *Client) LikePost(ctx context.Context, userID, postID string) error {
+	tx, err := db.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("LikePost: failed to start transaction: %w", err)
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			logger.Error("LikePost: failed to rollback transaction", "post_id", postID, "error", rollbackErr)
+		}
+	}()
+
+	query := `
+		INSERT INTO post_likes (post_id, user_id)
+		VALUES ($1, $2)
+		ON CONFLICT (post_id, user_id) DO NOTHING
+	`
+	result, err := tx.Exec(ctx, query, postID, userID)
+	if err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) {
+			if pgErr.Code == ForeignKeyViolation {
+				switch pgErr.ConstraintName {
+				case "post_likes_post_id_fkey":
+					return ErrPostNotFound
+				case "post_likes_user_id_fkey":
+					return ErrLikeAuthorNotFound
+				default:
+					return fmt.Errorf("LikePost FK violation: %w", err)
+				}
+			}
+		}
+		return fmt.Errorf("LikePost: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		logger.Warn("Already liked post", "user_id", userID, "post_id", postID)
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE posts SET like_count = like_count + 1 WHERE id = $1`, postID); err != nil {
+		return fmt.Errorf("LikePost (count): %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("LikePost: committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// UnlikePost removes userID's like from postID and decrements posts.like_count
+// in the same transaction. Unliking a post that was not liked is a no-op.
+func (db *Client) UnlikePost(ctx context.Context, userID, postID string) error {
+	tx, err := db.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("UnlikePost: failed to start transaction: %w", err)
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			logger.Error("UnlikePost: failed to rollback transaction", "post_id", postID, "error", rollbackErr)
+		}
+	}()
+
+	result, err := tx.Exec(ctx, `DELETE FROM post_likes WHERE post_id = $1 AND user_id = $2`, postID, userID)
+	if err != nil {
+		return fmt.Errorf("UnlikePost: %w", err)
+	}
+	if result.RowsAffected() == 0 {
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM posts WHERE id = $1)`, postID).Scan(&exists); err != nil {
+			return fmt.Errorf("UnlikePost (lookup): %w", err)
+		}
+		if !exists {
+			return ErrPostNotFound
+		}
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE posts SET like_count = like_count - 1 WHERE id = $1`, postID); err != nil {
+		return fmt.Errorf("UnlikePost (count): %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("UnlikePost: committing transaction: %w", err)
+	}
+
+	return nil
+}
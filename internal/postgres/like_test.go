@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_LikePost_Idempotent(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	author := seedUser(t, client)
+	liker := seedUser(t, client)
+	post := seedPost(t, client, author.ID, time.Now())
+
+	require.NoError(t, client.LikePost(ctx, liker.ID, post.ID))
+	require.NoError(t, client.LikePost(ctx, liker.ID, post.ID))
+	assert.Equal(t, int32(1), postLikeCount(t, client, post.ID))
+
+	require.NoError(t, client.UnlikePost(ctx, liker.ID, post.ID))
+	require.NoError(t, client.UnlikePost(ctx, liker.ID, post.ID))
+	assert.Equal(t, int32(0), postLikeCount(t, client, post.ID))
+}
+
+func TestClient_LikePost_MissingPost(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	liker := seedUser(t, client)
+	missingPostID := "00000000-0000-0000-0000-000000000000"
+
+	require.ErrorIs(t, client.LikePost(ctx, liker.ID, missingPostID), ErrPostNotFound)
+	require.ErrorIs(t, client.UnlikePost(ctx, liker.ID, missingPostID), ErrPostNotFound)
+}
+
+// TestClient_LikePost_Concurrent likes one post from many users at once, each
+// on its own connection, and checks the denormalized counter matches exactly.
+func TestClient_LikePost_Concurrent(t *testing.T) {
+	const likers = 25
+
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	author := seedUser(t, client)
+	post := seedPost(t, client, author.ID, time.Now())
+
+	userIDs := make([]string, likers)
+	for i := range likers {
+		userIDs[i] = seedUser(t, client).ID
+	}
+
+	// Every user likes twice so duplicate likes race with the first one too.
+	var wg sync.WaitGroup
+	errs := make(chan error, likers*2)
+	for i := range likers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 2 {
+				errs <- client.LikePost(ctx, userIDs[i], post.ID)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(likers), postLikeCount(t, client, post.ID))
+
+	for i := range likers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, client.UnlikePost(ctx, userIDs[i], post.ID))
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(0), postLikeCount(t, client, post.ID))
+}
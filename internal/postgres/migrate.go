@@ -0,0 +1,283 @@
+package postgres
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// migrationLockID is the pg_advisory_lock key held while migrating, so that
+// replicas starting together apply each migration once.
+const migrationLockID int64 = 0x6272_6d69_6772
+
+var (
+	ErrInvalidMigration = errors.New("invalid migration")
+	ErrUnknownMigration = errors.New("database has a migration this build does not know")
+)
+
+// migrationFileName matches NNNN_name.up.sql and NNNN_name.down.sql.
+var migrationFileName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Migration is one versioned schema change with the SQL to apply and revert
+// it.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// MigrationStatus reports whether a migration has been applied. AppliedAt is
+// nil for pending migrations.
+type MigrationStatus struct {
+	Version   int
+	Name      string
+	AppliedAt *time.Time
+}
+
+// Migrations returns the embedded migrations ordered by version.
+func Migrations() ([]Migration, error) {
+	return loadMigrations(migrationFiles, "migrations")
+}
+
+func loadMigrations(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("loadMigrations: %w", err)
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, entry := range entries {
+		match := migrationFileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("%w: unexpected file %q", ErrInvalidMigration, entry.Name())
+		}
+		version, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrInvalidMigration, entry.Name(), err)
+		}
+		contents, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("loadMigrations: %w", err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if m.Name != match[2] {
+			return nil, fmt.Errorf("%w: version %d is used by %q and %q", ErrInvalidMigration, version, m.Name, match[2])
+		}
+		if match[3] == "up" {
+			m.Up = string(contents)
+		} else {
+			m.Down = string(contents)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("%w: version %d needs both an up and a down file", ErrInvalidMigration, m.Version)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	for i, m := range migrations {
+		if m.Version != i+1 {
+			return nil, fmt.Errorf("%w: expected version %d, found %d", ErrInvalidMigration, i+1, m.Version)
+		}
+	}
+	return migrations, nil
+}
+
+// MigrateUp applies every pending migration in order and returns the ones it
+// applied.
+func MigrateUp(ctx context.Context, db *pgxpool.Pool) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	err = withMigrationLock(ctx, db, func(conn *pgx.Conn) error {
+		current, err := appliedVersions(ctx, conn, migrations)
+		if err != nil {
+			return err
+		}
+		for _, m := range migrations {
+			if _, ok := current[m.Version]; ok {
+				continue
+			}
+			if err := runMigration(ctx, conn, m.Up, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, m.Version, m.Name); err != nil {
+				return fmt.Errorf("MigrateUp: %04d_%s: %w", m.Version, m.Name, err)
+			}
+			logger.Info("applied migration", "version", m.Version, "name", m.Name)
+			applied = append(applied, m)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// MigrateDown reverts the latest steps applied migrations, newest first, and
+// returns the ones it reverted.
+func MigrateDown(ctx context.Context, db *pgxpool.Pool, steps int) ([]Migration, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	err = withMigrationLock(ctx, db, func(conn *pgx.Conn) error {
+		current, err := appliedVersions(ctx, conn, migrations)
+		if err != nil {
+			return err
+		}
+		for i := len(migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			m := migrations[i]
+			if _, ok := current[m.Version]; !ok {
+				continue
+			}
+			if err := runMigration(ctx, conn, m.Down, `DELETE FROM schema_migrations WHERE version = $1`, m.Version); err != nil {
+				return fmt.Errorf("MigrateDown: %04d_%s: %w", m.Version, m.Name, err)
+			}
+			logger.Info("reverted migration", "version", m.Version, "name", m.Name)
+			reverted = append(reverted, m)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// GetMigrationStatus lists every known migration with the time it was applied.
+func GetMigrationStatus(ctx context.Context, db *pgxpool.Pool) ([]MigrationStatus, error) {
+	migrations, err := Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []MigrationStatus
+	err = withMigrationLock(ctx, db, func(conn *pgx.Conn) error {
+		current, err := appliedVersions(ctx, conn, migrations)
+		if err != nil {
+			return err
+		}
+		statuses = make([]MigrationStatus, 0, len(migrations))
+		for _, m := range migrations {
+			status := MigrationStatus{Version: m.Version, Name: m.Name}
+			if appliedAt, ok := current[m.Version]; ok {
+				status.AppliedAt = &appliedAt
+			}
+			statuses = append(statuses, status)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// withMigrationLock runs fn on a dedicated connection while holding the
+// migration advisory lock. Session locks belong to a connection, so fn must
+// not use the pool.
+func withMigrationLock(ctx context.Context, db *pgxpool.Pool, fn func(conn *pgx.Conn) error) error {
+	conn, err := db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("migrate: acquiring connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		return fmt.Errorf("migrate: acquiring lock: %w", err)
+	}
+	defer func() {
+		// Use a fresh context so a cancelled ctx still releases the lock.
+		if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`, migrationLockID); err != nil {
+			logger.Error("migrate: failed to release lock", "error", err)
+		}
+	}()
+
+	createMigrationsTable := `
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+	);
+	`
+	if _, err := conn.Exec(ctx, createMigrationsTable); err != nil {
+		return fmt.Errorf("migrate: creating schema_migrations: %w", err)
+	}
+
+	return fn(conn.Conn())
+}
+
+// appliedVersions returns the applied_at time of each applied migration. It
+// fails if the database has a version newer than this build knows, since
+// running older code against that schema is unsafe.
+func appliedVersions(ctx context.Context, conn *pgx.Conn, migrations []Migration) (map[int]time.Time, error) {
+	rows, err := conn.Query(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("migrate: reading schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	applied := map[int]time.Time{}
+	for rows.Next() {
+		var version int
+		var appliedAt time.Time
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, fmt.Errorf("migrate: scanning schema_migrations: %w", err)
+		}
+		if version > len(migrations) {
+			return nil, fmt.Errorf("%w: version %d", ErrUnknownMigration, version)
+		}
+		applied[version] = appliedAt
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("migrate: reading schema_migrations: %w", err)
+	}
+	return applied, nil
+}
+
+// runMigration executes script and the bookkeeping statement in a single
+// transaction, so a failed migration leaves no trace.
+func runMigration(ctx context.Context, conn *pgx.Conn, script, record string, args ...any) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			logger.Error("migrate: failed to rollback transaction", "error", rollbackErr)
+		}
+	}()
+
+	// Without arguments pgx uses the simple protocol, which accepts several
+	// statements in one call.
+	if _, err := tx.Exec(ctx, script); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, record, args...); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
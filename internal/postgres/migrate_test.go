@@ -0,0 +1,277 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrations_Embedded(t *testing.T) {
+	t.Parallel()
+
+	migrations, err := Migrations()
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+	for i, m := range migrations {
+		assert.Equal(t, i+1, m.Version)
+		assert.NotEmpty(t, m.Up, m.Name)
+		assert.NotEmpty(t, m.Down, m.Name)
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	t.Parallel()
+
+	file := func(sql string) *fstest.MapFile { return &fstest.MapFile{Data: []byte(sql)} }
+
+	tests := []struct {
+		name     string
+		files    fstest.MapFS
+		wantErr  error
+		expected []Migration
+	}{
+		{
+			name: "orders by version",
+			files: fstest.MapFS{
+				"m/0002_second.up.sql":   file("up 2"),
+				"m/0002_second.down.sql": file("down 2"),
+				"m/0001_first.up.sql":    file("up 1"),
+				"m/0001_first.down.sql":  file("down 1"),
+			},
+			expected: []Migration{
+				{Version: 1, Name: "first", Up: "up 1", Down: "down 1"},
+				{Version: 2, Name: "second", Up: "up 2", Down: "down 2"},
+			},
+		},
+		{
+			name: "missing down",
+			files: fstest.MapFS{
+				"m/0001_first.up.sql": file("up 1"),
+			},
+			wantErr: ErrInvalidMigration,
+		},
+		{
+			name: "gap in versions",
+			files: fstest.MapFS{
+				"m/0001_first.up.sql":   file("up 1"),
+				"m/0001_first.down.sql": file("down 1"),
+				"m/0003_third.up.sql":   file("up 3"),
+				"m/0003_third.down.sql": file("down 3"),
+			},
+			wantErr: ErrInvalidMigration,
+		},
+		{
+			name: "version reused",
+			files: fstest.MapFS{
+				"m/0001_first.up.sql":   file("up 1"),
+				"m/0001_first.down.sql": file("down 1"),
+				"m/0001_other.up.sql":   file("up 1"),
+				"m/0001_other.down.sql": file("down 1"),
+			},
+			wantErr: ErrInvalidMigration,
+		},
+		{
+			name: "unexpected file",
+			files: fstest.MapFS{
+				"m/README.md": file("notes"),
+			},
+			wantErr: ErrInvalidMigration,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			migrations, err := loadMigrations(tc.files, "m")
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, migrations)
+		})
+	}
+}
+
+// newSchemaPool connects to the integration database with search_path set to
+// a fresh schema, so migrations can be applied and reverted without touching
+// the tables other tests use.
+func newSchemaPool(tb testing.TB) *pgxpool.Pool {
+	tb.Helper()
+	ctx := context.Background()
+	dsn := testSQLURL(tb)
+	schema := "migrate_test_" + uuid.NewString()[:8]
+
+	admin, err := pgxpool.New(ctx, dsn)
+	require.NoError(tb, err)
+	tb.Cleanup(admin.Close)
+	_, err = admin.Exec(ctx, `CREATE SCHEMA `+schema)
+	require.NoError(tb, err)
+	tb.Cleanup(func() {
+		_, _ = admin.Exec(ctx, `DROP SCHEMA `+schema+` CASCADE`)
+	})
+
+	cfg, err := pgxpool.ParseConfig(dsn)
+	require.NoError(tb, err)
+	cfg.ConnConfig.RuntimeParams["search_path"] = schema
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	require.NoError(tb, err)
+	tb.Cleanup(pool.Close)
+	return pool
+}
+
+func TestMigrateUpDown(t *testing.T) {
+	pool := newSchemaPool(t)
+	ctx := context.Background()
+
+	migrations, err := Migrations()
+	require.NoError(t, err)
+
+	applied, err := MigrateUp(ctx, pool)
+	require.NoError(t, err)
+	assert.Len(t, applied, len(migrations))
+
+	// Running again is a no-op.
+	applied, err = MigrateUp(ctx, pool)
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+
+	statuses, err := GetMigrationStatus(ctx, pool)
+	require.NoError(t, err)
+	require.Len(t, statuses, len(migrations))
+	for _, s := range statuses {
+		assert.NotNil(t, s.AppliedAt, s.Name)
+	}
+
+	reverted, err := MigrateDown(ctx, pool, 1)
+	require.NoError(t, err)
+	require.Len(t, reverted, 1)
+	assert.Equal(t, len(migrations), reverted[0].Version)
+
+	statuses, err = GetMigrationStatus(ctx, pool)
+	require.NoError(t, err)
+	assert.Nil(t, statuses[len(statuses)-1].AppliedAt)
+
+	// Every down migration must undo its up migration cleanly.
+	reverted, err = MigrateDown(ctx, pool, len(migrations))
+	require.NoError(t, err)
+	assert.Len(t, reverted, len(migrations)-1)
+
+	var tables int
+	require.NoError(t, pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM information_schema.tables
+		WHERE table_schema = current_schema() AND table_name <> 'schema_migrations'
+	`).Scan(&tables))
+	assert.Zero(t, tables)
+
+	applied, err = MigrateUp(ctx, pool)
+	require.NoError(t, err)
+	assert.Len(t, applied, len(migrations))
+}
+
+func TestMigrateUp_BackfillsReadingSessions(t *testing.T) {
+	pool := newSchemaPool(t)
+	ctx := context.Background()
+
+	migrations, err := Migrations()
+	require.NoError(t, err)
+	backfill := -1
+	for i, m := range migrations {
+		if m.Name == "backfill_reading_sessions" {
+			backfill = i
+		}
+	}
+	require.NotEqual(t, -1, backfill)
+
+	// Apply everything, then revert back to before the backfill so the
+	// library can be filled as it was then.
+	_, err = MigrateUp(ctx, pool)
+	require.NoError(t, err)
+	_, err = MigrateDown(ctx, pool, len(migrations)-backfill)
+	require.NoError(t, err)
+
+	userID := uuid.NewString()
+	updatedAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	finishedAt := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	_, err = pool.Exec(ctx, `
+		INSERT INTO users (id, username, first_name, last_name, email) VALUES ($1, $1, 'Test', 'User', $1)
+	`, userID)
+	require.NoError(t, err)
+	for _, b := range []struct {
+		id     string
+		status int32
+		rating int32
+	}{
+		{"read", data.ReadingStatusRead, 4},
+		{"progress", data.ReadingStatusRead, 5},
+		{"dnf", data.ReadingStatusDidNotFinish, 2},
+		{"want", data.ReadingStatusWantToRead, 0},
+		{"logged", data.ReadingStatusRead, 3},
+	} {
+		_, err = pool.Exec(ctx, `INSERT INTO books (source, external_id, title) VALUES (3, $1, $1)`, b.id)
+		require.NoError(t, err)
+		_, err = pool.Exec(ctx, `
+			INSERT INTO library_books (user_id, book_id, rating, source, reading_status, added_at, updated_at)
+			VALUES ($1, $2, $3, 3, $4, $5, $5)
+		`, userID, b.id, b.rating, b.status, updatedAt)
+		require.NoError(t, err)
+	}
+	_, err = pool.Exec(ctx, `
+		INSERT INTO reading_progress (id, user_id, book_id, page, total_pages, percent, created_at)
+		VALUES ($1, $2, 'progress', 300, 300, 100, $3)
+	`, uuid.NewString(), userID, finishedAt)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, `
+		INSERT INTO reading_sessions (id, user_id, book_id, finished_at, rating)
+		VALUES ($1, $2, 'logged', $3, 3)
+	`, uuid.NewString(), userID, finishedAt)
+	require.NoError(t, err)
+
+	_, err = MigrateUp(ctx, pool)
+	require.NoError(t, err)
+
+	rows, err := pool.Query(ctx, `
+		SELECT book_id, finished_at, did_not_finish, rating FROM reading_sessions WHERE user_id = $1 ORDER BY book_id
+	`, userID)
+	require.NoError(t, err)
+	type session struct {
+		BookID       string
+		FinishedAt   time.Time
+		DidNotFinish bool
+		Rating       int32
+	}
+	sessions, err := pgx.CollectRows(rows, pgx.RowToStructByPos[session])
+	require.NoError(t, err)
+	for i := range sessions {
+		sessions[i].FinishedAt = sessions[i].FinishedAt.UTC()
+	}
+	// Books on other statuses, or with a session already, get none.
+	assert.Equal(t, []session{
+		{BookID: "dnf", FinishedAt: updatedAt, DidNotFinish: true},
+		{BookID: "logged", FinishedAt: finishedAt, Rating: 3},
+		{BookID: "progress", FinishedAt: finishedAt, Rating: 5},
+		{BookID: "read", FinishedAt: updatedAt, Rating: 4},
+	}, sessions)
+}
+
+func TestMigrateUp_UnknownVersion(t *testing.T) {
+	pool := newSchemaPool(t)
+	ctx := context.Background()
+
+	_, err := MigrateUp(ctx, pool)
+	require.NoError(t, err)
+	_, err = pool.Exec(ctx, `INSERT INTO schema_migrations (version, name) VALUES (9999, 'from_the_future')`)
+	require.NoError(t, err)
+
+	_, err = MigrateUp(ctx, pool)
+	require.ErrorIs(t, err, ErrUnknownMigration)
+}
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS users (
+	id UUID PRIMARY KEY,
+	username TEXT NOT NULL UNIQUE,
+	first_name TEXT NOT NULL,
+	last_name TEXT NOT NULL,
+	email TEXT NOT NULL UNIQUE,
+	profile_photo TEXT,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
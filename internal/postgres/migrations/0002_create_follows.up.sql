@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS follows (
+	follower_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	followee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (follower_id, followee_id)
+);
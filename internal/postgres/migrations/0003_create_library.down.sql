@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS shelf_books;
+DROP TABLE IF EXISTS library_books;
+DROP TABLE IF EXISTS shelves;
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS shelves (
+	id UUID PRIMARY KEY,
+	name TEXT NOT NULL,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	UNIQUE(user_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS library_books (
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	book_id TEXT NOT NULL,
+	title TEXT NOT NULL,
+	author_name TEXT NOT NULL,
+	book_image TEXT,
+	rating INTEGER,
+	source INTEGER,
+	reading_status INTEGER NOT NULL DEFAULT 1,
+	added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (user_id, book_id)
+);
+
+CREATE TABLE IF NOT EXISTS shelf_books (
+	shelf_id UUID NOT NULL REFERENCES shelves(id) ON DELETE CASCADE,
+	user_id UUID NOT NULL,
+	book_id TEXT NOT NULL,
+	added_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (shelf_id, book_id),
+	FOREIGN KEY (user_id, book_id) REFERENCES library_books(user_id, book_id) ON DELETE CASCADE
+);
+
+-- Databases created before versioned migrations may predate these changes.
+ALTER TABLE shelves DROP COLUMN IF EXISTS is_default;
+ALTER TABLE library_books ADD COLUMN IF NOT EXISTS reading_status INTEGER NOT NULL DEFAULT 1;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS posts (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	book_id TEXT NOT NULL,
+	book_author TEXT NOT NULL,
+	book_title TEXT NOT NULL,
+	book_image TEXT NOT NULL DEFAULT '',
+	book_rating INTEGER NOT NULL DEFAULT 0,
+	description TEXT NOT NULL,
+	summary TEXT NOT NULL DEFAULT '',
+	like_count INTEGER NOT NULL DEFAULT 0,
+	comment_count INTEGER NOT NULL DEFAULT 0,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Serves feed reads, which filter by author and page by recency.
+CREATE INDEX IF NOT EXISTS posts_user_id_created_at_idx ON posts (user_id, created_at DESC, id DESC);
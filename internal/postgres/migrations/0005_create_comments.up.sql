@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS comments (
+	id UUID PRIMARY KEY,
+	post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	content TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS comments_post_id_created_at_idx ON comments (post_id, created_at, id);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS post_likes (
+	post_id UUID NOT NULL REFERENCES posts(id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (post_id, user_id)
+);
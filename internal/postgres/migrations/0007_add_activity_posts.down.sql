@@ -0,0 +1,2 @@
+ALTER TABLE posts DROP COLUMN IF EXISTS system_generated;
+ALTER TABLE users DROP COLUMN IF EXISTS activity_posts_enabled;
@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS activity_posts_enabled BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS system_generated BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS library_books_user_id_added_at_idx;
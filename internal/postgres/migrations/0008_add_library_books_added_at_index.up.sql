@@ -0,0 +1,2 @@
+-- Serves GetUserLibrary, which pages through a library newest first.
+CREATE INDEX IF NOT EXISTS library_books_user_id_added_at_idx ON library_books (user_id, added_at DESC, book_id DESC);
@@ -0,0 +1 @@
+ALTER TABLE library_books DROP COLUMN IF EXISTS isbn;
@@ -0,0 +1 @@
+ALTER TABLE library_books ADD COLUMN IF NOT EXISTS isbn TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS reading_progress (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL,
+	book_id TEXT NOT NULL,
+	page INTEGER NOT NULL DEFAULT 0 CHECK (page >= 0),
+	total_pages INTEGER NOT NULL DEFAULT 0 CHECK (total_pages >= 0),
+	percent DOUBLE PRECISION NOT NULL CHECK (percent BETWEEN 0 AND 100),
+	note TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	FOREIGN KEY (user_id, book_id) REFERENCES library_books(user_id, book_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS reading_progress_user_id_book_id_created_at_idx ON reading_progress (user_id, book_id, created_at DESC, id DESC);
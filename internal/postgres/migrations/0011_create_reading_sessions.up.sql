@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS reading_sessions (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL,
+	book_id TEXT NOT NULL,
+	started_at TIMESTAMPTZ,
+	finished_at TIMESTAMPTZ,
+	did_not_finish BOOLEAN NOT NULL DEFAULT FALSE,
+	rating INTEGER NOT NULL DEFAULT 0 CHECK (rating BETWEEN 0 AND 5),
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	CHECK (finished_at IS NULL OR started_at IS NULL OR finished_at >= started_at),
+	FOREIGN KEY (user_id, book_id) REFERENCES library_books(user_id, book_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS reading_sessions_user_id_book_id_idx ON reading_sessions (user_id, book_id);
+CREATE INDEX IF NOT EXISTS reading_sessions_user_id_finished_at_idx ON reading_sessions (user_id, finished_at);
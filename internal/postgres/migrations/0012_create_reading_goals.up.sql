@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS reading_goals (
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	year INTEGER NOT NULL,
+	target_books INTEGER NOT NULL DEFAULT 0 CHECK (target_books >= 0),
+	target_pages INTEGER NOT NULL DEFAULT 0 CHECK (target_pages >= 0),
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	CHECK (target_books > 0 OR target_pages > 0),
+	PRIMARY KEY (user_id, year)
+);
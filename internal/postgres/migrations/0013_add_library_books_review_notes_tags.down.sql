@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS library_books_tags_idx;
+ALTER TABLE library_books DROP COLUMN IF EXISTS tags;
+ALTER TABLE library_books DROP COLUMN IF EXISTS notes;
+ALTER TABLE library_books DROP COLUMN IF EXISTS review_has_spoilers;
+ALTER TABLE library_books DROP COLUMN IF EXISTS review;
@@ -0,0 +1,7 @@
+ALTER TABLE library_books ADD COLUMN IF NOT EXISTS review TEXT NOT NULL DEFAULT '';
+ALTER TABLE library_books ADD COLUMN IF NOT EXISTS review_has_spoilers BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE library_books ADD COLUMN IF NOT EXISTS notes TEXT NOT NULL DEFAULT '';
+ALTER TABLE library_books ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+-- Serves SearchLibrary's tag filter.
+CREATE INDEX IF NOT EXISTS library_books_tags_idx ON library_books USING GIN (tags);
@@ -0,0 +1,3 @@
+ALTER TABLE posts DROP COLUMN IF EXISTS quote;
+ALTER TABLE posts DROP COLUMN IF EXISTS highlight_id;
+DROP TABLE IF EXISTS highlights;
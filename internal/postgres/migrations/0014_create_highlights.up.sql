@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS highlights (
+	id UUID PRIMARY KEY,
+	user_id UUID NOT NULL,
+	book_id TEXT NOT NULL,
+	quote TEXT NOT NULL,
+	page INTEGER NOT NULL DEFAULT 0 CHECK (page >= 0),
+	location TEXT NOT NULL DEFAULT '',
+	comment TEXT NOT NULL DEFAULT '',
+	is_public BOOLEAN NOT NULL DEFAULT FALSE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	FOREIGN KEY (user_id, book_id) REFERENCES library_books(user_id, book_id) ON DELETE CASCADE
+);
+
+-- Serves ListHighlights, across the library and for one book, newest first.
+CREATE INDEX IF NOT EXISTS highlights_user_id_created_at_idx ON highlights (user_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS highlights_user_id_book_id_created_at_idx ON highlights (user_id, book_id, created_at DESC, id DESC);
+
+-- A post sharing a highlight keeps a copy of the quote, so it survives the
+-- highlight being edited or deleted.
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS highlight_id UUID REFERENCES highlights(id) ON DELETE SET NULL;
+ALTER TABLE posts ADD COLUMN IF NOT EXISTS quote TEXT NOT NULL DEFAULT '';
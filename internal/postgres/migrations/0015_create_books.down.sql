@@ -0,0 +1,16 @@
+ALTER TABLE library_books ADD COLUMN IF NOT EXISTS title TEXT NOT NULL DEFAULT '';
+ALTER TABLE library_books ADD COLUMN IF NOT EXISTS author_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE library_books ADD COLUMN IF NOT EXISTS book_image TEXT;
+ALTER TABLE library_books ADD COLUMN IF NOT EXISTS isbn TEXT NOT NULL DEFAULT '';
+
+UPDATE library_books lb
+SET title = b.title, author_name = b.author_name, book_image = b.book_image, isbn = b.isbn
+FROM books b
+WHERE b.source = lb.source AND b.external_id = lb.book_id;
+
+ALTER TABLE library_books ALTER COLUMN title DROP DEFAULT;
+ALTER TABLE library_books ALTER COLUMN author_name DROP DEFAULT;
+ALTER TABLE library_books DROP CONSTRAINT IF EXISTS library_books_source_book_id_fkey;
+ALTER TABLE library_books ALTER COLUMN source DROP NOT NULL;
+ALTER TABLE library_books ALTER COLUMN source DROP DEFAULT;
+DROP TABLE IF EXISTS books;
@@ -0,0 +1,47 @@
+-- The catalog holds one entry per book, shared by every library that has it.
+-- Entries come from search results and are refreshed from their source in
+-- the background; refreshed_at is NULL for an entry the source has never
+-- confirmed, which holds what the first library to add the book sent.
+CREATE TABLE IF NOT EXISTS books (
+	source INTEGER NOT NULL,
+	external_id TEXT NOT NULL,
+	title TEXT NOT NULL,
+	author_name TEXT NOT NULL DEFAULT '',
+	author_key TEXT NOT NULL DEFAULT '',
+	book_image TEXT NOT NULL DEFAULT '',
+	isbn TEXT NOT NULL DEFAULT '',
+	publish_year INTEGER NOT NULL DEFAULT 0,
+	rating_average REAL NOT NULL DEFAULT 0,
+	rating_count INTEGER NOT NULL DEFAULT 0,
+	refreshed_at TIMESTAMPTZ,
+	checked_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (source, external_id)
+);
+
+-- Serves the background refresh, which checks the longest unchecked entries
+-- of a source first.
+CREATE INDEX IF NOT EXISTS books_source_checked_at_idx ON books (source, checked_at NULLS FIRST);
+
+-- Every library entry must name its source to be found in the catalog.
+UPDATE library_books SET source = 0 WHERE source IS NULL;
+ALTER TABLE library_books ALTER COLUMN source SET DEFAULT 0;
+ALTER TABLE library_books ALTER COLUMN source SET NOT NULL;
+
+-- Seed the catalog from the most recently updated copy of each book.
+INSERT INTO books (source, external_id, title, author_name, book_image, isbn, created_at, updated_at)
+SELECT DISTINCT ON (source, book_id)
+	source, book_id, title, author_name, COALESCE(book_image, ''), isbn, added_at, updated_at
+FROM library_books
+ORDER BY source, book_id, updated_at DESC
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE library_books
+	ADD CONSTRAINT library_books_source_book_id_fkey
+	FOREIGN KEY (source, book_id) REFERENCES books(source, external_id);
+
+ALTER TABLE library_books DROP COLUMN IF EXISTS title;
+ALTER TABLE library_books DROP COLUMN IF EXISTS author_name;
+ALTER TABLE library_books DROP COLUMN IF EXISTS book_image;
+ALTER TABLE library_books DROP COLUMN IF EXISTS isbn;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS books_source_work_key_idx;
+
+ALTER TABLE books DROP COLUMN IF EXISTS work_key;
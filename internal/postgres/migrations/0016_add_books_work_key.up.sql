@@ -0,0 +1,5 @@
+-- A catalog entry from Open Library is an edition; work_key is the work it is
+-- an edition of, so a library can be matched against an author's works.
+ALTER TABLE books ADD COLUMN IF NOT EXISTS work_key TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS books_source_work_key_idx ON books (source, work_key) WHERE work_key <> '';
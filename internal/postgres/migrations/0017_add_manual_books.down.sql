@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS books_created_by_idx;
+
+ALTER TABLE books DROP COLUMN IF EXISTS created_by;
+ALTER TABLE books DROP COLUMN IF EXISTS page_count;
+ALTER TABLE books DROP COLUMN IF EXISTS authors;
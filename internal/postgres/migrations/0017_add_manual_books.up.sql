@@ -0,0 +1,8 @@
+-- Books entered by hand carry every author and a page count, and record who
+-- entered them so they can be reviewed and merged into an entry from a real
+-- source later.
+ALTER TABLE books ADD COLUMN IF NOT EXISTS authors TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE books ADD COLUMN IF NOT EXISTS page_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE books ADD COLUMN IF NOT EXISTS created_by UUID REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS books_created_by_idx ON books (created_by) WHERE created_by IS NOT NULL;
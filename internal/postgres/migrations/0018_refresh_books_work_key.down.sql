@@ -0,0 +1,2 @@
+-- The refresh only fills in what Open Library says; there is nothing to undo.
+SELECT 1;
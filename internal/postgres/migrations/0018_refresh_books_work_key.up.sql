@@ -0,0 +1,4 @@
+-- Open Library (source 2) entries added before work_key existed, or shelved
+-- without a search, have no work key. Marking them unchecked puts them first
+-- in the catalog refresh, which records the work key Open Library gives.
+UPDATE books SET checked_at = NULL WHERE source = 2 AND work_key = '';
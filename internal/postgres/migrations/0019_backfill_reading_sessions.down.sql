@@ -0,0 +1,3 @@
+-- The backfilled sessions cannot be told apart from ones logged since, and
+-- reverting the backfill would drop reads from stats and goals, so they stay.
+SELECT 1;
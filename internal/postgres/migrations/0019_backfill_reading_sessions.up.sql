@@ -0,0 +1,25 @@
+-- Books read (status 1) or not finished (status 4) before reading sessions
+-- existed have none, so stats and goals, which count finished sessions, miss
+-- them. Each gets one, finished when its progress last reached 100% or,
+-- without such an entry, when the library entry was last updated.
+INSERT INTO reading_sessions (id, user_id, book_id, finished_at, did_not_finish, rating, created_at, updated_at)
+SELECT
+	gen_random_uuid(),
+	lb.user_id,
+	lb.book_id,
+	COALESCE(done.finished_at, lb.updated_at),
+	lb.reading_status = 4,
+	CASE WHEN lb.reading_status = 1 THEN COALESCE(lb.rating, 0) ELSE 0 END,
+	NOW(),
+	NOW()
+FROM library_books lb
+CROSS JOIN LATERAL (
+	SELECT MAX(rp.created_at) AS finished_at
+	FROM reading_progress rp
+	WHERE rp.user_id = lb.user_id AND rp.book_id = lb.book_id AND rp.percent >= 100
+) done
+WHERE lb.reading_status IN (1, 4)
+	AND NOT EXISTS (
+		SELECT 1 FROM reading_sessions rs
+		WHERE rs.user_id = lb.user_id AND rs.book_id = lb.book_id
+	);
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	data "github.com/celestialdragonfly/betterreads/internal/data"
 	gomock "go.uber.org/mock/gomock"
@@ -55,6 +56,95 @@ func (mr *MockAPIMockRecorder) AddBookToShelf(ctx, userID, bookID, shelfID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBookToShelf", reflect.TypeOf((*MockAPI)(nil).AddBookToShelf), ctx, userID, bookID, shelfID)
 }
 
+// AddComment mocks base method.
+func (m *MockAPI) AddComment(ctx context.Context, comment *data.Comment) (*data.Comment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddComment", ctx, comment)
+	ret0, _ := ret[0].(*data.Comment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddComment indicates an expected call of AddComment.
+func (mr *MockAPIMockRecorder) AddComment(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockAPI)(nil).AddComment), ctx, comment)
+}
+
+// ClaimStaleBooks mocks base method.
+func (m *MockAPI) ClaimStaleBooks(ctx context.Context, source int32, checkedBefore, at time.Time, limit int32) ([]*data.Book, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimStaleBooks", ctx, source, checkedBefore, at, limit)
+	ret0, _ := ret[0].([]*data.Book)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimStaleBooks indicates an expected call of ClaimStaleBooks.
+func (mr *MockAPIMockRecorder) ClaimStaleBooks(ctx, source, checkedBefore, at, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimStaleBooks", reflect.TypeOf((*MockAPI)(nil).ClaimStaleBooks), ctx, source, checkedBefore, at, limit)
+}
+
+// CreateBook mocks base method.
+func (m *MockAPI) CreateBook(ctx context.Context, book *data.Book) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBook", ctx, book)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBook indicates an expected call of CreateBook.
+func (mr *MockAPIMockRecorder) CreateBook(ctx, book any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBook", reflect.TypeOf((*MockAPI)(nil).CreateBook), ctx, book)
+}
+
+// CreateHighlight mocks base method.
+func (m *MockAPI) CreateHighlight(ctx context.Context, highlight *data.Highlight) (*data.Highlight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHighlight", ctx, highlight)
+	ret0, _ := ret[0].(*data.Highlight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHighlight indicates an expected call of CreateHighlight.
+func (mr *MockAPIMockRecorder) CreateHighlight(ctx, highlight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHighlight", reflect.TypeOf((*MockAPI)(nil).CreateHighlight), ctx, highlight)
+}
+
+// CreatePost mocks base method.
+func (m *MockAPI) CreatePost(ctx context.Context, post *data.Post) (*data.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePost", ctx, post)
+	ret0, _ := ret[0].(*data.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePost indicates an expected call of CreatePost.
+func (mr *MockAPIMockRecorder) CreatePost(ctx, post any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePost", reflect.TypeOf((*MockAPI)(nil).CreatePost), ctx, post)
+}
+
+// CreateReadingSession mocks base method.
+func (m *MockAPI) CreateReadingSession(ctx context.Context, session *data.ReadingSession) (*data.ReadingSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReadingSession", ctx, session)
+	ret0, _ := ret[0].(*data.ReadingSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReadingSession indicates an expected call of CreateReadingSession.
+func (mr *MockAPIMockRecorder) CreateReadingSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReadingSession", reflect.TypeOf((*MockAPI)(nil).CreateReadingSession), ctx, session)
+}
+
 // CreateShelf mocks base method.
 func (m *MockAPI) CreateShelf(ctx context.Context, shelf *data.Shelf) (*data.Shelf, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +160,76 @@ func (mr *MockAPIMockRecorder) CreateShelf(ctx, shelf any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShelf", reflect.TypeOf((*MockAPI)(nil).CreateShelf), ctx, shelf)
 }
 
+// DeleteComment mocks base method.
+func (m *MockAPI) DeleteComment(ctx context.Context, userID, postID, commentID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, userID, postID, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockAPIMockRecorder) DeleteComment(ctx, userID, postID, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockAPI)(nil).DeleteComment), ctx, userID, postID, commentID)
+}
+
+// DeleteHighlight mocks base method.
+func (m *MockAPI) DeleteHighlight(ctx context.Context, userID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHighlight", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHighlight indicates an expected call of DeleteHighlight.
+func (mr *MockAPIMockRecorder) DeleteHighlight(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHighlight", reflect.TypeOf((*MockAPI)(nil).DeleteHighlight), ctx, userID, id)
+}
+
+// DeletePost mocks base method.
+func (m *MockAPI) DeletePost(ctx context.Context, userID, postID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePost", ctx, userID, postID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePost indicates an expected call of DeletePost.
+func (mr *MockAPIMockRecorder) DeletePost(ctx, userID, postID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePost", reflect.TypeOf((*MockAPI)(nil).DeletePost), ctx, userID, postID)
+}
+
+// DeleteReadingGoal mocks base method.
+func (m *MockAPI) DeleteReadingGoal(ctx context.Context, userID string, year int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReadingGoal", ctx, userID, year)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReadingGoal indicates an expected call of DeleteReadingGoal.
+func (mr *MockAPIMockRecorder) DeleteReadingGoal(ctx, userID, year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReadingGoal", reflect.TypeOf((*MockAPI)(nil).DeleteReadingGoal), ctx, userID, year)
+}
+
+// DeleteReadingSession mocks base method.
+func (m *MockAPI) DeleteReadingSession(ctx context.Context, userID, bookID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReadingSession", ctx, userID, bookID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReadingSession indicates an expected call of DeleteReadingSession.
+func (mr *MockAPIMockRecorder) DeleteReadingSession(ctx, userID, bookID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReadingSession", reflect.TypeOf((*MockAPI)(nil).DeleteReadingSession), ctx, userID, bookID, id)
+}
+
 // DeleteShelf mocks base method.
 func (m *MockAPI) DeleteShelf(ctx context.Context, userID, id string) error {
 	m.ctrl.T.Helper()
@@ -84,6 +244,50 @@ func (mr *MockAPIMockRecorder) DeleteShelf(ctx, userID, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShelf", reflect.TypeOf((*MockAPI)(nil).DeleteShelf), ctx, userID, id)
 }
 
+// ExportLibrary mocks base method.
+func (m *MockAPI) ExportLibrary(ctx context.Context, userID string, shelves func([]*data.Shelf) error, book func(*data.LibraryBook) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportLibrary", ctx, userID, shelves, book)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportLibrary indicates an expected call of ExportLibrary.
+func (mr *MockAPIMockRecorder) ExportLibrary(ctx, userID, shelves, book any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportLibrary", reflect.TypeOf((*MockAPI)(nil).ExportLibrary), ctx, userID, shelves, book)
+}
+
+// FindLibraryBookByTitle mocks base method.
+func (m *MockAPI) FindLibraryBookByTitle(ctx context.Context, userID, title, author string) (*data.LibraryBook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLibraryBookByTitle", ctx, userID, title, author)
+	ret0, _ := ret[0].(*data.LibraryBook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLibraryBookByTitle indicates an expected call of FindLibraryBookByTitle.
+func (mr *MockAPIMockRecorder) FindLibraryBookByTitle(ctx, userID, title, author any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLibraryBookByTitle", reflect.TypeOf((*MockAPI)(nil).FindLibraryBookByTitle), ctx, userID, title, author)
+}
+
+// FindLibraryWorks mocks base method.
+func (m *MockAPI) FindLibraryWorks(ctx context.Context, userID string, source int32, workKeys []string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLibraryWorks", ctx, userID, source, workKeys)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLibraryWorks indicates an expected call of FindLibraryWorks.
+func (mr *MockAPIMockRecorder) FindLibraryWorks(ctx, userID, source, workKeys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLibraryWorks", reflect.TypeOf((*MockAPI)(nil).FindLibraryWorks), ctx, userID, source, workKeys)
+}
+
 // FollowUser mocks base method.
 func (m *MockAPI) FollowUser(ctx context.Context, followerID, followeeID string) error {
 	m.ctrl.T.Helper()
@@ -98,19 +302,174 @@ func (mr *MockAPIMockRecorder) FollowUser(ctx, followerID, followeeID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowUser", reflect.TypeOf((*MockAPI)(nil).FollowUser), ctx, followerID, followeeID)
 }
 
-// GetShelfBooks mocks base method.
-func (m *MockAPI) GetShelfBooks(ctx context.Context, userID, shelfID string) ([]*data.LibraryBook, error) {
+// GetBook mocks base method.
+func (m *MockAPI) GetBook(ctx context.Context, source int32, externalID string) (*data.Book, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetShelfBooks", ctx, userID, shelfID)
-	ret0, _ := ret[0].([]*data.LibraryBook)
+	ret := m.ctrl.Call(m, "GetBook", ctx, source, externalID)
+	ret0, _ := ret[0].(*data.Book)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetBook indicates an expected call of GetBook.
+func (mr *MockAPIMockRecorder) GetBook(ctx, source, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBook", reflect.TypeOf((*MockAPI)(nil).GetBook), ctx, source, externalID)
+}
+
+// GetCommentsForPost mocks base method.
+func (m *MockAPI) GetCommentsForPost(ctx context.Context, postID string, page data.Page) ([]*data.Comment, int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentsForPost", ctx, postID, page)
+	ret0, _ := ret[0].([]*data.Comment)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCommentsForPost indicates an expected call of GetCommentsForPost.
+func (mr *MockAPIMockRecorder) GetCommentsForPost(ctx, postID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsForPost", reflect.TypeOf((*MockAPI)(nil).GetCommentsForPost), ctx, postID, page)
+}
+
+// GetExistingLibraryBookIDs mocks base method.
+func (m *MockAPI) GetExistingLibraryBookIDs(ctx context.Context, userID string, bookIDs []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExistingLibraryBookIDs", ctx, userID, bookIDs)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExistingLibraryBookIDs indicates an expected call of GetExistingLibraryBookIDs.
+func (mr *MockAPIMockRecorder) GetExistingLibraryBookIDs(ctx, userID, bookIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExistingLibraryBookIDs", reflect.TypeOf((*MockAPI)(nil).GetExistingLibraryBookIDs), ctx, userID, bookIDs)
+}
+
+// GetGoalBooks mocks base method.
+func (m *MockAPI) GetGoalBooks(ctx context.Context, userID string, year int32) ([]*data.GoalBook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoalBooks", ctx, userID, year)
+	ret0, _ := ret[0].([]*data.GoalBook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoalBooks indicates an expected call of GetGoalBooks.
+func (mr *MockAPIMockRecorder) GetGoalBooks(ctx, userID, year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoalBooks", reflect.TypeOf((*MockAPI)(nil).GetGoalBooks), ctx, userID, year)
+}
+
+// GetHighlight mocks base method.
+func (m *MockAPI) GetHighlight(ctx context.Context, userID, id string) (*data.Highlight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHighlight", ctx, userID, id)
+	ret0, _ := ret[0].(*data.Highlight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHighlight indicates an expected call of GetHighlight.
+func (mr *MockAPIMockRecorder) GetHighlight(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHighlight", reflect.TypeOf((*MockAPI)(nil).GetHighlight), ctx, userID, id)
+}
+
+// GetHighlights mocks base method.
+func (m *MockAPI) GetHighlights(ctx context.Context, userID, bookID string, publicOnly bool, page data.Page) ([]*data.Highlight, int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHighlights", ctx, userID, bookID, publicOnly, page)
+	ret0, _ := ret[0].([]*data.Highlight)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHighlights indicates an expected call of GetHighlights.
+func (mr *MockAPIMockRecorder) GetHighlights(ctx, userID, bookID, publicOnly, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHighlights", reflect.TypeOf((*MockAPI)(nil).GetHighlights), ctx, userID, bookID, publicOnly, page)
+}
+
+// GetPersonalizedFeed mocks base method.
+func (m *MockAPI) GetPersonalizedFeed(ctx context.Context, userID string, since *time.Time, page data.Page) ([]*data.Post, int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonalizedFeed", ctx, userID, since, page)
+	ret0, _ := ret[0].([]*data.Post)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPersonalizedFeed indicates an expected call of GetPersonalizedFeed.
+func (mr *MockAPIMockRecorder) GetPersonalizedFeed(ctx, userID, since, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonalizedFeed", reflect.TypeOf((*MockAPI)(nil).GetPersonalizedFeed), ctx, userID, since, page)
+}
+
+// GetReadingGoal mocks base method.
+func (m *MockAPI) GetReadingGoal(ctx context.Context, userID string, year int32) (*data.ReadingGoal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadingGoal", ctx, userID, year)
+	ret0, _ := ret[0].(*data.ReadingGoal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadingGoal indicates an expected call of GetReadingGoal.
+func (mr *MockAPIMockRecorder) GetReadingGoal(ctx, userID, year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadingGoal", reflect.TypeOf((*MockAPI)(nil).GetReadingGoal), ctx, userID, year)
+}
+
+// GetReadingProgress mocks base method.
+func (m *MockAPI) GetReadingProgress(ctx context.Context, userID, bookID string, page data.Page) ([]*data.ReadingProgress, int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadingProgress", ctx, userID, bookID, page)
+	ret0, _ := ret[0].([]*data.ReadingProgress)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetReadingProgress indicates an expected call of GetReadingProgress.
+func (mr *MockAPIMockRecorder) GetReadingProgress(ctx, userID, bookID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadingProgress", reflect.TypeOf((*MockAPI)(nil).GetReadingProgress), ctx, userID, bookID, page)
+}
+
+// GetReadingStats mocks base method.
+func (m *MockAPI) GetReadingStats(ctx context.Context, userID string, from, to *time.Time) (*data.ReadingStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadingStats", ctx, userID, from, to)
+	ret0, _ := ret[0].(*data.ReadingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadingStats indicates an expected call of GetReadingStats.
+func (mr *MockAPIMockRecorder) GetReadingStats(ctx, userID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadingStats", reflect.TypeOf((*MockAPI)(nil).GetReadingStats), ctx, userID, from, to)
+}
+
+// GetShelfBooks mocks base method.
+func (m *MockAPI) GetShelfBooks(ctx context.Context, userID, shelfID string, sort data.ShelfSortOrder, page data.Page) ([]*data.LibraryBook, int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShelfBooks", ctx, userID, shelfID, sort, page)
+	ret0, _ := ret[0].([]*data.LibraryBook)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // GetShelfBooks indicates an expected call of GetShelfBooks.
-func (mr *MockAPIMockRecorder) GetShelfBooks(ctx, userID, shelfID any) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetShelfBooks(ctx, userID, shelfID, sort, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShelfBooks", reflect.TypeOf((*MockAPI)(nil).GetShelfBooks), ctx, userID, shelfID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShelfBooks", reflect.TypeOf((*MockAPI)(nil).GetShelfBooks), ctx, userID, shelfID, sort, page)
 }
 
 // GetUserByID mocks base method.
@@ -128,19 +487,36 @@ func (mr *MockAPIMockRecorder) GetUserByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockAPI)(nil).GetUserByID), ctx, id)
 }
 
+// GetUserFeed mocks base method.
+func (m *MockAPI) GetUserFeed(ctx context.Context, userID string, page data.Page) ([]*data.Post, int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserFeed", ctx, userID, page)
+	ret0, _ := ret[0].([]*data.Post)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserFeed indicates an expected call of GetUserFeed.
+func (mr *MockAPIMockRecorder) GetUserFeed(ctx, userID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserFeed", reflect.TypeOf((*MockAPI)(nil).GetUserFeed), ctx, userID, page)
+}
+
 // GetUserLibrary mocks base method.
-func (m *MockAPI) GetUserLibrary(ctx context.Context, userID string) ([]*data.LibraryBook, error) {
+func (m *MockAPI) GetUserLibrary(ctx context.Context, userID string, page data.Page) ([]*data.LibraryBook, int32, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserLibrary", ctx, userID)
+	ret := m.ctrl.Call(m, "GetUserLibrary", ctx, userID, page)
 	ret0, _ := ret[0].([]*data.LibraryBook)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // GetUserLibrary indicates an expected call of GetUserLibrary.
-func (mr *MockAPIMockRecorder) GetUserLibrary(ctx, userID any) *gomock.Call {
+func (mr *MockAPIMockRecorder) GetUserLibrary(ctx, userID, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserLibrary", reflect.TypeOf((*MockAPI)(nil).GetUserLibrary), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserLibrary", reflect.TypeOf((*MockAPI)(nil).GetUserLibrary), ctx, userID, page)
 }
 
 // GetUserShelves mocks base method.
@@ -158,6 +534,79 @@ func (mr *MockAPIMockRecorder) GetUserShelves(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserShelves", reflect.TypeOf((*MockAPI)(nil).GetUserShelves), ctx, userID)
 }
 
+// ImportHighlight mocks base method.
+func (m *MockAPI) ImportHighlight(ctx context.Context, highlight *data.Highlight) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportHighlight", ctx, highlight)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportHighlight indicates an expected call of ImportHighlight.
+func (mr *MockAPIMockRecorder) ImportHighlight(ctx, highlight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportHighlight", reflect.TypeOf((*MockAPI)(nil).ImportHighlight), ctx, highlight)
+}
+
+// ImportLibraryBook mocks base method.
+func (m *MockAPI) ImportLibraryBook(ctx context.Context, book *data.LibraryBook, newShelves []*data.Shelf) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportLibraryBook", ctx, book, newShelves)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportLibraryBook indicates an expected call of ImportLibraryBook.
+func (mr *MockAPIMockRecorder) ImportLibraryBook(ctx, book, newShelves any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportLibraryBook", reflect.TypeOf((*MockAPI)(nil).ImportLibraryBook), ctx, book, newShelves)
+}
+
+// LikePost mocks base method.
+func (m *MockAPI) LikePost(ctx context.Context, userID, postID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LikePost", ctx, userID, postID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LikePost indicates an expected call of LikePost.
+func (mr *MockAPIMockRecorder) LikePost(ctx, userID, postID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikePost", reflect.TypeOf((*MockAPI)(nil).LikePost), ctx, userID, postID)
+}
+
+// LogReadingProgress mocks base method.
+func (m *MockAPI) LogReadingProgress(ctx context.Context, progress *data.ReadingProgress) (*data.LibraryBook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogReadingProgress", ctx, progress)
+	ret0, _ := ret[0].(*data.LibraryBook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogReadingProgress indicates an expected call of LogReadingProgress.
+func (mr *MockAPIMockRecorder) LogReadingProgress(ctx, progress any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogReadingProgress", reflect.TypeOf((*MockAPI)(nil).LogReadingProgress), ctx, progress)
+}
+
+// MarkBooksChecked mocks base method.
+func (m *MockAPI) MarkBooksChecked(ctx context.Context, source int32, externalIDs []string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkBooksChecked", ctx, source, externalIDs, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkBooksChecked indicates an expected call of MarkBooksChecked.
+func (mr *MockAPIMockRecorder) MarkBooksChecked(ctx, source, externalIDs, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkBooksChecked", reflect.TypeOf((*MockAPI)(nil).MarkBooksChecked), ctx, source, externalIDs, at)
+}
+
 // ProfileCreate mocks base method.
 func (m *MockAPI) ProfileCreate(ctx context.Context, profile *data.User) (*data.User, error) {
 	m.ctrl.T.Helper()
@@ -245,6 +694,51 @@ func (mr *MockAPIMockRecorder) RemoveLibraryBook(ctx, userID, bookID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveLibraryBook", reflect.TypeOf((*MockAPI)(nil).RemoveLibraryBook), ctx, userID, bookID)
 }
 
+// SearchLibrary mocks base method.
+func (m *MockAPI) SearchLibrary(ctx context.Context, userID string, search data.LibrarySearch, page data.Page) ([]*data.LibraryBook, int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchLibrary", ctx, userID, search, page)
+	ret0, _ := ret[0].([]*data.LibraryBook)
+	ret1, _ := ret[1].(int32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchLibrary indicates an expected call of SearchLibrary.
+func (mr *MockAPIMockRecorder) SearchLibrary(ctx, userID, search, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchLibrary", reflect.TypeOf((*MockAPI)(nil).SearchLibrary), ctx, userID, search, page)
+}
+
+// SetActivityPostsEnabled mocks base method.
+func (m *MockAPI) SetActivityPostsEnabled(ctx context.Context, id string, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActivityPostsEnabled", ctx, id, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetActivityPostsEnabled indicates an expected call of SetActivityPostsEnabled.
+func (mr *MockAPIMockRecorder) SetActivityPostsEnabled(ctx, id, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActivityPostsEnabled", reflect.TypeOf((*MockAPI)(nil).SetActivityPostsEnabled), ctx, id, enabled)
+}
+
+// SetReadingGoal mocks base method.
+func (m *MockAPI) SetReadingGoal(ctx context.Context, goal *data.ReadingGoal) (*data.ReadingGoal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReadingGoal", ctx, goal)
+	ret0, _ := ret[0].(*data.ReadingGoal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetReadingGoal indicates an expected call of SetReadingGoal.
+func (mr *MockAPIMockRecorder) SetReadingGoal(ctx, goal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadingGoal", reflect.TypeOf((*MockAPI)(nil).SetReadingGoal), ctx, goal)
+}
+
 // UnfollowUser mocks base method.
 func (m *MockAPI) UnfollowUser(ctx context.Context, followerID, followeeID string) error {
 	m.ctrl.T.Helper()
@@ -259,6 +753,35 @@ func (mr *MockAPIMockRecorder) UnfollowUser(ctx, followerID, followeeID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowUser", reflect.TypeOf((*MockAPI)(nil).UnfollowUser), ctx, followerID, followeeID)
 }
 
+// UnlikePost mocks base method.
+func (m *MockAPI) UnlikePost(ctx context.Context, userID, postID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlikePost", ctx, userID, postID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlikePost indicates an expected call of UnlikePost.
+func (mr *MockAPIMockRecorder) UnlikePost(ctx, userID, postID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlikePost", reflect.TypeOf((*MockAPI)(nil).UnlikePost), ctx, userID, postID)
+}
+
+// UpdateHighlight mocks base method.
+func (m *MockAPI) UpdateHighlight(ctx context.Context, highlight *data.Highlight) (*data.Highlight, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHighlight", ctx, highlight)
+	ret0, _ := ret[0].(*data.Highlight)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHighlight indicates an expected call of UpdateHighlight.
+func (mr *MockAPIMockRecorder) UpdateHighlight(ctx, highlight any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHighlight", reflect.TypeOf((*MockAPI)(nil).UpdateHighlight), ctx, highlight)
+}
+
 // UpdateLibraryBook mocks base method.
 func (m *MockAPI) UpdateLibraryBook(ctx context.Context, book *data.LibraryBook) error {
 	m.ctrl.T.Helper()
@@ -273,6 +796,36 @@ func (mr *MockAPIMockRecorder) UpdateLibraryBook(ctx, book any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLibraryBook", reflect.TypeOf((*MockAPI)(nil).UpdateLibraryBook), ctx, book)
 }
 
+// UpdatePost mocks base method.
+func (m *MockAPI) UpdatePost(ctx context.Context, post *data.Post) (*data.Post, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePost", ctx, post)
+	ret0, _ := ret[0].(*data.Post)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePost indicates an expected call of UpdatePost.
+func (mr *MockAPIMockRecorder) UpdatePost(ctx, post any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePost", reflect.TypeOf((*MockAPI)(nil).UpdatePost), ctx, post)
+}
+
+// UpdateReadingSession mocks base method.
+func (m *MockAPI) UpdateReadingSession(ctx context.Context, session *data.ReadingSession) (*data.ReadingSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReadingSession", ctx, session)
+	ret0, _ := ret[0].(*data.ReadingSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReadingSession indicates an expected call of UpdateReadingSession.
+func (mr *MockAPIMockRecorder) UpdateReadingSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReadingSession", reflect.TypeOf((*MockAPI)(nil).UpdateReadingSession), ctx, session)
+}
+
 // UpdateShelf mocks base method.
 func (m *MockAPI) UpdateShelf(ctx context.Context, shelf *data.Shelf) (*data.Shelf, error) {
 	m.ctrl.T.Helper()
@@ -287,3 +840,17 @@ func (mr *MockAPIMockRecorder) UpdateShelf(ctx, shelf any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShelf", reflect.TypeOf((*MockAPI)(nil).UpdateShelf), ctx, shelf)
 }
+
+// UpsertBooks mocks base method.
+func (m *MockAPI) UpsertBooks(ctx context.Context, books []*data.Book) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBooks", ctx, books)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertBooks indicates an expected call of UpsertBooks.
+func (mr *MockAPIMockRecorder) UpsertBooks(ctx, books any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBooks", reflect.TypeOf((*MockAPI)(nil).UpsertBooks), ctx, books)
+}
@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"errors"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+)
+
+var ErrInvalidCursor = errors.New("invalid page cursor")
+
+// cursorArgs returns the cursor's timestamp and id as query arguments. Both are
+// nil for offset based pages, so queries guard their keyset condition with
+// "$n::timestamptz IS NULL OR ...".
+func cursorArgs(c *data.Cursor) (any, any) {
+	if c == nil {
+		return nil, nil
+	}
+	return c.Time, c.ID
+}
+
+// offset returns the OFFSET for the page. Keyset pages never skip rows.
+func offset(page data.Page) int32 {
+	if page.After != nil {
+		return 0
+	}
+	return page.Offset
+}
@@ -0,0 +1,194 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+	ErrPostNotFound       = errors.New("post not found")
+	ErrNotPostAuthor      = errors.New("only the post author may modify the post")
+	ErrPostAuthorNotFound = errors.New("post author not found")
+)
+
+// postColumns is the column list scanned by scanPost.
+const postColumns = `
+	p.id, p.user_id, p.book_id, p.book_author, p.book_title, p.book_image, p.book_rating,
+	p.description, p.summary, p.like_count, p.comment_count, p.system_generated,
+	COALESCE(p.highlight_id::text, ''), p.quote, p.created_at, p.updated_at
+`
+
+func (db *Client) CreatePost(ctx context.Context, post *data.Post) (*data.Post, error) {
+	query := `
+		INSERT INTO posts AS p (id, user_id, book_id, book_author, book_title, book_image, book_rating, description, summary, system_generated, highlight_id, quote, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, '')::uuid, $12, $13, $14)
+		RETURNING ` + postColumns
+
+	created, err := insertPost(ctx, db.DB, query, post)
+	if err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && pgErr.Code == ForeignKeyViolation {
+			if pgErr.ConstraintName == "posts_highlight_id_fkey" {
+				return nil, ErrHighlightNotFound
+			}
+			return nil, ErrPostAuthorNotFound
+		}
+		return nil, fmt.Errorf("CreatePost: %w", err)
+	}
+
+	return created, nil
+}
+
+// querier is satisfied by both a connection and a transaction.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// insertPost runs query, an INSERT returning postColumns, with the post
+// fields in column order.
+func insertPost(ctx context.Context, q querier, query string, post *data.Post) (*data.Post, error) {
+	return scanPost(q.QueryRow(
+		ctx,
+		query,
+		post.ID,
+		post.UserID,
+		post.Book.ID,
+		post.Book.Author,
+		post.Book.Title,
+		post.Book.ImageURL,
+		post.Book.Rating,
+		post.Description,
+		post.Summary,
+		post.SystemGenerated,
+		post.HighlightID,
+		post.Quote,
+		post.CreatedAt,
+		post.UpdatedAt,
+	))
+}
+
+func (db *Client) UpdatePost(ctx context.Context, post *data.Post) (*data.Post, error) {
+	tx, err := db.DB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("UpdatePost: failed to start transaction: %w", err)
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			logger.Error("UpdatePost: failed to rollback transaction", "post_id", post.ID, "error", rollbackErr)
+		}
+	}()
+
+	if err := lockPostForAuthor(ctx, tx, post.ID, post.UserID); err != nil {
+		return nil, err
+	}
+
+	query := `
+		UPDATE posts AS p
+		SET book_id = $2,
+			book_author = $3,
+			book_title = $4,
+			book_image = $5,
+			book_rating = $6,
+			description = $7,
+			summary = $8,
+			updated_at = $9
+		WHERE p.id = $1
+		RETURNING ` + postColumns
+
+	updated, err := scanPost(tx.QueryRow(
+		ctx,
+		query,
+		post.ID,
+		post.Book.ID,
+		post.Book.Author,
+		post.Book.Title,
+		post.Book.ImageURL,
+		post.Book.Rating,
+		post.Description,
+		post.Summary,
+		post.UpdatedAt,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("UpdatePost: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("UpdatePost: committing transaction: %w", err)
+	}
+
+	return updated, nil
+}
+
+func (db *Client) DeletePost(ctx context.Context, userID, postID string) error {
+	tx, err := db.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("DeletePost: failed to start transaction: %w", err)
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			logger.Error("DeletePost: failed to rollback transaction", "post_id", postID, "error", rollbackErr)
+		}
+	}()
+
+	if err := lockPostForAuthor(ctx, tx, postID, userID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM posts WHERE id = $1`, postID); err != nil {
+		return fmt.Errorf("DeletePost: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("DeletePost: committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// lockPostForAuthor locks the post row for the rest of the transaction and
+// verifies that userID wrote it.
+func lockPostForAuthor(ctx context.Context, tx pgx.Tx, postID, userID string) error {
+	var authorID string
+	err := tx.QueryRow(ctx, `SELECT user_id FROM posts WHERE id = $1 FOR UPDATE`, postID).Scan(&authorID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrPostNotFound
+		}
+		return fmt.Errorf("lock post: %w", err)
+	}
+	if authorID != userID {
+		return ErrNotPostAuthor
+	}
+	return nil
+}
+
+func scanPost(row pgx.Row) (*data.Post, error) {
+	var p data.Post
+	if err := row.Scan(
+		&p.ID,
+		&p.UserID,
+		&p.Book.ID,
+		&p.Book.Author,
+		&p.Book.Title,
+		&p.Book.ImageURL,
+		&p.Book.Rating,
+		&p.Description,
+		&p.Summary,
+		&p.LikeCount,
+		&p.CommentCount,
+		&p.SystemGenerated,
+		&p.HighlightID,
+		&p.Quote,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
@@ -4,9 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/celestialdragonfly/betterreads/internal/data"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 type API interface {
@@ -14,21 +15,77 @@ type API interface {
 	ProfileGet(ctx context.Context, id string) (*data.User, error)
 	ProfileUpdate(ctx context.Context, id string, updates *data.User) (*data.User, error)
 	ProfileDelete(ctx context.Context, id string) error
+	SetActivityPostsEnabled(ctx context.Context, id string, enabled bool) error
 	GetUserByID(ctx context.Context, id string) (*data.User, error)
 	FollowUser(ctx context.Context, followerID, followeeID string) error
 	UnfollowUser(ctx context.Context, followerID, followeeID string) error
 
+	// Catalog
+	CreateBook(ctx context.Context, book *data.Book) error
+	UpsertBooks(ctx context.Context, books []*data.Book) error
+	GetBook(ctx context.Context, source int32, externalID string) (*data.Book, error)
+	ClaimStaleBooks(ctx context.Context, source int32, checkedBefore, at time.Time, limit int32) ([]*data.Book, error)
+	MarkBooksChecked(ctx context.Context, source int32, externalIDs []string, at time.Time) error
+
 	// Library
 	CreateShelf(ctx context.Context, shelf *data.Shelf) (*data.Shelf, error)
 	UpdateShelf(ctx context.Context, shelf *data.Shelf) (*data.Shelf, error)
 	DeleteShelf(ctx context.Context, userID, id string) error
 	GetUserShelves(ctx context.Context, userID string) ([]*data.Shelf, error)
-	GetShelfBooks(ctx context.Context, userID, shelfID string) ([]*data.LibraryBook, error)
+	GetShelfBooks(ctx context.Context, userID, shelfID string, sort data.ShelfSortOrder, page data.Page) ([]*data.LibraryBook, int32, error)
 	UpdateLibraryBook(ctx context.Context, book *data.LibraryBook) error
+	ImportLibraryBook(ctx context.Context, book *data.LibraryBook, newShelves []*data.Shelf) (bool, error)
+	GetExistingLibraryBookIDs(ctx context.Context, userID string, bookIDs []string) ([]string, error)
+	FindLibraryBookByTitle(ctx context.Context, userID, title, author string) (*data.LibraryBook, error)
+	FindLibraryWorks(ctx context.Context, userID string, source int32, workKeys []string) (map[string]string, error)
 	RemoveLibraryBook(ctx context.Context, userID, bookID string) error
-	GetUserLibrary(ctx context.Context, userID string) ([]*data.LibraryBook, error)
+	GetUserLibrary(ctx context.Context, userID string, page data.Page) ([]*data.LibraryBook, int32, error)
+	SearchLibrary(ctx context.Context, userID string, search data.LibrarySearch, page data.Page) ([]*data.LibraryBook, int32, error)
+	ExportLibrary(ctx context.Context, userID string, shelves func([]*data.Shelf) error, book func(*data.LibraryBook) error) error
 	AddBookToShelf(ctx context.Context, userID, bookID, shelfID string) error
 	RemoveBookFromShelf(ctx context.Context, userID, bookID, shelfID string) error
+
+	// Reading progress
+	LogReadingProgress(ctx context.Context, progress *data.ReadingProgress) (*data.LibraryBook, error)
+	GetReadingProgress(ctx context.Context, userID, bookID string, page data.Page) ([]*data.ReadingProgress, int32, error)
+
+	// Reading sessions
+	CreateReadingSession(ctx context.Context, session *data.ReadingSession) (*data.ReadingSession, error)
+	UpdateReadingSession(ctx context.Context, session *data.ReadingSession) (*data.ReadingSession, error)
+	DeleteReadingSession(ctx context.Context, userID, bookID, id string) error
+
+	// Reading goals
+	SetReadingGoal(ctx context.Context, goal *data.ReadingGoal) (*data.ReadingGoal, error)
+	GetReadingGoal(ctx context.Context, userID string, year int32) (*data.ReadingGoal, error)
+	DeleteReadingGoal(ctx context.Context, userID string, year int32) error
+	GetGoalBooks(ctx context.Context, userID string, year int32) ([]*data.GoalBook, error)
+
+	// Highlights
+	CreateHighlight(ctx context.Context, highlight *data.Highlight) (*data.Highlight, error)
+	ImportHighlight(ctx context.Context, highlight *data.Highlight) (bool, error)
+	UpdateHighlight(ctx context.Context, highlight *data.Highlight) (*data.Highlight, error)
+	DeleteHighlight(ctx context.Context, userID, id string) error
+	GetHighlight(ctx context.Context, userID, id string) (*data.Highlight, error)
+	GetHighlights(ctx context.Context, userID, bookID string, publicOnly bool, page data.Page) ([]*data.Highlight, int32, error)
+
+	// Statistics
+	GetReadingStats(ctx context.Context, userID string, from, to *time.Time) (*data.ReadingStats, error)
+
+	// Posts
+	CreatePost(ctx context.Context, post *data.Post) (*data.Post, error)
+	UpdatePost(ctx context.Context, post *data.Post) (*data.Post, error)
+	DeletePost(ctx context.Context, userID, postID string) error
+	GetPersonalizedFeed(ctx context.Context, userID string, since *time.Time, page data.Page) ([]*data.Post, int32, error)
+	GetUserFeed(ctx context.Context, userID string, page data.Page) ([]*data.Post, int32, error)
+
+	// Comments
+	GetCommentsForPost(ctx context.Context, postID string, page data.Page) ([]*data.Comment, int32, error)
+	AddComment(ctx context.Context, comment *data.Comment) (*data.Comment, error)
+	DeleteComment(ctx context.Context, userID, postID, commentID string) error
+
+	// Likes
+	LikePost(ctx context.Context, userID, postID string) error
+	UnlikePost(ctx context.Context, userID, postID string) error
 }
 
 var (
@@ -36,38 +93,65 @@ var (
 	ErrUnableToPing    = errors.New("unable to ping Postgres DB")
 )
 
+// Config configures the connection pool. Zero values keep the pgxpool
+// defaults.
+type Config struct {
+	URL               string
+	MaxConns          int32
+	MinConns          int32
+	MaxConnLifetime   time.Duration
+	MaxConnIdleTime   time.Duration
+	HealthCheckPeriod time.Duration
+	// SkipMigrations connects without applying pending migrations.
+	SkipMigrations bool
+}
+
 type Client struct {
-	DB *pgx.Conn
+	DB *pgxpool.Pool
 }
 
-func NewClient(ctx context.Context, dsn string) (*Client, error) {
-	db, err := pgx.Connect(ctx, dsn)
+func NewClient(ctx context.Context, cfg Config) (*Client, error) {
+	poolConfig, err := pgxpool.ParseConfig(cfg.URL)
+	if err != nil {
+		return nil, fmt.Errorf("NewClient postgress: %w: %w", ErrUnableToConnect, err)
+	}
+	if cfg.MaxConns > 0 {
+		poolConfig.MaxConns = cfg.MaxConns
+	}
+	if cfg.MinConns > 0 {
+		poolConfig.MinConns = cfg.MinConns
+	}
+	if cfg.MaxConnLifetime > 0 {
+		poolConfig.MaxConnLifetime = cfg.MaxConnLifetime
+	}
+	if cfg.MaxConnIdleTime > 0 {
+		poolConfig.MaxConnIdleTime = cfg.MaxConnIdleTime
+	}
+	if cfg.HealthCheckPeriod > 0 {
+		poolConfig.HealthCheckPeriod = cfg.HealthCheckPeriod
+	}
+
+	db, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("NewClient postgress: %w", ErrUnableToConnect)
 	}
 
 	if err := db.Ping(ctx); err != nil {
+		db.Close()
 		return nil, fmt.Errorf("NewClient postgress: %w", ErrUnableToPing)
 	}
 
-	if err := migrate(ctx, db); err != nil {
-		return nil, fmt.Errorf("NewClient postgress: %w", err)
+	if !cfg.SkipMigrations {
+		if _, err := MigrateUp(ctx, db); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("NewClient postgress: %w", err)
+		}
 	}
 	return &Client{DB: db}, nil
 }
 
-var registers = []func(context.Context, *pgx.Conn) error{
-	registerUser,
-	registerFollows,
-	registerLibrary,
-}
-
-func migrate(ctx context.Context, db *pgx.Conn) error {
-	for _, f := range registers {
-		err := f(ctx, db)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+// Close closes every connection in the pool, waiting for acquired connections
+// to be released.
+func (db *Client) Close() {
+	db.DB.Close()
 }
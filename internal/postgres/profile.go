@@ -39,10 +39,13 @@ func (db *Client) ProfileCreate(ctx context.Context, profile *data.User) (*data.
 	query := `
 		INSERT INTO users (id, username, first_name, last_name, email, profile_photo)
 		VALUES ($1, $2, $3, $4, $5, $6)
-		RETURNING created_at;
+		RETURNING created_at, activity_posts_enabled;
 	`
 
-	var createdAt time.Time
+	var (
+		createdAt            time.Time
+		activityPostsEnabled bool
+	)
 
 	err = tx.QueryRow(
 		ctx,
@@ -53,7 +56,7 @@ func (db *Client) ProfileCreate(ctx context.Context, profile *data.User) (*data.
 		profile.LastName,
 		profile.Email,
 		profile.ProfilePhotoURL,
-	).Scan(&createdAt)
+	).Scan(&createdAt, &activityPostsEnabled)
 	if err != nil {
 		pgErr := &pgconn.PgError{}
 		if errors.As(err, &pgErr) {
@@ -73,6 +76,7 @@ func (db *Client) ProfileCreate(ctx context.Context, profile *data.User) (*data.
 	}
 
 	profile.CreatedAt = createdAt
+	profile.ActivityPostsEnabled = activityPostsEnabled
 
 	// Commit the transaction
 	if err = tx.Commit(ctx); err != nil {
@@ -84,7 +88,7 @@ func (db *Client) ProfileCreate(ctx context.Context, profile *data.User) (*data.
 
 func (db *Client) ProfileGet(ctx context.Context, id string) (*data.User, error) {
 	query := `
-		SELECT id, username, first_name, last_name, email, profile_photo, created_at
+		SELECT id, username, first_name, last_name, email, profile_photo, created_at, activity_posts_enabled
 		FROM users
 		WHERE id = $1;
 	`
@@ -99,6 +103,7 @@ func (db *Client) ProfileGet(ctx context.Context, id string) (*data.User, error)
 		&user.Email,
 		&user.ProfilePhotoURL,
 		&user.CreatedAt,
+		&user.ActivityPostsEnabled,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -151,7 +156,7 @@ func (db *Client) ProfileUpdate(ctx context.Context, id string, updates *data.Us
 		UPDATE users
 		SET %s
 		WHERE id = $%d
-		RETURNING id, username, first_name, last_name, email, profile_photo, created_at
+		RETURNING id, username, first_name, last_name, email, profile_photo, created_at, activity_posts_enabled
 	`,
 		strings.Join(setClauses, ", "),
 		argPos,
@@ -169,6 +174,7 @@ func (db *Client) ProfileUpdate(ctx context.Context, id string, updates *data.Us
 		&updated.Email,
 		&updated.ProfilePhotoURL,
 		&updated.CreatedAt,
+		&updated.ActivityPostsEnabled,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -194,15 +200,18 @@ func (db *Client) ProfileUpdate(ctx context.Context, id string, updates *data.Us
 	return &updated, nil
 }
 
-func (db *Client) ProfileDelete(ctx context.Context, id string) error {
+// SetActivityPostsEnabled opts the user in or out of posts generated from
+// library changes.
+func (db *Client) SetActivityPostsEnabled(ctx context.Context, id string, enabled bool) error {
 	query := `
-		DELETE FROM users
+		UPDATE users
+		SET activity_posts_enabled = $2
 		WHERE id = $1
 	`
 
-	tag, err := db.DB.Exec(ctx, query, id)
+	tag, err := db.DB.Exec(ctx, query, id, enabled)
 	if err != nil {
-		return fmt.Errorf("%w: %w", ErrDeleteUser, err)
+		return fmt.Errorf("%w: %w", ErrUpdateUser, err)
 	}
 
 	if tag.RowsAffected() == 0 {
@@ -212,22 +221,20 @@ func (db *Client) ProfileDelete(ctx context.Context, id string) error {
 	return nil
 }
 
-var ErrUnableToCreateUserTable = fmt.Errorf("failed to register user table")
-
-func registerUser(ctx context.Context, db *pgx.Conn) error {
-	createUsersTable := `
-	CREATE TABLE IF NOT EXISTS users (
-		id UUID PRIMARY KEY,
-		username TEXT NOT NULL UNIQUE,
-		first_name TEXT NOT NULL,
-		last_name TEXT NOT NULL,
-		email TEXT NOT NULL UNIQUE,
-		profile_photo TEXT,
-		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
-	);
-`
-	if _, err := db.Exec(ctx, createUsersTable); err != nil {
-		return fmt.Errorf("%w: %w", ErrUnableToCreateUserTable, err)
+func (db *Client) ProfileDelete(ctx context.Context, id string) error {
+	query := `
+		DELETE FROM users
+		WHERE id = $1
+	`
+
+	tag, err := db.DB.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrDeleteUser, err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return ErrUserNotFound
 	}
+
 	return nil
 }
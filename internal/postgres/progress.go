@@ -0,0 +1,181 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/logger"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrTotalPagesUnknown is returned when progress is logged as a page number
+// but neither the entry, an earlier one nor the catalog gives the book's page
+// count.
+var ErrTotalPagesUnknown = errors.New("total pages unknown")
+
+// ErrPageBeyondTotal is returned when progress is logged past the last page.
+var ErrPageBeyondTotal = errors.New("page is beyond total pages")
+
+// finishedPercent is the progress at which a book counts as read.
+const finishedPercent = 100
+
+// bookPagesJoin joins rp.total_pages, the latest page count given with
+// progress on lb, or NULL when there is none.
+const bookPagesJoin = `
+	LEFT JOIN LATERAL (
+		SELECT total_pages
+		FROM reading_progress
+		WHERE user_id = lb.user_id AND book_id = lb.book_id AND total_pages > 0
+		ORDER BY created_at DESC, id DESC
+		LIMIT 1
+	) rp ON TRUE
+`
+
+// LogReadingProgress records progress on a book in the user's library and
+// returns the book afterwards. Entries logged as a page number without a
+// page count use the count from the latest entry that had one, or else the
+// catalog's, and their percent is filled in. The book moves to reading on its first entry, unless
+// it was already read, and to read once progress reaches 100%, announcing the
+// change like UpdateLibraryBook does. A re-read is started by setting the
+// status, not by logging progress.
+func (db *Client) LogReadingProgress(ctx context.Context, progress *data.ReadingProgress) (*data.LibraryBook, error) {
+	tx, err := db.DB.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("LogReadingProgress: failed to start transaction: %w", err)
+	}
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			logger.Error("LogReadingProgress: failed to rollback transaction", "book_id", progress.BookID, "error", rollbackErr)
+		}
+	}()
+
+	query := `SELECT ` + libraryBookColumns + ` FROM library_books lb ` + catalogJoin + ` WHERE lb.user_id = $1 AND lb.book_id = $2 FOR UPDATE OF lb`
+	book, err := scanLibraryBook(tx.QueryRow(ctx, query, progress.UserID, progress.BookID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrBookNotFound
+		}
+		return nil, fmt.Errorf("LogReadingProgress: %w", err)
+	}
+
+	var first bool
+	var lastTotalPages int32
+	query = `
+		SELECT NOT EXISTS (SELECT 1 FROM reading_progress WHERE user_id = $1 AND book_id = $2),
+			COALESCE(rp.total_pages, b.page_count)
+		FROM library_books lb
+		` + catalogJoin + bookPagesJoin + `
+		WHERE lb.user_id = $1 AND lb.book_id = $2
+	`
+	if err := tx.QueryRow(ctx, query, progress.UserID, progress.BookID).Scan(&first, &lastTotalPages); err != nil {
+		return nil, fmt.Errorf("LogReadingProgress (history): %w", err)
+	}
+
+	if progress.Page > 0 {
+		if progress.TotalPages == 0 {
+			progress.TotalPages = lastTotalPages
+		}
+		switch {
+		case progress.TotalPages == 0:
+			return nil, ErrTotalPagesUnknown
+		case progress.Page > progress.TotalPages:
+			return nil, ErrPageBeyondTotal
+		}
+		progress.Percent = float64(progress.Page) / float64(progress.TotalPages) * finishedPercent
+	}
+
+	query = `
+		INSERT INTO reading_progress (id, user_id, book_id, page, total_pages, percent, note, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+	_, err = tx.Exec(ctx, query,
+		progress.ID,
+		progress.UserID,
+		progress.BookID,
+		progress.Page,
+		progress.TotalPages,
+		progress.Percent,
+		progress.Note,
+		progress.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("LogReadingProgress: %w", err)
+	}
+
+	status := book.ReadingStatus
+	switch {
+	case progress.Percent >= finishedPercent:
+		status = data.ReadingStatusRead
+	case first && book.ReadingStatus != data.ReadingStatusRead:
+		status = data.ReadingStatusReading
+	}
+	if status != book.ReadingStatus {
+		previous := &libraryBookState{ReadingStatus: book.ReadingStatus, Rating: book.Rating}
+		book.ReadingStatus = status
+		book.UpdatedAt = progress.CreatedAt
+
+		query = `UPDATE library_books SET reading_status = $3, updated_at = $4 WHERE user_id = $1 AND book_id = $2`
+		if _, err := tx.Exec(ctx, query, book.UserID, book.BookID, book.ReadingStatus, book.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("LogReadingProgress (status): %w", err)
+		}
+		if err := syncReadingSession(ctx, tx, previous, book, progress.CreatedAt); err != nil {
+			return nil, fmt.Errorf("LogReadingProgress: %w", err)
+		}
+		if err := createActivityPost(ctx, tx, previous, book); err != nil {
+			return nil, fmt.Errorf("LogReadingProgress (activity post): %w", err)
+		}
+
+		query = `SELECT ` + libraryBookColumns + ` FROM library_books lb ` + catalogJoin + ` WHERE lb.user_id = $1 AND lb.book_id = $2`
+		if book, err = scanLibraryBook(tx.QueryRow(ctx, query, book.UserID, book.BookID)); err != nil {
+			return nil, fmt.Errorf("LogReadingProgress: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("LogReadingProgress: committing transaction: %w", err)
+	}
+	return book, nil
+}
+
+// GetReadingProgress returns a page of the user's progress entries for a
+// book, newest first, along with the total number of entries. It returns
+// ErrBookNotFound if the book is not in the user's library.
+func (db *Client) GetReadingProgress(ctx context.Context, userID, bookID string, page data.Page) ([]*data.ReadingProgress, int32, error) {
+	countQuery := `
+		SELECT (SELECT COUNT(*) FROM reading_progress rp WHERE rp.user_id = lb.user_id AND rp.book_id = lb.book_id)
+		FROM library_books lb
+		WHERE lb.user_id = $1 AND lb.book_id = $2
+	`
+	var total int32
+	if err := db.DB.QueryRow(ctx, countQuery, userID, bookID).Scan(&total); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, 0, ErrBookNotFound
+		}
+		return nil, 0, fmt.Errorf("GetReadingProgress (count): %w", err)
+	}
+
+	query := `
+		SELECT id, user_id, book_id, page, total_pages, percent, note, created_at
+		FROM reading_progress
+		WHERE user_id = $1 AND book_id = $2
+		  AND ($5::timestamptz IS NULL OR (created_at, id) < ($5, $6::uuid))
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`
+	afterTime, afterID := cursorArgs(page.After)
+	rows, err := db.DB.Query(ctx, query, userID, bookID, page.Limit, offset(page), afterTime, afterID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("GetReadingProgress: %w", err)
+	}
+	entries, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*data.ReadingProgress, error) {
+		var p data.ReadingProgress
+		err := row.Scan(&p.ID, &p.UserID, &p.BookID, &p.Page, &p.TotalPages, &p.Percent, &p.Note, &p.CreatedAt)
+		return &p, err
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("GetReadingProgress: %w", err)
+	}
+	return entries, total, nil
+}
@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_LogReadingProgress(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	start := time.Now().Add(-time.Hour)
+	seedLibraryBook(t, client, user.ID, "OL456M", "Dune", "Frank Herbert", start)
+
+	logProgress := func(page, totalPages int32, percent float64, at time.Time) (*data.ReadingProgress, *data.LibraryBook, error) {
+		t.Helper()
+		progress := &data.ReadingProgress{
+			ID:         uuid.New().String(),
+			UserID:     user.ID,
+			BookID:     "OL456M",
+			Page:       page,
+			TotalPages: totalPages,
+			Percent:    percent,
+			CreatedAt:  at,
+		}
+		book, err := client.LogReadingProgress(ctx, progress)
+		return progress, book, err
+	}
+
+	_, _, err := logProgress(10, 0, 0, start)
+	require.ErrorIs(t, err, ErrTotalPagesUnknown)
+
+	// The first entry starts the book.
+	progress, book, err := logProgress(100, 400, 0, start.Add(time.Minute))
+	require.NoError(t, err)
+	assert.InDelta(t, 25, progress.Percent, 0.001)
+	assert.Equal(t, data.ReadingStatusReading, book.ReadingStatus)
+
+	// Later entries reuse the page count.
+	progress, _, err = logProgress(200, 0, 0, start.Add(2*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int32(400), progress.TotalPages)
+	assert.InDelta(t, 50, progress.Percent, 0.001)
+
+	_, _, err = logProgress(500, 0, 0, start.Add(3*time.Minute))
+	require.ErrorIs(t, err, ErrPageBeyondTotal)
+
+	_, book, err = logProgress(0, 0, 100, start.Add(4*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, data.ReadingStatusRead, book.ReadingStatus)
+
+	history, total, err := client.GetReadingProgress(ctx, user.ID, "OL456M", data.Page{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), total)
+	require.Len(t, history, 2)
+	assert.InDelta(t, 100, history[0].Percent, 0)
+	assert.Equal(t, int32(200), history[1].Page)
+
+	after := &data.Cursor{Time: history[1].CreatedAt, ID: history[1].ID}
+	history, _, err = client.GetReadingProgress(ctx, user.ID, "OL456M", data.Page{Limit: 2, After: after})
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, int32(100), history[0].Page)
+
+	// Both status changes were announced.
+	posts, _, err := client.GetUserFeed(ctx, user.ID, data.Page{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, posts, 3)
+	assert.Equal(t, "Finished reading Dune", posts[0].Description)
+	assert.Equal(t, "Started reading Dune", posts[1].Description)
+
+	_, err = client.LogReadingProgress(ctx, &data.ReadingProgress{
+		ID: uuid.New().String(), UserID: user.ID, BookID: "missing", Percent: 10, CreatedAt: time.Now(),
+	})
+	require.ErrorIs(t, err, ErrBookNotFound)
+
+	_, _, err = client.GetReadingProgress(ctx, user.ID, "missing", data.Page{Limit: 2})
+	require.ErrorIs(t, err, ErrBookNotFound)
+}
+
+func TestClient_LogReadingProgress_CatalogPageCount(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	start := time.Now().Add(-time.Hour)
+	seedLibraryBook(t, client, user.ID, "OL456M", "Dune", "Frank Herbert", start)
+	require.NoError(t, client.UpsertBooks(ctx, []*data.Book{{
+		Source: data.BookSourceOpenLibrary, ExternalID: "OL456M", Title: "Dune", AuthorName: "Frank Herbert", PageCount: 400, UpdatedAt: start,
+	}}))
+
+	// Without a page count on any entry, the catalog's is used.
+	progress := &data.ReadingProgress{
+		ID: uuid.New().String(), UserID: user.ID, BookID: "OL456M", Page: 100, CreatedAt: start.Add(time.Minute),
+	}
+	book, err := client.LogReadingProgress(ctx, progress)
+	require.NoError(t, err)
+	assert.Equal(t, int32(400), progress.TotalPages)
+	assert.InDelta(t, 25, progress.Percent, 0.001)
+	assert.Equal(t, data.ReadingStatusReading, book.ReadingStatus)
+
+	// Reaching the catalog's last page finishes the book.
+	book, err = client.LogReadingProgress(ctx, &data.ReadingProgress{
+		ID: uuid.New().String(), UserID: user.ID, BookID: "OL456M", Page: 400, CreatedAt: start.Add(2 * time.Minute),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, data.ReadingStatusRead, book.ReadingStatus)
+}
+
+func TestClient_LogReadingProgress_ReadBook(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	start := time.Now().Add(-time.Hour)
+	require.NoError(t, client.UpdateLibraryBook(ctx, &data.LibraryBook{
+		UserID:        user.ID,
+		BookID:        "OL456M",
+		Title:         "Dune",
+		AuthorName:    "Frank Herbert",
+		Source:        data.BookSourceOpenLibrary,
+		ReadingStatus: data.ReadingStatusRead,
+		AddedAt:       start,
+		UpdatedAt:     start,
+	}))
+
+	// Progress on a book already read, such as one finished before progress
+	// was logged, does not start it again.
+	book, err := client.LogReadingProgress(ctx, &data.ReadingProgress{
+		ID: uuid.New().String(), UserID: user.ID, BookID: "OL456M", Percent: 40, CreatedAt: start.Add(time.Minute),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, data.ReadingStatusRead, book.ReadingStatus)
+	require.Len(t, book.Sessions, 1)
+	assert.NotNil(t, book.Sessions[0].FinishedAt)
+}
@@ -0,0 +1,228 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var ErrReadingSessionNotFound = errors.New("reading session not found")
+
+// readingSessionsColumn aggregates a library book's sessions, oldest first,
+// for libraryBookColumns. It is decoded by decodeReadingSessions.
+const readingSessionsColumn = `
+	COALESCE((
+		SELECT json_agg(json_build_object(
+			'id', rs.id,
+			'started_at', rs.started_at,
+			'finished_at', rs.finished_at,
+			'did_not_finish', rs.did_not_finish,
+			'rating', rs.rating,
+			'created_at', rs.created_at,
+			'updated_at', rs.updated_at
+		) ORDER BY COALESCE(rs.started_at, rs.finished_at, rs.created_at), rs.id)
+		FROM reading_sessions rs
+		WHERE rs.user_id = lb.user_id AND rs.book_id = lb.book_id
+	), '[]') AS sessions
+`
+
+type readingSessionJSON struct {
+	ID           string     `json:"id"`
+	StartedAt    *time.Time `json:"started_at"`
+	FinishedAt   *time.Time `json:"finished_at"`
+	DidNotFinish bool       `json:"did_not_finish"`
+	Rating       int32      `json:"rating"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// decodeReadingSessions sets book.Sessions from readingSessionsColumn.
+func decodeReadingSessions(book *data.LibraryBook, raw []byte) error {
+	var sessions []readingSessionJSON
+	if err := json.Unmarshal(raw, &sessions); err != nil {
+		return fmt.Errorf("decode reading sessions: %w", err)
+	}
+	book.Sessions = make([]*data.ReadingSession, 0, len(sessions))
+	for _, s := range sessions {
+		book.Sessions = append(book.Sessions, &data.ReadingSession{
+			ID:           s.ID,
+			UserID:       book.UserID,
+			BookID:       book.BookID,
+			StartedAt:    s.StartedAt,
+			FinishedAt:   s.FinishedAt,
+			DidNotFinish: s.DidNotFinish,
+			Rating:       s.Rating,
+			CreatedAt:    s.CreatedAt,
+			UpdatedAt:    s.UpdatedAt,
+		})
+	}
+	return nil
+}
+
+// syncReadingSession keeps the book's sessions in step with a change of
+// reading status made at the given time. Starting to read opens a session;
+// finishing or abandoning the book closes the open one, or records a session
+// ending then if none was open. A new rating on a book that stays read goes
+// to its latest finished session.
+func syncReadingSession(ctx context.Context, tx pgx.Tx, previous *libraryBookState, book *data.LibraryBook, at time.Time) error {
+	var before libraryBookState
+	if previous != nil {
+		before = *previous
+	}
+
+	if book.ReadingStatus == before.ReadingStatus {
+		if book.ReadingStatus != data.ReadingStatusRead || book.Rating == before.Rating {
+			return nil
+		}
+		query := `
+			UPDATE reading_sessions SET rating = $3, updated_at = $4
+			WHERE id = (
+				SELECT id FROM reading_sessions
+				WHERE user_id = $1 AND book_id = $2 AND finished_at IS NOT NULL AND NOT did_not_finish
+				ORDER BY finished_at DESC, id DESC
+				LIMIT 1
+			)
+		`
+		if _, err := tx.Exec(ctx, query, book.UserID, book.BookID, book.Rating, at); err != nil {
+			return fmt.Errorf("rate reading session: %w", err)
+		}
+		return nil
+	}
+
+	switch book.ReadingStatus {
+	case data.ReadingStatusReading:
+		query := `
+			INSERT INTO reading_sessions (id, user_id, book_id, started_at, created_at, updated_at)
+			SELECT $1, $2, $3, $4, $4, $4
+			WHERE NOT EXISTS (
+				SELECT 1 FROM reading_sessions
+				WHERE user_id = $2 AND book_id = $3 AND finished_at IS NULL
+			)
+		`
+		if _, err := tx.Exec(ctx, query, uuid.New().String(), book.UserID, book.BookID, at); err != nil {
+			return fmt.Errorf("start reading session: %w", err)
+		}
+
+	case data.ReadingStatusRead, data.ReadingStatusDidNotFinish:
+		dnf := book.ReadingStatus == data.ReadingStatusDidNotFinish
+		query := `
+			UPDATE reading_sessions SET finished_at = $3, did_not_finish = $4, rating = $5, updated_at = $3
+			WHERE id = (
+				SELECT id FROM reading_sessions
+				WHERE user_id = $1 AND book_id = $2 AND finished_at IS NULL
+				ORDER BY created_at DESC, id DESC
+				LIMIT 1
+			)
+		`
+		tag, err := tx.Exec(ctx, query, book.UserID, book.BookID, at, dnf, book.Rating)
+		if err != nil {
+			return fmt.Errorf("finish reading session: %w", err)
+		}
+		if tag.RowsAffected() > 0 {
+			return nil
+		}
+		query = `
+			INSERT INTO reading_sessions (id, user_id, book_id, finished_at, did_not_finish, rating, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $4, $4)
+		`
+		if _, err := tx.Exec(ctx, query, uuid.New().String(), book.UserID, book.BookID, at, dnf, book.Rating); err != nil {
+			return fmt.Errorf("finish reading session: %w", err)
+		}
+	}
+	return nil
+}
+
+// insertReadingSessions stores sessions read from an import alongside the
+// book they belong to.
+func insertReadingSessions(ctx context.Context, tx pgx.Tx, sessions []*data.ReadingSession) error {
+	for _, s := range sessions {
+		if err := insertReadingSession(ctx, tx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertReadingSession(ctx context.Context, q querier, s *data.ReadingSession) error {
+	query := `
+		INSERT INTO reading_sessions (id, user_id, book_id, started_at, finished_at, did_not_finish, rating, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING created_at, updated_at
+	`
+	err := q.QueryRow(ctx, query,
+		s.ID,
+		s.UserID,
+		s.BookID,
+		s.StartedAt,
+		s.FinishedAt,
+		s.DidNotFinish,
+		s.Rating,
+		s.CreatedAt,
+		s.UpdatedAt,
+	).Scan(&s.CreatedAt, &s.UpdatedAt)
+	if err != nil {
+		pgErr := &pgconn.PgError{}
+		if errors.As(err, &pgErr) && pgErr.ConstraintName == "reading_sessions_user_id_book_id_fkey" {
+			return ErrBookNotFound
+		}
+		return fmt.Errorf("insert reading session: %w", err)
+	}
+	return nil
+}
+
+// CreateReadingSession records a read of a book in the user's library.
+func (db *Client) CreateReadingSession(ctx context.Context, session *data.ReadingSession) (*data.ReadingSession, error) {
+	if err := insertReadingSession(ctx, db.DB, session); err != nil {
+		if errors.Is(err, ErrBookNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("CreateReadingSession: %w", err)
+	}
+	return session, nil
+}
+
+// UpdateReadingSession replaces the dates, DNF flag and rating of a session.
+func (db *Client) UpdateReadingSession(ctx context.Context, session *data.ReadingSession) (*data.ReadingSession, error) {
+	query := `
+		UPDATE reading_sessions
+		SET started_at = $4, finished_at = $5, did_not_finish = $6, rating = $7, updated_at = $8
+		WHERE id = $1 AND user_id = $2 AND book_id = $3
+		RETURNING created_at
+	`
+	err := db.DB.QueryRow(ctx, query,
+		session.ID,
+		session.UserID,
+		session.BookID,
+		session.StartedAt,
+		session.FinishedAt,
+		session.DidNotFinish,
+		session.Rating,
+		session.UpdatedAt,
+	).Scan(&session.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrReadingSessionNotFound
+		}
+		return nil, fmt.Errorf("UpdateReadingSession: %w", err)
+	}
+	return session, nil
+}
+
+func (db *Client) DeleteReadingSession(ctx context.Context, userID, bookID, id string) error {
+	query := `DELETE FROM reading_sessions WHERE id = $1 AND user_id = $2 AND book_id = $3`
+	tag, err := db.DB.Exec(ctx, query, id, userID, bookID)
+	if err != nil {
+		return fmt.Errorf("DeleteReadingSession: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrReadingSessionNotFound
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ReadingSessions(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	start := time.Now().Add(-time.Hour).UTC().Truncate(time.Millisecond)
+
+	update := func(status, rating int32) {
+		t.Helper()
+		require.NoError(t, client.UpdateLibraryBook(ctx, &data.LibraryBook{
+			UserID:        user.ID,
+			BookID:        "OL456M",
+			Title:         "Dune",
+			AuthorName:    "Frank Herbert",
+			Rating:        rating,
+			Source:        data.BookSourceOpenLibrary,
+			ReadingStatus: status,
+			AddedAt:       start,
+			UpdatedAt:     time.Now(),
+		}))
+	}
+	sessions := func() []*data.ReadingSession {
+		t.Helper()
+		books, _, err := client.GetUserLibrary(ctx, user.ID, data.Page{Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, books, 1)
+		return books[0].Sessions
+	}
+
+	// Starting and finishing the book opens and closes one session.
+	update(data.ReadingStatusReading, 0)
+	update(data.ReadingStatusRead, 4)
+	got := sessions()
+	require.Len(t, got, 1)
+	assert.NotNil(t, got[0].StartedAt)
+	assert.NotNil(t, got[0].FinishedAt)
+	assert.Equal(t, int32(4), got[0].Rating)
+
+	// Re-rating the book rates the latest read.
+	update(data.ReadingStatusRead, 5)
+	got = sessions()
+	require.Len(t, got, 1)
+	assert.Equal(t, int32(5), got[0].Rating)
+
+	// A past read is recorded by hand, then abandoned and removed.
+	earlier := start.AddDate(-2, 0, 0)
+	reread, err := client.CreateReadingSession(ctx, &data.ReadingSession{
+		ID:         uuid.New().String(),
+		UserID:     user.ID,
+		BookID:     "OL456M",
+		StartedAt:  &earlier,
+		FinishedAt: &earlier,
+		Rating:     3,
+		CreatedAt:  start,
+		UpdatedAt:  start,
+	})
+	require.NoError(t, err)
+	got = sessions()
+	require.Len(t, got, 2)
+	assert.Equal(t, reread.ID, got[0].ID)
+
+	reread.DidNotFinish = true
+	reread.Rating = 0
+	_, err = client.UpdateReadingSession(ctx, reread)
+	require.NoError(t, err)
+	assert.True(t, sessions()[0].DidNotFinish)
+
+	require.NoError(t, client.DeleteReadingSession(ctx, user.ID, "OL456M", reread.ID))
+	require.ErrorIs(t, client.DeleteReadingSession(ctx, user.ID, "OL456M", reread.ID), ErrReadingSessionNotFound)
+	assert.Len(t, sessions(), 1)
+
+	_, err = client.CreateReadingSession(ctx, &data.ReadingSession{
+		ID: uuid.New().String(), UserID: user.ID, BookID: "missing", StartedAt: &start, CreatedAt: start, UpdatedAt: start,
+	})
+	require.ErrorIs(t, err, ErrBookNotFound)
+}
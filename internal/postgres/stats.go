@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+)
+
+// statsTopAuthors is the number of authors listed in ReadingStats.TopAuthors.
+const statsTopAuthors = 10
+
+// statsReads selects the user's reads ($1) that ended in [$2, $3), where
+// either bound may be NULL, as the reads CTE.
+const statsReads = `
+	WITH reads AS (
+		SELECT rs.started_at, rs.finished_at, rs.did_not_finish, rs.rating, b.author_name,
+			COALESCE(rp.total_pages, b.page_count) AS pages
+		FROM reading_sessions rs
+		INNER JOIN library_books lb ON lb.user_id = rs.user_id AND lb.book_id = rs.book_id
+		` + catalogJoin + bookPagesJoin + `
+		WHERE rs.user_id = $1
+		  AND rs.finished_at IS NOT NULL
+		  AND ($2::timestamptz IS NULL OR rs.finished_at >= $2)
+		  AND ($3::timestamptz IS NULL OR rs.finished_at < $3)
+	)
+`
+
+// GetReadingStats summarizes the reads the user finished or abandoned
+// between from (inclusive) and to (exclusive); nil leaves that end of the
+// range open. Months run from the month of from, or of the first read, to
+// the month before to, or the current month, and cover at most
+// data.MaxStatsYears.
+func (db *Client) GetReadingStats(ctx context.Context, userID string, from, to *time.Time) (*data.ReadingStats, error) {
+	var stats data.ReadingStats
+
+	query := statsReads + `
+		SELECT
+			COUNT(*) FILTER (WHERE NOT did_not_finish),
+			COALESCE(SUM(pages) FILTER (WHERE NOT did_not_finish), 0),
+			COUNT(*) FILTER (WHERE did_not_finish),
+			COALESCE(EXTRACT(EPOCH FROM AVG(finished_at - started_at) FILTER (WHERE NOT did_not_finish)), 0)
+		FROM reads
+	`
+	var avgSeconds float64
+	err := db.DB.QueryRow(ctx, query, userID, from, to).Scan(
+		&stats.BooksRead,
+		&stats.PagesRead,
+		&stats.BooksNotFinished,
+		&avgSeconds,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("GetReadingStats (totals): %w", err)
+	}
+	stats.AverageTimeToFinish = time.Duration(avgSeconds * float64(time.Second))
+
+	query = statsReads + `
+		SELECT rating, COUNT(*) FROM reads WHERE NOT did_not_finish GROUP BY rating
+	`
+	rows, err := db.DB.Query(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("GetReadingStats (ratings): %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var rating, count int32
+		if err := rows.Scan(&rating, &count); err != nil {
+			return nil, fmt.Errorf("GetReadingStats (ratings) scan: %w", err)
+		}
+		if rating >= 0 && int(rating) < len(stats.RatingCounts) {
+			stats.RatingCounts[rating] = count
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("GetReadingStats (ratings): %w", err)
+	}
+
+	query = statsReads + `
+		SELECT author_name, COUNT(*) AS books
+		FROM reads
+		WHERE NOT did_not_finish
+		GROUP BY author_name
+		ORDER BY books DESC, author_name ASC
+		LIMIT $4
+	`
+	rows, err = db.DB.Query(ctx, query, userID, from, to, statsTopAuthors)
+	if err != nil {
+		return nil, fmt.Errorf("GetReadingStats (authors): %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var a data.AuthorCount
+		if err := rows.Scan(&a.AuthorName, &a.Books); err != nil {
+			return nil, fmt.Errorf("GetReadingStats (authors) scan: %w", err)
+		}
+		stats.TopAuthors = append(stats.TopAuthors, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("GetReadingStats (authors): %w", err)
+	}
+
+	// generate_series fills in the months without reads. The first month is
+	// clamped so an early read or a distant to cannot run the series for
+	// centuries.
+	query = statsReads + `
+		, bounds AS (
+			SELECT date_trunc('month', COALESCE($2, (SELECT MIN(finished_at) FROM reads)), 'UTC') AS first,
+				date_trunc('month', COALESCE($3 - INTERVAL '1 microsecond', NOW()), 'UTC') AS last
+		)
+		, months AS (
+			SELECT generate_series(GREATEST(first, last - make_interval(years => $4::int)), last, INTERVAL '1 month') AS month
+			FROM bounds
+			WHERE first IS NOT NULL
+		)
+		SELECT m.month, COUNT(r.finished_at), COALESCE(SUM(r.pages), 0)
+		FROM months m
+		LEFT JOIN reads r ON NOT r.did_not_finish AND date_trunc('month', r.finished_at, 'UTC') = m.month
+		GROUP BY m.month
+		ORDER BY m.month ASC
+	`
+	rows, err = db.DB.Query(ctx, query, userID, from, to, data.MaxStatsYears)
+	if err != nil {
+		return nil, fmt.Errorf("GetReadingStats (months): %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var m data.MonthCount
+		if err := rows.Scan(&m.Month, &m.Books, &m.Pages); err != nil {
+			return nil, fmt.Errorf("GetReadingStats (months) scan: %w", err)
+		}
+		m.Month = m.Month.UTC()
+		stats.Months = append(stats.Months, &m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("GetReadingStats (months): %w", err)
+	}
+
+	return &stats, nil
+}
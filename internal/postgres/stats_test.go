@@ -0,0 +1,77 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetReadingStats(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	user := seedUser(t, client)
+	addedAt := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	seedLibraryBook(t, client, user.ID, "OL1M", "Dune", "Frank Herbert", addedAt)
+	seedLibraryBook(t, client, user.ID, "OL2M", "Dune Messiah", "Frank Herbert", addedAt)
+	seedLibraryBook(t, client, user.ID, "OL3M", "Emma", "Jane Austen", addedAt)
+
+	session := func(bookID string, startedAt, finishedAt time.Time, dnf bool, rating int32) {
+		t.Helper()
+		_, err := client.CreateReadingSession(ctx, &data.ReadingSession{
+			ID:           uuid.New().String(),
+			UserID:       user.ID,
+			BookID:       bookID,
+			StartedAt:    &startedAt,
+			FinishedAt:   &finishedAt,
+			DidNotFinish: dnf,
+			Rating:       rating,
+			CreatedAt:    finishedAt,
+			UpdatedAt:    finishedAt,
+		})
+		require.NoError(t, err)
+	}
+	day := func(month time.Month, d int) time.Time { return time.Date(2025, month, d, 0, 0, 0, 0, time.UTC) }
+	session("OL1M", day(1, 1), day(1, 5), false, 5)
+	session("OL2M", day(3, 1), day(3, 3), false, 4)
+	session("OL3M", day(3, 10), day(3, 12), true, 0)
+	session("OL1M", day(1, 1).AddDate(-1, 0, 0), day(1, 2).AddDate(-1, 0, 0), false, 3) // outside the range
+
+	_, err := client.LogReadingProgress(ctx, &data.ReadingProgress{
+		ID: uuid.New().String(), UserID: user.ID, BookID: "OL1M", Page: 1, TotalPages: 412, CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	from, to := day(1, 1), day(1, 1).AddDate(1, 0, 0)
+	stats, err := client.GetReadingStats(ctx, user.ID, &from, &to)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), stats.BooksRead)
+	assert.Equal(t, int32(412), stats.PagesRead)
+	assert.Equal(t, int32(1), stats.BooksNotFinished)
+	assert.Equal(t, 3*24*time.Hour, stats.AverageTimeToFinish)
+	assert.Equal(t, [6]int32{0, 0, 0, 0, 1, 1}, stats.RatingCounts)
+	require.Len(t, stats.TopAuthors, 1)
+	assert.Equal(t, data.AuthorCount{AuthorName: "Frank Herbert", Books: 2}, *stats.TopAuthors[0])
+
+	require.Len(t, stats.Months, 12)
+	assert.Equal(t, from, stats.Months[0].Month)
+	assert.Equal(t, int32(1), stats.Months[0].Books)
+	assert.Equal(t, int32(412), stats.Months[0].Pages)
+	assert.Equal(t, int32(0), stats.Months[1].Books)
+	assert.Equal(t, int32(1), stats.Months[2].Books)
+
+	stats, err = client.GetReadingStats(ctx, user.ID, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), stats.BooksRead)
+	assert.Equal(t, day(1, 1).AddDate(-1, 0, 0), stats.Months[0].Month)
+
+	far := time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+	stats, err = client.GetReadingStats(ctx, user.ID, nil, &far)
+	require.NoError(t, err)
+	require.Len(t, stats.Months, 12*data.MaxStatsYears+1)
+	assert.Equal(t, far.AddDate(-data.MaxStatsYears, -1, 0), stats.Months[0].Month)
+}
@@ -12,10 +12,9 @@ import (
 )
 
 var (
-	ErrFollowUser           = errors.New("FollowUser: failed to follow user")
-	ErrSelfFollow           = errors.New("FollowUser: cannot follow self")
-	ErrUnableToCreateFollow = errors.New("failed to register ensure follows table")
-	ErrUnfollowUser         = errors.New("failed to unfollow user")
+	ErrFollowUser   = errors.New("FollowUser: failed to follow user")
+	ErrSelfFollow   = errors.New("FollowUser: cannot follow self")
+	ErrUnfollowUser = errors.New("failed to unfollow user")
 )
 
 func (db *Client) GetUserByID(ctx context.Context, id string) (*data.User, error) {
@@ -87,18 +86,3 @@ func (db *Client) UnfollowUser(ctx context.Context, followerID, followeeID strin
 
 	return nil
 }
-
-func registerFollows(ctx context.Context, db *pgx.Conn) error {
-	createFollowsTable := `
-	CREATE TABLE IF NOT EXISTS follows (
-		follower_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
-		followee_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
-		created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-		PRIMARY KEY (follower_id, followee_id)
-	);
-	`
-	if _, err := db.Exec(ctx, createFollowsTable); err != nil {
-		return fmt.Errorf("%w: %w", ErrUnableToCreateFollow, err)
-	}
-	return nil
-}
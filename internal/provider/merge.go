@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/isbn"
+)
+
+// Merge combines the results of several providers, taking each provider's
+// best remaining match in turn so no provider's results are pushed to the end.
+// A book with the same ISBN as one already taken is dropped; books without an
+// ISBN are always kept.
+func Merge(results ...[]*data.Book) []*data.Book {
+	var (
+		merged []*data.Book
+		seen   = make(map[string]bool)
+	)
+	for i := 0; ; i++ {
+		more := false
+		for _, books := range results {
+			if i >= len(books) {
+				continue
+			}
+			more = true
+			book := books[i]
+			if key := isbn.To13(book.ISBN); key != "" {
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+			}
+			merged = append(merged, book)
+		}
+		if !more {
+			return merged
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	openLibrary := []*data.Book{
+		{ExternalID: "OL1M", ISBN: "9780441013593"},
+		{ExternalID: "OL2M"},
+		{ExternalID: "OL3M", ISBN: "9780593099322"},
+	}
+	googleBooks := []*data.Book{
+		// The same book as OL1M, by its ISBN-10.
+		{ExternalID: "g1", ISBN: "0441013597"},
+		{ExternalID: "g2", ISBN: "9780340960196"},
+	}
+
+	var ids []string
+	for _, b := range Merge(openLibrary, googleBooks) {
+		ids = append(ids, b.ExternalID)
+	}
+	assert.Equal(t, []string{"OL1M", "OL2M", "g2", "OL3M"}, ids)
+
+	assert.Empty(t, Merge())
+	assert.Len(t, Merge(nil, googleBooks), 2)
+}
@@ -0,0 +1,34 @@
+// Package provider defines what the services books are searched in have in
+// common, so search can ask several of them and merge what they find.
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+)
+
+// Errors returned by every provider, so callers handle them alike.
+var (
+	ErrInternalServer = errors.New("internal server error")
+	ErrNotFound       = errors.New("not found")
+	ErrBadRequest     = errors.New("bad request")
+)
+
+// Query is a book search. At least one field is set.
+type Query struct {
+	Query   string
+	Title   string
+	Author  string
+	Subject string
+}
+
+// Provider is a service books can be searched in.
+type Provider interface {
+	// Source returns the data.BookSource* the provider's books come from.
+	Source() int32
+	// Search returns the books matching q, best match first, as catalog
+	// entries read now.
+	Search(ctx context.Context, q Query) ([]*data.Book, error)
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"context"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// (GET /api/v1/authors/{author_id}).
+// GetAuthor implements betterreads.BetterReadsServiceServer.
+func (s *Server) GetAuthor(ctx context.Context, req *betterreads.GetAuthorRequest) (*betterreads.GetAuthorResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+	if req.AuthorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "author_id is required")
+	}
+	p, err := newPageRequest(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := s.OpenLibrary.GetAuthor(ctx, req.AuthorId)
+	if err != nil {
+		return nil, providerError(err)
+	}
+	works, err := s.OpenLibrary.GetAuthorWorks(ctx, req.AuthorId, int(p.Limit), int(p.Offset()))
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	// Library entries are editions, matched to the works through the catalog.
+	var inLibrary map[string]string
+	if len(works.Works) > 0 {
+		keys := make([]string, 0, len(works.Works))
+		for _, work := range works.Works {
+			keys = append(keys, work.Key)
+		}
+		inLibrary, err = s.DB.FindLibraryWorks(ctx, userID, data.BookSourceOpenLibrary, keys)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to find library books: %v", err)
+		}
+	}
+
+	result := make([]*betterreads.AuthorWork, 0, len(works.Works))
+	for _, work := range works.Works {
+		bookID, ok := inLibrary[work.Key]
+		result = append(result, &betterreads.AuthorWork{
+			WorkId:           work.Key,
+			Title:            work.Title,
+			Subtitle:         work.Subtitle,
+			BookImage:        work.CoverImage,
+			FirstPublishDate: work.FirstPublishDate,
+			InLibrary:        ok,
+			LibraryBookId:    bookID,
+		})
+	}
+
+	return &betterreads.GetAuthorResponse{
+		Author: &betterreads.Author{
+			Id:        author.Key,
+			Name:      author.Name,
+			Bio:       author.Bio,
+			PhotoUrl:  author.Photo,
+			BirthDate: author.BirthDate,
+			DeathDate: author.DeathDate,
+		},
+		Works:      result,
+		Pagination: p.Metadata(int32(works.Total)), //nolint:gosec // integer overflow unlikely
+	}, nil
+}
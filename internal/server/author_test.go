@@ -0,0 +1,154 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/openlibrary"
+	"github.com/celestialdragonfly/betterreads/internal/postgres/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_GetAuthor(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	author := &openlibrary.Author{
+		Key:       "OL34184A",
+		Name:      "Roald Dahl",
+		Bio:       "British novelist.",
+		BirthDate: "13 September 1916",
+		DeathDate: "23 November 1990",
+		Photo:     "https://covers.openlibrary.org/a/id/9395323-L.jpg",
+	}
+	works := &openlibrary.AuthorWorks{
+		Works: []openlibrary.AuthorWork{
+			{Key: "OL45804W", Title: "Fantastic Mr Fox", FirstPublishDate: "1970"},
+			{Key: "OL45883W", Title: "Matilda"},
+		},
+		Total: 52,
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.GetAuthorRequest
+		setupMock func(*MockOpenLibraryClient, *mocks.MockAPI)
+		wantCode  codes.Code
+	}{
+		{
+			name:    "marks works in the library",
+			ctx:     ctx,
+			request: &betterreads.GetAuthorRequest{AuthorId: "OL34184A", Page: 2, Limit: 2},
+			setupMock: func(ol *MockOpenLibraryClient, db *mocks.MockAPI) {
+				ol.On("GetAuthor", mock.Anything, "OL34184A").Return(author, nil)
+				ol.On("GetAuthorWorks", mock.Anything, "OL34184A", 2, 2).Return(works, nil)
+				db.EXPECT().
+					FindLibraryWorks(gomock.Any(), testUserID, data.BookSourceOpenLibrary, []string{"OL45804W", "OL45883W"}).
+					Return(map[string]string{"OL45883W": "OL7353617M"}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "missing author_id",
+			ctx:       ctx,
+			request:   &betterreads.GetAuthorRequest{},
+			setupMock: func(*MockOpenLibraryClient, *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:    "invalid author_id",
+			ctx:     ctx,
+			request: &betterreads.GetAuthorRequest{AuthorId: "roald-dahl"},
+			setupMock: func(ol *MockOpenLibraryClient, _ *mocks.MockAPI) {
+				ol.On("GetAuthor", mock.Anything, "roald-dahl").Return(nil, openlibrary.ErrBadRequest)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:    "author not found",
+			ctx:     ctx,
+			request: &betterreads.GetAuthorRequest{AuthorId: "OL1A"},
+			setupMock: func(ol *MockOpenLibraryClient, _ *mocks.MockAPI) {
+				ol.On("GetAuthor", mock.Anything, "OL1A").Return(nil, openlibrary.ErrNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:    "works lookup error",
+			ctx:     ctx,
+			request: &betterreads.GetAuthorRequest{AuthorId: "OL34184A"},
+			setupMock: func(ol *MockOpenLibraryClient, _ *mocks.MockAPI) {
+				ol.On("GetAuthor", mock.Anything, "OL34184A").Return(author, nil)
+				ol.On("GetAuthorWorks", mock.Anything, "OL34184A", 20, 0).Return(nil, openlibrary.ErrInternalServer)
+			},
+			wantCode: codes.Internal,
+		},
+		{
+			name:    "database error",
+			ctx:     ctx,
+			request: &betterreads.GetAuthorRequest{AuthorId: "OL34184A"},
+			setupMock: func(ol *MockOpenLibraryClient, db *mocks.MockAPI) {
+				ol.On("GetAuthor", mock.Anything, "OL34184A").Return(author, nil)
+				ol.On("GetAuthorWorks", mock.Anything, "OL34184A", 20, 0).Return(works, nil)
+				db.EXPECT().
+					FindLibraryWorks(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       context.Background(),
+			request:   &betterreads.GetAuthorRequest{AuthorId: "OL34184A"},
+			setupMock: func(*MockOpenLibraryClient, *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockClient := NewMockClient()
+			mockDB := mocks.NewMockAPI(gomock.NewController(t))
+			tt.setupMock(mockClient, mockDB)
+			server := NewServer(&Config{
+				SQLClient:   mockDB,
+				OpenLibrary: mockClient,
+			})
+
+			resp, err := server.GetAuthor(tt.ctx, tt.request)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			mockClient.AssertExpectations(t)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			assert.Equal(t, "OL34184A", resp.Author.Id)
+			assert.Equal(t, "Roald Dahl", resp.Author.Name)
+			assert.Equal(t, "British novelist.", resp.Author.Bio)
+			assert.Equal(t, "13 September 1916", resp.Author.BirthDate)
+			assert.Equal(t, "23 November 1990", resp.Author.DeathDate)
+			assert.Equal(t, "https://covers.openlibrary.org/a/id/9395323-L.jpg", resp.Author.PhotoUrl)
+			require.Len(t, resp.Works, 2)
+			assert.Equal(t, "Fantastic Mr Fox", resp.Works[0].Title)
+			assert.False(t, resp.Works[0].InLibrary)
+			assert.Empty(t, resp.Works[0].LibraryBookId)
+			assert.True(t, resp.Works[1].InLibrary)
+			assert.Equal(t, "OL7353617M", resp.Works[1].LibraryBookId)
+			assert.Equal(t, int32(2), resp.Pagination.Page)
+			assert.Equal(t, int32(52), resp.Pagination.Total)
+		})
+	}
+}
@@ -3,9 +3,22 @@ package server
 import (
 	"context"
 	"errors"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
 	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/isbn"
+	"github.com/celestialdragonfly/betterreads/internal/logger"
 	"github.com/celestialdragonfly/betterreads/internal/openlibrary"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/celestialdragonfly/betterreads/internal/provider"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -16,47 +29,312 @@ func (s *Server) SearchBooks(ctx context.Context, request *betterreads.SearchBoo
 	if err := verifySearchBooksRequest(request); err != nil {
 		return nil, err
 	}
-
-	searchResult, err := s.OpenLibrary.SearchBooks(
-		ctx,
-		request.Query,
-		&request.Title,
-		&request.Author,
-		&request.Subject,
-	)
+	providers, err := s.searchProviders(request.Sources)
 	if err != nil {
-		switch {
-		case errors.Is(err, openlibrary.ErrBadRequest):
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		case errors.Is(err, openlibrary.ErrNotFound):
-			return nil, status.Error(codes.NotFound, err.Error())
-		case errors.Is(err, openlibrary.ErrInternalServer):
-			return nil, status.Error(codes.Internal, err.Error())
-		default:
-			return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+
+	query := provider.Query{
+		Query:   request.Query,
+		Title:   request.Title,
+		Author:  request.Author,
+		Subject: request.Subject,
+	}
+	results := make([][]*data.Book, len(providers))
+	errs := make([]error, len(providers))
+	var wg sync.WaitGroup
+	for i, p := range providers {
+		wg.Go(func() {
+			results[i], errs[i] = p.Search(ctx, query)
+		})
+	}
+	wg.Wait()
+
+	// A source that fails is left out as long as another one answered.
+	failed := 0
+	for i, err := range errs {
+		if err != nil {
+			failed++
+			logger.Warn("book search failed", "source", providers[i].Source(), "error", err)
 		}
 	}
+	if failed == len(providers) {
+		return nil, providerError(errs[0])
+	}
 
-	books := make([]*betterreads.Book, 0)
-	for _, book := range searchResult.Books {
+	// Every result is recorded in the catalog, so a book added from the
+	// results is described the same way in every library.
+	entries := provider.Merge(results...)
+	books := make([]*betterreads.Book, 0, len(entries))
+	for _, book := range entries {
 		books = append(books, &betterreads.Book{
-			Id:            book.CoverEditionKey,
+			Id:            book.ExternalID,
 			Title:         book.Title,
 			AuthorName:    book.AuthorName,
 			AuthorId:      book.AuthorKey,
-			BookImage:     book.CoverImage,
-			PublishedYear: int32(book.PublishYear), //nolint:gosec // integer overflow unlikely
+			BookImage:     book.BookImage,
+			PublishedYear: book.PublishYear,
 			Isbn:          book.ISBN,
-			RatingCount:   int32(book.RatingCount), //nolint:gosec // integer overflow unlikely
-			RatingAverage: float32(book.RatingAverage),
-			Source:        betterreads.BookSource_BOOK_SOURCE_OPEN_LIBRARY,
+			RatingCount:   book.RatingCount,
+			RatingAverage: book.RatingAverage,
+			Source:        betterreads.BookSource(book.Source),
 		})
 	}
+	if len(entries) > 0 {
+		if err := s.DB.UpsertBooks(ctx, entries); err != nil {
+			// The results are still good; the catalog catches up on the next
+			// search or refresh.
+			logger.Warn("failed to record search results in catalog", "error", err)
+		}
+	}
+
 	return &betterreads.SearchBooksResponse{
 		Books: books,
 	}, nil
 }
 
+// searchProviders returns the configured providers for the requested
+// sources, or all of them when none are requested.
+func (s *Server) searchProviders(sources []betterreads.BookSource) ([]provider.Provider, error) {
+	var providers []provider.Provider
+	for _, p := range []provider.Provider{s.OpenLibrary, s.GoogleBooks} {
+		if p != nil && (len(sources) == 0 || slices.Contains(sources, betterreads.BookSource(p.Source()))) {
+			providers = append(providers, p)
+		}
+	}
+	for _, source := range sources {
+		if !slices.ContainsFunc(providers, func(p provider.Provider) bool { return p.Source() == int32(source) }) {
+			return nil, status.Errorf(codes.InvalidArgument, "books cannot be searched in %s", source)
+		}
+	}
+	if len(providers) == 0 {
+		return nil, status.Error(codes.Unavailable, "no book sources are configured")
+	}
+	return providers, nil
+}
+
+// (GET /api/v1/books/{book_id}).
+// GetBook implements betterreads.BetterReadsServiceServer.
+func (s *Server) GetBook(ctx context.Context, request *betterreads.GetBookRequest) (*betterreads.GetBookResponse, error) {
+	if request.BookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "book_id is required")
+	}
+
+	if strings.HasPrefix(request.BookId, manualBookPrefix) {
+		return s.getManualBook(ctx, request.BookId)
+	}
+
+	details, err := s.OpenLibrary.GetBook(ctx, request.BookId)
+	if err != nil {
+		return nil, providerError(err)
+	}
+
+	book := &betterreads.Book{
+		Id:        details.Key,
+		Title:     details.Title,
+		BookImage: details.CoverImage,
+		Isbn:      details.ISBN,
+		Source:    betterreads.BookSource_BOOK_SOURCE_OPEN_LIBRARY,
+	}
+	if len(details.AuthorKeys) > 0 {
+		book.AuthorId = details.AuthorKeys[0]
+	}
+	// Open Library's book pages only name authors by key, so the name and
+	// ratings come from the catalog when a search has recorded the book.
+	entry, err := s.DB.GetBook(ctx, data.BookSourceOpenLibrary, details.Key)
+	switch {
+	case err == nil:
+		book.AuthorName = entry.AuthorName
+		book.PublishedYear = entry.PublishYear
+		book.RatingAverage = entry.RatingAverage
+		book.RatingCount = entry.RatingCount
+	case !errors.Is(err, postgres.ErrCatalogBookNotFound):
+		logger.Warn("failed to read catalog entry", "book_id", details.Key, "error", err)
+	}
+
+	editions := make([]*betterreads.Edition, 0, len(details.Editions))
+	for _, edition := range details.Editions {
+		editions = append(editions, toProtoEdition(edition))
+	}
+
+	return &betterreads.GetBookResponse{
+		Book:         book,
+		WorkId:       details.WorkKey,
+		AuthorIds:    details.AuthorKeys,
+		Subtitle:     details.Subtitle,
+		Description:  details.Description,
+		Subjects:     details.Subjects,
+		PageCount:    int32(details.PageCount), //nolint:gosec // integer overflow unlikely
+		Publishers:   details.Publishers,
+		PublishDate:  details.PublishDate,
+		Editions:     editions,
+		EditionCount: int32(details.EditionCount), //nolint:gosec // integer overflow unlikely
+	}, nil
+}
+
+// providerError converts an error from a book provider to a gRPC status
+// error.
+func providerError(err error) error {
+	switch {
+	case errors.Is(err, provider.ErrBadRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, provider.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toProtoEdition(edition openlibrary.Edition) *betterreads.Edition {
+	return &betterreads.Edition{
+		Id:             edition.Key,
+		Title:          edition.Title,
+		Subtitle:       edition.Subtitle,
+		Publishers:     edition.Publishers,
+		PublishDate:    edition.PublishDate,
+		PageCount:      int32(edition.PageCount), //nolint:gosec // integer overflow unlikely
+		Isbn:           edition.ISBN,
+		BookImage:      edition.CoverImage,
+		PhysicalFormat: edition.PhysicalFormat,
+	}
+}
+
+// manualBookPrefix starts the id of every book entered by hand.
+const manualBookPrefix = "manual:"
+
+// Limits on books entered by hand.
+const (
+	maxManualTitleLength  = 500
+	maxManualAuthors      = 20
+	maxManualAuthorLength = 200
+	maxManualPageCount    = 100000
+	maxCoverURLLength     = 2048
+)
+
+// (POST /api/v1/books).
+// CreateManualBook implements betterreads.BetterReadsServiceServer.
+func (s *Server) CreateManualBook(ctx context.Context, req *betterreads.CreateManualBookRequest) (*betterreads.CreateManualBookResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	now := time.Now()
+	book, err := manualBook(req, now)
+	if err != nil {
+		return nil, err
+	}
+	book.ExternalID = manualBookPrefix + uuid.NewString()
+	book.CreatedBy = userID
+
+	if err := s.DB.CreateBook(ctx, book); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create book: %v", err)
+	}
+
+	return &betterreads.CreateManualBookResponse{
+		Book:      toProtoManualBook(book),
+		Authors:   book.Authors,
+		PageCount: book.PageCount,
+	}, nil
+}
+
+// getManualBook answers GetBook for a book entered by hand, which only the
+// catalog knows.
+func (s *Server) getManualBook(ctx context.Context, id string) (*betterreads.GetBookResponse, error) {
+	book, err := s.DB.GetBook(ctx, data.BookSourceManual, id)
+	if err != nil {
+		if errors.Is(err, postgres.ErrCatalogBookNotFound) {
+			return nil, status.Error(codes.NotFound, "book not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get book: %v", err)
+	}
+	return &betterreads.GetBookResponse{
+		Book:      toProtoManualBook(book),
+		PageCount: book.PageCount,
+	}, nil
+}
+
+func toProtoManualBook(book *data.Book) *betterreads.Book {
+	return &betterreads.Book{
+		Id:            book.ExternalID,
+		Title:         book.Title,
+		AuthorName:    book.AuthorName,
+		BookImage:     book.BookImage,
+		Isbn:          book.ISBN,
+		PublishedYear: book.PublishYear,
+		Source:        betterreads.BookSource_BOOK_SOURCE_MANUAL,
+	}
+}
+
+// manualBook validates a book entered by hand and returns its catalog entry,
+// without an id. Errors are returned as gRPC status errors.
+func manualBook(req *betterreads.CreateManualBookRequest, now time.Time) (*data.Book, error) {
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, status.Error(codes.InvalidArgument, "title is required")
+	}
+	if utf8.RuneCountInString(title) > maxManualTitleLength {
+		return nil, status.Errorf(codes.InvalidArgument, "title must be at most %d characters", maxManualTitleLength)
+	}
+
+	authors := make([]string, 0, len(req.Authors))
+	for _, author := range req.Authors {
+		author = strings.TrimSpace(author)
+		if author == "" {
+			return nil, status.Error(codes.InvalidArgument, "authors must not be empty")
+		}
+		if utf8.RuneCountInString(author) > maxManualAuthorLength {
+			return nil, status.Errorf(codes.InvalidArgument, "authors must be at most %d characters", maxManualAuthorLength)
+		}
+		if !slices.Contains(authors, author) {
+			authors = append(authors, author)
+		}
+	}
+	if len(authors) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one author is required")
+	}
+	if len(authors) > maxManualAuthors {
+		return nil, status.Errorf(codes.InvalidArgument, "a book can have at most %d authors", maxManualAuthors)
+	}
+
+	var isbn13 string
+	if req.Isbn != "" {
+		if isbn13 = isbn.To13(req.Isbn); isbn13 == "" {
+			return nil, status.Error(codes.InvalidArgument, "isbn must be an ISBN-10 or ISBN-13")
+		}
+	}
+
+	if req.PageCount < 0 || req.PageCount > maxManualPageCount {
+		return nil, status.Errorf(codes.InvalidArgument, "page_count must be between 0 and %d", maxManualPageCount)
+	}
+
+	// A book can be entered ahead of its publication.
+	if req.PublishedYear < 0 || req.PublishedYear > int32(now.Year()+1) { //nolint:gosec // year fits
+		return nil, status.Error(codes.InvalidArgument, "published_year must not be more than a year ahead")
+	}
+
+	cover := strings.TrimSpace(req.CoverUrl)
+	if cover != "" {
+		u, err := url.Parse(cover)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || len(cover) > maxCoverURLLength {
+			return nil, status.Error(codes.InvalidArgument, "cover_url must be an http or https URL")
+		}
+	}
+
+	return &data.Book{
+		Source:      data.BookSourceManual,
+		Title:       title,
+		AuthorName:  strings.Join(authors, ", "),
+		Authors:     authors,
+		BookImage:   cover,
+		ISBN:        isbn13,
+		PageCount:   req.PageCount,
+		PublishYear: req.PublishedYear,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
 func verifySearchBooksRequest(request *betterreads.SearchBooksRequest) error {
 	if request.Query == "" &&
 		request.Title == "" &&
@@ -3,12 +3,24 @@ package server
 import (
 	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/googlebooks"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
 	"github.com/celestialdragonfly/betterreads/internal/openlibrary"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/celestialdragonfly/betterreads/internal/postgres/mocks"
+	"github.com/celestialdragonfly/betterreads/internal/provider"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -27,6 +39,56 @@ func (m *MockOpenLibraryClient) SearchBooks(ctx context.Context, query string, _
 	return args.Get(0).(*openlibrary.SearchBooksResponse), args.Error(1)
 }
 
+// GetBook is a mock implementation of the GetBook method.
+func (m *MockOpenLibraryClient) GetBook(ctx context.Context, id string) (*openlibrary.BookDetails, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*openlibrary.BookDetails), args.Error(1)
+}
+
+// GetEditionByISBN is a mock implementation of the GetEditionByISBN method.
+func (m *MockOpenLibraryClient) GetEditionByISBN(ctx context.Context, isbn string) (*openlibrary.BookDetails, error) {
+	args := m.Called(ctx, isbn)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*openlibrary.BookDetails), args.Error(1)
+}
+
+// GetAuthor is a mock implementation of the GetAuthor method.
+func (m *MockOpenLibraryClient) GetAuthor(ctx context.Context, id string) (*openlibrary.Author, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*openlibrary.Author), args.Error(1)
+}
+
+// GetAuthorWorks is a mock implementation of the GetAuthorWorks method.
+func (m *MockOpenLibraryClient) GetAuthorWorks(ctx context.Context, id string, limit, offset int) (*openlibrary.AuthorWorks, error) {
+	args := m.Called(ctx, id, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*openlibrary.AuthorWorks), args.Error(1)
+}
+
+// Source is the source of the Open Library provider.
+func (m *MockOpenLibraryClient) Source() int32 {
+	return data.BookSourceOpenLibrary
+}
+
+// Search is a mock implementation of the Search method.
+func (m *MockOpenLibraryClient) Search(ctx context.Context, q provider.Query) ([]*data.Book, error) {
+	args := m.Called(ctx, q)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*data.Book), args.Error(1)
+}
+
 // NewMockClient creates a new mock OpenLibrary client.
 func NewMockClient() *MockOpenLibraryClient {
 	return &MockOpenLibraryClient{}
@@ -36,31 +98,44 @@ func TestServer_SearchBooks_Success(t *testing.T) {
 	t.Parallel()
 	// Setup
 	mockClient := NewMockClient()
+	mockDB := mocks.NewMockAPI(gomock.NewController(t))
 	server := NewServer(&Config{
+		SQLClient:   mockDB,
 		OpenLibrary: mockClient,
 	})
 
 	// Test data
 	testQuery := "test query"
-	mockResponse := &openlibrary.SearchBooksResponse{
-		Books: []openlibrary.Book{
-			{
-				AuthorKey:       "OL123A",
-				AuthorName:      "Test Author",
-				CoverEditionKey: "OL456M",
-				CoverImage:      "https://covers.openlibrary.org/b/olid/OL456M-L.jpg",
-				ISBN:            "1234567890",
-				Title:           "Test Book",
-				RatingAverage:   4.5,
-				RatingCount:     100,
-				PublishYear:     2020,
-				Source:          string(betterreads.BookSource_BOOK_SOURCE_OPEN_LIBRARY),
-			},
+	mockResponse := []*data.Book{
+		{
+			Source:        data.BookSourceOpenLibrary,
+			ExternalID:    "OL456M",
+			WorkKey:       "OL789W",
+			Title:         "Test Book",
+			AuthorName:    "Test Author",
+			AuthorKey:     "OL123A",
+			BookImage:     "https://covers.openlibrary.org/b/olid/OL456M-L.jpg",
+			ISBN:          "1234567890",
+			PublishYear:   2020,
+			RatingAverage: 4.5,
+			RatingCount:   100,
 		},
 	}
 
 	// Expectations
-	mockClient.On("SearchBooks", mock.Anything, testQuery).Return(mockResponse, nil)
+	mockClient.On("Search", mock.Anything, provider.Query{Query: testQuery}).Return(mockResponse, nil)
+	mockDB.EXPECT().
+		UpsertBooks(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, books []*data.Book) error {
+			require.Len(t, books, 1)
+			assert.Equal(t, data.BookSourceOpenLibrary, books[0].Source)
+			assert.Equal(t, "OL456M", books[0].ExternalID)
+			assert.Equal(t, "Test Book", books[0].Title)
+			assert.Equal(t, "OL123A", books[0].AuthorKey)
+			assert.Equal(t, "OL789W", books[0].WorkKey)
+			assert.Equal(t, int32(2020), books[0].PublishYear)
+			return nil
+		})
 
 	// Execute
 	resp, err := server.SearchBooks(context.Background(), &betterreads.SearchBooksRequest{
@@ -109,7 +184,7 @@ func TestServer_SearchBooks_NilQuery(t *testing.T) {
 	assert.Equal(t, codes.InvalidArgument, st.Code())
 
 	// Verify no calls were made to the mock
-	mockClient.AssertNotCalled(t, "SearchBooks")
+	mockClient.AssertNotCalled(t, "Search")
 }
 
 func TestServer_SearchBooks_Errors(t *testing.T) {
@@ -154,7 +229,7 @@ func TestServer_SearchBooks_Errors(t *testing.T) {
 			testQuery := "test query"
 
 			// Expectations
-			mockClient.On("SearchBooks", mock.Anything, testQuery).Return(nil, tt.error)
+			mockClient.On("Search", mock.Anything, provider.Query{Query: testQuery}).Return(nil, tt.error)
 
 			// Execute
 			resp, err := server.SearchBooks(context.Background(), &betterreads.SearchBooksRequest{
@@ -184,12 +259,9 @@ func TestServer_SearchBooks_EmptyResults(t *testing.T) {
 
 	// Test data
 	testQuery := "test query"
-	mockResponse := &openlibrary.SearchBooksResponse{
-		Books: []openlibrary.Book{}, // Empty results
-	}
 
 	// Expectations
-	mockClient.On("SearchBooks", mock.Anything, testQuery).Return(mockResponse, nil)
+	mockClient.On("Search", mock.Anything, provider.Query{Query: testQuery}).Return([]*data.Book{}, nil) // Empty results
 
 	// Execute
 	resp, err := server.SearchBooks(context.Background(), &betterreads.SearchBooksRequest{
@@ -209,55 +281,57 @@ func TestServer_SearchBooks_MultipleBooks(t *testing.T) {
 	t.Parallel()
 	// Setup
 	mockClient := NewMockClient()
+	mockDB := mocks.NewMockAPI(gomock.NewController(t))
 	server := NewServer(&Config{
+		SQLClient:   mockDB,
 		OpenLibrary: mockClient,
 	})
 
 	// Test data
 	testQuery := "multiple books"
-	mockResponse := &openlibrary.SearchBooksResponse{
-		Books: []openlibrary.Book{
-			{
-				AuthorKey:       "OL123A",
-				AuthorName:      "Author One",
-				CoverEditionKey: "OL456M",
-				CoverImage:      "https://covers.openlibrary.org/b/olid/OL456M-L.jpg",
-				ISBN:            "1234567890",
-				Title:           "First Book",
-				RatingAverage:   4.5,
-				RatingCount:     100,
-				PublishYear:     2020,
-				Source:          string(betterreads.BookSource_BOOK_SOURCE_OPEN_LIBRARY),
-			},
-			{
-				AuthorKey:       "OL789A",
-				AuthorName:      "Author Two",
-				CoverEditionKey: "OL101M",
-				CoverImage:      "https://covers.openlibrary.org/b/olid/OL101M-L.jpg",
-				ISBN:            "0987654321",
-				Title:           "Second Book",
-				RatingAverage:   3.8,
-				RatingCount:     75,
-				PublishYear:     2018,
-				Source:          string(betterreads.BookSource_BOOK_SOURCE_OPEN_LIBRARY),
-			},
-			{
-				AuthorKey:       "OL456A",
-				AuthorName:      "Author Three",
-				CoverEditionKey: "OL202M",
-				CoverImage:      "https://covers.openlibrary.org/b/olid/OL202M-L.jpg",
-				ISBN:            "5678901234",
-				Title:           "Third Book",
-				RatingAverage:   4.2,
-				RatingCount:     50,
-				PublishYear:     2022,
-				Source:          string(betterreads.BookSource_BOOK_SOURCE_OPEN_LIBRARY),
-			},
+	mockResponse := []*data.Book{
+		{
+			Source:        data.BookSourceOpenLibrary,
+			ExternalID:    "OL456M",
+			Title:         "First Book",
+			AuthorName:    "Author One",
+			AuthorKey:     "OL123A",
+			BookImage:     "https://covers.openlibrary.org/b/olid/OL456M-L.jpg",
+			ISBN:          "1234567890",
+			PublishYear:   2020,
+			RatingAverage: 4.5,
+			RatingCount:   100,
+		},
+		{
+			Source:        data.BookSourceOpenLibrary,
+			ExternalID:    "OL101M",
+			Title:         "Second Book",
+			AuthorName:    "Author Two",
+			AuthorKey:     "OL789A",
+			BookImage:     "https://covers.openlibrary.org/b/olid/OL101M-L.jpg",
+			ISBN:          "0987654321",
+			PublishYear:   2018,
+			RatingAverage: 3.8,
+			RatingCount:   75,
+		},
+		{
+			Source:        data.BookSourceOpenLibrary,
+			ExternalID:    "OL202M",
+			Title:         "Third Book",
+			AuthorName:    "Author Three",
+			AuthorKey:     "OL456A",
+			BookImage:     "https://covers.openlibrary.org/b/olid/OL202M-L.jpg",
+			ISBN:          "5678901234",
+			PublishYear:   2022,
+			RatingAverage: 4.2,
+			RatingCount:   50,
 		},
 	}
 
 	// Expectations
-	mockClient.On("SearchBooks", mock.Anything, testQuery).Return(mockResponse, nil)
+	mockClient.On("Search", mock.Anything, provider.Query{Query: testQuery}).Return(mockResponse, nil)
+	// Search results are returned even if the catalog cannot record them.
+	mockDB.EXPECT().UpsertBooks(gomock.Any(), gomock.Len(3)).Return(errors.New("database connection failed"))
 
 	// Execute
 	resp, err := server.SearchBooks(context.Background(), &betterreads.SearchBooksRequest{
@@ -308,3 +382,542 @@ func TestServer_SearchBooks_MultipleBooks(t *testing.T) {
 	// Verify mock expectations
 	mockClient.AssertExpectations(t)
 }
+
+func TestServer_GetBook(t *testing.T) {
+	t.Parallel()
+
+	details := &openlibrary.BookDetails{
+		Edition: openlibrary.Edition{
+			Key:         "OL456M",
+			Title:       "Dune",
+			Publishers:  []string{"Chilton Books"},
+			PublishDate: "1965",
+			PageCount:   412,
+			ISBN:        "9780801950773",
+			CoverImage:  "https://covers.openlibrary.org/b/id/123-L.jpg",
+		},
+		WorkKey:     "OL893415W",
+		AuthorKeys:  []string{"OL79034A"},
+		Description: "A desert planet.",
+		Subjects:    []string{"Science fiction"},
+		Editions: []openlibrary.Edition{
+			{Key: "OL456M", Title: "Dune", PageCount: 412},
+			{Key: "OL789M", Title: "Dune", PhysicalFormat: "Paperback"},
+		},
+		EditionCount: 120,
+	}
+
+	tests := []struct {
+		name      string
+		bookID    string
+		setupMock func(*MockOpenLibraryClient, *mocks.MockAPI)
+		wantCode  codes.Code
+		wantName  string
+	}{
+		{
+			name:   "book in the catalog",
+			bookID: "OL456M",
+			setupMock: func(ol *MockOpenLibraryClient, db *mocks.MockAPI) {
+				ol.On("GetBook", mock.Anything, "OL456M").Return(details, nil)
+				db.EXPECT().
+					GetBook(gomock.Any(), data.BookSourceOpenLibrary, "OL456M").
+					Return(&data.Book{AuthorName: "Frank Herbert", PublishYear: 1965, RatingAverage: 4.3, RatingCount: 900}, nil)
+			},
+			wantCode: codes.OK,
+			wantName: "Frank Herbert",
+		},
+		{
+			name:   "book not in the catalog",
+			bookID: "OL456M",
+			setupMock: func(ol *MockOpenLibraryClient, db *mocks.MockAPI) {
+				ol.On("GetBook", mock.Anything, "OL456M").Return(details, nil)
+				db.EXPECT().GetBook(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, postgres.ErrCatalogBookNotFound)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:   "catalog error",
+			bookID: "OL456M",
+			setupMock: func(ol *MockOpenLibraryClient, db *mocks.MockAPI) {
+				ol.On("GetBook", mock.Anything, "OL456M").Return(details, nil)
+				db.EXPECT().GetBook(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("database connection failed"))
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "missing book id",
+			setupMock: func(*MockOpenLibraryClient, *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:   "invalid book id",
+			bookID: "dune",
+			setupMock: func(ol *MockOpenLibraryClient, _ *mocks.MockAPI) {
+				ol.On("GetBook", mock.Anything, "dune").Return(nil, openlibrary.ErrBadRequest)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:   "book not found",
+			bookID: "OL1M",
+			setupMock: func(ol *MockOpenLibraryClient, _ *mocks.MockAPI) {
+				ol.On("GetBook", mock.Anything, "OL1M").Return(nil, openlibrary.ErrNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:   "open library error",
+			bookID: "OL1M",
+			setupMock: func(ol *MockOpenLibraryClient, _ *mocks.MockAPI) {
+				ol.On("GetBook", mock.Anything, "OL1M").Return(nil, openlibrary.ErrInternalServer)
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockClient := NewMockClient()
+			mockDB := mocks.NewMockAPI(gomock.NewController(t))
+			tt.setupMock(mockClient, mockDB)
+			server := NewServer(&Config{
+				SQLClient:   mockDB,
+				OpenLibrary: mockClient,
+			})
+
+			resp, err := server.GetBook(context.Background(), &betterreads.GetBookRequest{BookId: tt.bookID})
+			require.Equal(t, tt.wantCode, status.Code(err))
+			mockClient.AssertExpectations(t)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			assert.Equal(t, "OL456M", resp.Book.Id)
+			assert.Equal(t, "Dune", resp.Book.Title)
+			assert.Equal(t, "OL79034A", resp.Book.AuthorId)
+			assert.Equal(t, tt.wantName, resp.Book.AuthorName)
+			assert.Equal(t, "https://covers.openlibrary.org/b/id/123-L.jpg", resp.Book.BookImage)
+			assert.Equal(t, betterreads.BookSource_BOOK_SOURCE_OPEN_LIBRARY, resp.Book.Source)
+			assert.Equal(t, "OL893415W", resp.WorkId)
+			assert.Equal(t, "A desert planet.", resp.Description)
+			assert.Equal(t, []string{"Science fiction"}, resp.Subjects)
+			assert.Equal(t, int32(412), resp.PageCount)
+			assert.Equal(t, []string{"Chilton Books"}, resp.Publishers)
+			assert.Equal(t, int32(120), resp.EditionCount)
+			require.Len(t, resp.Editions, 2)
+			assert.Equal(t, "OL789M", resp.Editions[1].Id)
+			assert.Equal(t, "Paperback", resp.Editions[1].PhysicalFormat)
+		})
+	}
+}
+
+// fakeProvider returns the same books, or error, for every search.
+type fakeProvider struct {
+	source int32
+	books  []*data.Book
+	err    error
+}
+
+func (f *fakeProvider) Source() int32 { return f.source }
+
+func (f *fakeProvider) Search(context.Context, provider.Query) ([]*data.Book, error) {
+	return f.books, f.err
+}
+
+func TestServer_SearchBooks_Sources(t *testing.T) {
+	t.Parallel()
+
+	openLibraryBooks := []*data.Book{
+		{Source: data.BookSourceOpenLibrary, ExternalID: "OL1M", Title: "Dune", ISBN: "9780441013593"},
+		{Source: data.BookSourceOpenLibrary, ExternalID: "OL2M", Title: "Dune Messiah"},
+	}
+	googleBooks := &fakeProvider{
+		source: data.BookSourceGoogleBooks,
+		books: []*data.Book{
+			{Source: data.BookSourceGoogleBooks, ExternalID: "g1", Title: "Dune", ISBN: "0441013597"},
+			{Source: data.BookSourceGoogleBooks, ExternalID: "g2", Title: "Children of Dune"},
+		},
+	}
+	failing := &fakeProvider{source: data.BookSourceGoogleBooks, err: provider.ErrInternalServer}
+
+	tests := []struct {
+		name        string
+		sources     []betterreads.BookSource
+		googleBooks provider.Provider
+		openLibrary func(*MockOpenLibraryClient)
+		wantIDs     []string
+		wantCode    codes.Code
+	}{
+		{
+			name:        "merges every source by isbn",
+			googleBooks: googleBooks,
+			openLibrary: func(m *MockOpenLibraryClient) {
+				m.On("Search", mock.Anything, mock.Anything).Return(openLibraryBooks, nil)
+			},
+			wantIDs: []string{"OL1M", "OL2M", "g2"},
+		},
+		{
+			name:        "one source",
+			sources:     []betterreads.BookSource{betterreads.BookSource_BOOK_SOURCE_GOOGLE_BOOKS},
+			googleBooks: googleBooks,
+			openLibrary: func(*MockOpenLibraryClient) {},
+			wantIDs:     []string{"g1", "g2"},
+		},
+		{
+			name:        "a failing source is left out",
+			googleBooks: failing,
+			openLibrary: func(m *MockOpenLibraryClient) {
+				m.On("Search", mock.Anything, mock.Anything).Return(openLibraryBooks, nil)
+			},
+			wantIDs: []string{"OL1M", "OL2M"},
+		},
+		{
+			name:        "every source fails",
+			googleBooks: failing,
+			openLibrary: func(m *MockOpenLibraryClient) {
+				m.On("Search", mock.Anything, mock.Anything).Return(nil, openlibrary.ErrBadRequest)
+			},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:        "source not configured",
+			sources:     []betterreads.BookSource{betterreads.BookSource_BOOK_SOURCE_GOOGLE_BOOKS},
+			openLibrary: func(*MockOpenLibraryClient) {},
+			wantCode:    codes.InvalidArgument,
+		},
+		{
+			name:        "source that cannot be searched",
+			sources:     []betterreads.BookSource{betterreads.BookSource_BOOK_SOURCE_MANUAL},
+			googleBooks: googleBooks,
+			openLibrary: func(*MockOpenLibraryClient) {},
+			wantCode:    codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockClient := NewMockClient()
+			tt.openLibrary(mockClient)
+			mockDB := mocks.NewMockAPI(gomock.NewController(t))
+			mockDB.EXPECT().UpsertBooks(gomock.Any(), gomock.Len(len(tt.wantIDs))).Return(nil).MaxTimes(1)
+			server := NewServer(&Config{
+				SQLClient:   mockDB,
+				OpenLibrary: mockClient,
+				GoogleBooks: tt.googleBooks,
+			})
+
+			resp, err := server.SearchBooks(context.Background(), &betterreads.SearchBooksRequest{
+				Query:   "dune",
+				Sources: tt.sources,
+			})
+			require.Equal(t, tt.wantCode, status.Code(err))
+			mockClient.AssertExpectations(t)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			ids := make([]string, 0, len(resp.Books))
+			for _, book := range resp.Books {
+				ids = append(ids, book.Id)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+// TestServer_SearchBooks_Providers searches both real clients against local
+// stand-ins for Open Library and Google Books.
+func TestServer_SearchBooks_Providers(t *testing.T) {
+	t.Parallel()
+
+	openLibrarySrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/search.json", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"numFound": 2, "start": 0, "numFoundExact": true, "q": "dune", "offset": null,
+			"documentation_url": "https://openlibrary.org/dev/docs/api/search",
+			"docs": [
+				{"key": "/works/OL893415W", "title": "Dune", "cover_edition_key": "OL26242482M",
+				 "author_name": ["Frank Herbert"], "author_key": ["OL79034A"], "isbn": ["0441013597"],
+				 "publish_year": [1965], "ratings_average": 4.3, "ratings_count": 900},
+				{"key": "/works/OL893502W", "title": "Dune Messiah", "cover_edition_key": "OL7353617M",
+				 "author_name": ["Frank Herbert"], "author_key": ["OL79034A"], "isbn": [],
+				 "publish_year": [1969], "ratings_average": 4.0, "ratings_count": 300}
+			]
+		}`))
+	}))
+	defer openLibrarySrv.Close()
+
+	googleBooksSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/volumes", r.URL.Path)
+		assert.Equal(t, `dune inauthor:"Frank Herbert"`, r.URL.Query().Get("q"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"totalItems": 2,
+			"items": [
+				{"id": "B1hSG45JCX4C", "volumeInfo": {"title": "Dune", "authors": ["Frank Herbert"],
+				 "industryIdentifiers": [{"type": "ISBN_13", "identifier": "9780441013593"}]}},
+				{"id": "p9cJAQAAMAAJ", "volumeInfo": {"title": "Children of Dune", "authors": ["Frank Herbert"],
+				 "publishedDate": "1976-04", "industryIdentifiers": [{"type": "ISBN_13", "identifier": "9780593098240"}]}}
+			]
+		}`))
+	}))
+	defer googleBooksSrv.Close()
+
+	openLibraryClient, err := openlibrary.NewClient(openLibrarySrv.URL)
+	require.NoError(t, err)
+	googleBooksClient, err := googlebooks.NewClient(googleBooksSrv.URL, "")
+	require.NoError(t, err)
+
+	mockDB := mocks.NewMockAPI(gomock.NewController(t))
+	mockDB.EXPECT().UpsertBooks(gomock.Any(), gomock.Len(3)).Return(nil)
+	server := NewServer(&Config{
+		SQLClient:   mockDB,
+		OpenLibrary: openLibraryClient,
+		GoogleBooks: googleBooksClient,
+	})
+
+	resp, err := server.SearchBooks(context.Background(), &betterreads.SearchBooksRequest{
+		Query:  "dune",
+		Author: "Frank Herbert",
+	})
+	require.NoError(t, err)
+
+	// Google's Dune has the same ISBN as Open Library's, given as an ISBN-10.
+	require.Len(t, resp.Books, 3)
+	assert.Equal(t, "OL26242482M", resp.Books[0].Id)
+	assert.Equal(t, betterreads.BookSource_BOOK_SOURCE_OPEN_LIBRARY, resp.Books[0].Source)
+	assert.Equal(t, "OL7353617M", resp.Books[1].Id)
+	assert.Equal(t, betterreads.BookSource_BOOK_SOURCE_OPEN_LIBRARY, resp.Books[1].Source)
+	assert.Equal(t, "p9cJAQAAMAAJ", resp.Books[2].Id)
+	assert.Equal(t, betterreads.BookSource_BOOK_SOURCE_GOOGLE_BOOKS, resp.Books[2].Source)
+	assert.Equal(t, int32(1976), resp.Books[2].PublishedYear)
+}
+
+func TestServer_CreateManualBook(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	valid := func() *betterreads.CreateManualBookRequest {
+		return &betterreads.CreateManualBookRequest{
+			Title:         "  The Zine ",
+			Authors:       []string{"Ana Ruiz", " Ben Ode", "Ana Ruiz"},
+			Isbn:          "0-306-40615-2",
+			PageCount:     48,
+			CoverUrl:      "https://example.com/zine.jpg",
+			PublishedYear: 2024,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		modify    func(*betterreads.CreateManualBookRequest)
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+	}{
+		{
+			name: "success",
+			ctx:  ctx,
+			setupMock: func(db *mocks.MockAPI) {
+				db.EXPECT().CreateBook(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, book *data.Book) error {
+					assert.Equal(t, data.BookSourceManual, book.Source)
+					assert.True(t, strings.HasPrefix(book.ExternalID, "manual:"))
+					assert.Equal(t, "9780306406157", book.ISBN)
+					assert.Equal(t, testUserID, book.CreatedBy)
+					return nil
+				})
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "missing title",
+			ctx:      ctx,
+			modify:   func(r *betterreads.CreateManualBookRequest) { r.Title = " " },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "missing authors",
+			ctx:      ctx,
+			modify:   func(r *betterreads.CreateManualBookRequest) { r.Authors = nil },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "empty author",
+			ctx:      ctx,
+			modify:   func(r *betterreads.CreateManualBookRequest) { r.Authors = []string{"Ana Ruiz", ""} },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "invalid isbn",
+			ctx:      ctx,
+			modify:   func(r *betterreads.CreateManualBookRequest) { r.Isbn = "12345" },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "negative page count",
+			ctx:      ctx,
+			modify:   func(r *betterreads.CreateManualBookRequest) { r.PageCount = -1 },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "cover not a url",
+			ctx:      ctx,
+			modify:   func(r *betterreads.CreateManualBookRequest) { r.CoverUrl = "javascript:alert(1)" },
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "year in the future",
+			ctx:      ctx,
+			modify:   func(r *betterreads.CreateManualBookRequest) { r.PublishedYear = int32(time.Now().Year() + 2) }, //nolint:gosec // year fits
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "database error",
+			ctx:  ctx,
+			setupMock: func(db *mocks.MockAPI) {
+				db.EXPECT().CreateBook(gomock.Any(), gomock.Any()).Return(errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDB := mocks.NewMockAPI(gomock.NewController(t))
+			if tt.setupMock != nil {
+				tt.setupMock(mockDB)
+			}
+			server := NewServer(&Config{SQLClient: mockDB})
+
+			req := valid()
+			if tt.modify != nil {
+				tt.modify(req)
+			}
+			resp, err := server.CreateManualBook(tt.ctx, req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			assert.True(t, strings.HasPrefix(resp.Book.Id, "manual:"))
+			assert.Equal(t, "The Zine", resp.Book.Title)
+			assert.Equal(t, "Ana Ruiz, Ben Ode", resp.Book.AuthorName)
+			assert.Equal(t, []string{"Ana Ruiz", "Ben Ode"}, resp.Authors)
+			assert.Equal(t, "9780306406157", resp.Book.Isbn)
+			assert.Equal(t, int32(48), resp.PageCount)
+			assert.Equal(t, int32(2024), resp.Book.PublishedYear)
+			assert.Equal(t, betterreads.BookSource_BOOK_SOURCE_MANUAL, resp.Book.Source)
+		})
+	}
+}
+
+func TestServer_CreateManualBook_Shelve(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	mockDB := mocks.NewMockAPI(gomock.NewController(t))
+	server := NewServer(&Config{SQLClient: mockDB})
+
+	var created *data.Book
+	mockDB.EXPECT().CreateBook(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, book *data.Book) error {
+		created = book
+		return nil
+	})
+	mockDB.EXPECT().UpdateLibraryBook(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, book *data.LibraryBook) error {
+		require.NotNil(t, created)
+		assert.Equal(t, created.ExternalID, book.BookID)
+		assert.Equal(t, data.BookSourceManual, book.Source)
+		return nil
+	})
+
+	resp, err := server.CreateManualBook(ctx, &betterreads.CreateManualBookRequest{
+		Title:   "The Zine",
+		Authors: []string{"Ana Ruiz"},
+	})
+	require.NoError(t, err)
+
+	_, err = server.UpdateLibraryBook(ctx, &betterreads.UpdateLibraryBookRequest{
+		BookId:        resp.Book.Id,
+		Title:         resp.Book.Title,
+		AuthorName:    resp.Book.AuthorName,
+		Source:        resp.Book.Source,
+		ReadingStatus: betterreads.ReadingStatus_READING_STATUS_WANT_TO_READ,
+	})
+	require.NoError(t, err)
+}
+
+func TestServer_GetBook_Manual(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+	}{
+		{
+			name: "book in the catalog",
+			setupMock: func(db *mocks.MockAPI) {
+				db.EXPECT().GetBook(gomock.Any(), data.BookSourceManual, "manual:abc").Return(&data.Book{
+					Source:     data.BookSourceManual,
+					ExternalID: "manual:abc",
+					Title:      "The Zine",
+					AuthorName: "Ana Ruiz",
+					PageCount:  48,
+				}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "book not found",
+			setupMock: func(db *mocks.MockAPI) {
+				db.EXPECT().GetBook(gomock.Any(), data.BookSourceManual, "manual:abc").Return(nil, postgres.ErrCatalogBookNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "database error",
+			setupMock: func(db *mocks.MockAPI) {
+				db.EXPECT().GetBook(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			mockDB := mocks.NewMockAPI(gomock.NewController(t))
+			tt.setupMock(mockDB)
+			// Open Library is never asked about a book entered by hand.
+			mockClient := NewMockClient()
+			server := NewServer(&Config{SQLClient: mockDB, OpenLibrary: mockClient})
+
+			resp, err := server.GetBook(context.Background(), &betterreads.GetBookRequest{BookId: "manual:abc"})
+			require.Equal(t, tt.wantCode, status.Code(err))
+			mockClient.AssertExpectations(t)
+			if tt.wantCode != codes.OK {
+				return
+			}
+
+			assert.Equal(t, "manual:abc", resp.Book.Id)
+			assert.Equal(t, "The Zine", resp.Book.Title)
+			assert.Equal(t, betterreads.BookSource_BOOK_SOURCE_MANUAL, resp.Book.Source)
+			assert.Equal(t, int32(48), resp.PageCount)
+		})
+	}
+}
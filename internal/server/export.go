@@ -0,0 +1,65 @@
+package server
+
+import (
+	"bufio"
+	"io"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/exporter"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize is the most file data sent in one ExportLibrary message.
+const exportChunkSize = 32 << 10
+
+var exportWriters = map[betterreads.ExportFormat]func(io.Writer) (exporter.Writer, error){
+	betterreads.ExportFormat_EXPORT_FORMAT_JSON:           exporter.NewJSON,
+	betterreads.ExportFormat_EXPORT_FORMAT_GOODREADS_CSV:  exporter.NewGoodreadsCSV,
+	betterreads.ExportFormat_EXPORT_FORMAT_STORYGRAPH_CSV: exporter.NewStoryGraphCSV,
+}
+
+// ExportLibrary implements betterreads.BetterReadsServiceServer. The library
+// is written to the stream as it is read from the database, so memory use does
+// not grow with its size.
+func (s *Server) ExportLibrary(req *betterreads.ExportLibraryRequest, stream betterreads.BetterReadsService_ExportLibraryServer) error {
+	ctx := stream.Context()
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	newWriter, ok := exportWriters[req.Format]
+	if !ok {
+		return status.Error(codes.InvalidArgument, "format must be specified")
+	}
+
+	out := bufio.NewWriterSize(chunkWriter{stream}, exportChunkSize)
+	w, err := newWriter(out)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to export library: %v", err)
+	}
+	if err := s.DB.ExportLibrary(ctx, userID, w.WriteShelves, w.WriteBook); err != nil {
+		return status.Errorf(codes.Internal, "failed to export library: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return status.Errorf(codes.Internal, "failed to export library: %v", err)
+	}
+	if err := out.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to export library: %v", err)
+	}
+	return nil
+}
+
+// chunkWriter sends each write as one ExportLibrary message.
+type chunkWriter struct {
+	stream betterreads.BetterReadsService_ExportLibraryServer
+}
+
+func (c chunkWriter) Write(p []byte) (int, error) {
+	if err := c.stream.Send(&betterreads.ExportLibraryResponse{Data: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
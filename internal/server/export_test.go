@@ -0,0 +1,173 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/postgres/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportStream collects the chunks sent by ExportLibrary.
+type exportStream struct {
+	grpc.ServerStream
+	ctx    context.Context //nolint: containedctx // test double for a stream
+	chunks [][]byte
+}
+
+func (s *exportStream) Context() context.Context { return s.ctx }
+
+func (s *exportStream) Send(resp *betterreads.ExportLibraryResponse) error {
+	s.chunks = append(s.chunks, bytes.Clone(resp.Data))
+	return nil
+}
+
+// ---- ExportLibrary -----------------------------------------------------
+
+func TestServer_ExportLibrary(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	// streamLibrary feeds a library of n books through the export callbacks.
+	streamLibrary := func(n int) func(context.Context, string, func([]*data.Shelf) error, func(*data.LibraryBook) error) error {
+		return func(_ context.Context, _ string, shelves func([]*data.Shelf) error, book func(*data.LibraryBook) error) error {
+			if err := shelves([]*data.Shelf{testShelf("shelf-1", "favorites", testUserID, testTime)}); err != nil {
+				return err
+			}
+			for i := range n {
+				if err := book(&data.LibraryBook{
+					BookID:        "goodreads:1",
+					Title:         strings.Repeat("a long title ", 10),
+					AuthorName:    "Someone",
+					ReadingStatus: data.ReadingStatusRead,
+					ShelfIDs:      []string{"shelf-1"},
+					AddedAt:       testTime.Add(time.Duration(i) * time.Minute),
+				}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		format    betterreads.ExportFormat
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+		verify    func(*testing.T, [][]byte)
+	}{
+		{
+			name:   "streams a large library in bounded chunks",
+			ctx:    ctx,
+			format: betterreads.ExportFormat_EXPORT_FORMAT_GOODREADS_CSV,
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					ExportLibrary(gomock.Any(), testUserID, gomock.Any(), gomock.Any()).
+					DoAndReturn(streamLibrary(2000))
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, chunks [][]byte) {
+				t.Helper()
+				require.Greater(t, len(chunks), 1)
+				for _, chunk := range chunks {
+					assert.LessOrEqual(t, len(chunk), exportChunkSize)
+				}
+				file := string(bytes.Join(chunks, nil))
+				assert.True(t, strings.HasPrefix(file, "Book Id,Title,Author"))
+				assert.Equal(t, 2001, strings.Count(file, "\n"))
+			},
+		},
+		{
+			name:   "json",
+			ctx:    ctx,
+			format: betterreads.ExportFormat_EXPORT_FORMAT_JSON,
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					ExportLibrary(gomock.Any(), testUserID, gomock.Any(), gomock.Any()).
+					DoAndReturn(streamLibrary(1))
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, chunks [][]byte) {
+				t.Helper()
+				file := string(bytes.Join(chunks, nil))
+				assert.Contains(t, file, `"shelves":[{"id":"shelf-1","name":"favorites"`)
+				assert.True(t, strings.HasSuffix(file, "]}\n"))
+			},
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       context.Background(),
+			format:    betterreads.ExportFormat_EXPORT_FORMAT_JSON,
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:      "format unspecified",
+			ctx:       ctx,
+			format:    betterreads.ExportFormat_EXPORT_FORMAT_UNSPECIFIED,
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:   "database error",
+			ctx:    ctx,
+			format: betterreads.ExportFormat_EXPORT_FORMAT_JSON,
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					ExportLibrary(gomock.Any(), testUserID, gomock.Any(), gomock.Any()).
+					Return(errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			stream := &exportStream{ctx: tt.ctx}
+			err := s.ExportLibrary(&betterreads.ExportLibraryRequest{Format: tt.format}, stream)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.verify != nil {
+				tt.verify(t, stream.chunks)
+			}
+		})
+	}
+}
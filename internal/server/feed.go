@@ -3,57 +3,488 @@ package server
 import (
 	"context"
 	"errors"
+	"time"
+	"unicode/utf8"
 
 	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxFeedOffset bounds how deep a page number reaches into the personalized
+// feed. Each followee's newest posts up to the offset are read for a page, so
+// deeper pages must continue from a page_token.
+const maxFeedOffset = 1000
+
+// Limits on the text of a post and its comments, in characters.
+const (
+	maxPostDescriptionLength = 20000
+	maxPostSummaryLength     = 1000
+	maxCommentLength         = 5000
 )
 
 // (GET /api/v1/feed).
 // GetPersonalizedFeed implements betterreads.BetterReadsServiceServer.
-func (s *Server) GetPersonalizedFeed(_ context.Context, _ *betterreads.GetPersonalizedFeedRequest) (*betterreads.GetPersonalizedFeedResponse, error) {
-	return nil, errors.New("not implemented")
+func (s *Server) GetPersonalizedFeed(ctx context.Context, req *betterreads.GetPersonalizedFeedRequest) (*betterreads.GetPersonalizedFeedResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	var since *time.Time
+	if req.Since != nil {
+		if err := req.Since.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid since: %v", err)
+		}
+		t := req.Since.AsTime()
+		since = &t
+	}
+
+	list := "feed:" + userID
+	page, err := newPageRequest(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	page, err = s.withPageToken(page, req.PageToken, list)
+	if err != nil {
+		return nil, err
+	}
+	if page.After == nil && page.Offset() >= maxFeedOffset {
+		return nil, status.Errorf(codes.InvalidArgument, "page must start within the first %d posts; continue with page_token", maxFeedOffset)
+	}
+
+	posts, total, err := s.DB.GetPersonalizedFeed(ctx, userID, since, page.Query())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get feed: %v", err)
+	}
+
+	pbPosts := make([]*betterreads.Post, 0, len(posts))
+	for _, p := range posts {
+		pbPosts = append(pbPosts, postToProto(p))
+	}
+
+	pagination := page.Metadata(total)
+	pagination.NextPageToken = nextPageToken(s, page, list, posts, postCursor)
+
+	return &betterreads.GetPersonalizedFeedResponse{
+		Posts:      pbPosts,
+		Pagination: pagination,
+	}, nil
 }
 
 // GetUserFeed implements betterreads.BetterReadsServiceServer.
-func (s *Server) GetUserFeed(_ context.Context, _ *betterreads.GetUserFeedRequest) (*betterreads.GetUserFeedResponse, error) {
-	return nil, errors.New("not implemented")
+func (s *Server) GetUserFeed(ctx context.Context, req *betterreads.GetUserFeedRequest) (*betterreads.GetUserFeedResponse, error) {
+	if _, ok := headers.GetUserID(ctx); !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// Every account is public for now. When private accounts land, hide the
+	// feed from callers who do not follow req.UserId.
+	list := "user_feed:" + req.UserId
+	page, err := newPageRequest(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	page, err = s.withPageToken(page, req.PageToken, list)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, total, err := s.DB.GetUserFeed(ctx, req.UserId, page.Query())
+	if err != nil {
+		if errors.Is(err, postgres.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get user feed: %v", err)
+	}
+
+	pbPosts := make([]*betterreads.Post, 0, len(posts))
+	for _, p := range posts {
+		pbPosts = append(pbPosts, postToProto(p))
+	}
+
+	pagination := page.Metadata(total)
+	pagination.NextPageToken = nextPageToken(s, page, list, posts, postCursor)
+
+	return &betterreads.GetUserFeedResponse{
+		Posts:      pbPosts,
+		Pagination: pagination,
+	}, nil
 }
 
 // CreatePost implements betterreads.BetterReadsServiceServer.
-func (s *Server) CreatePost(_ context.Context, _ *betterreads.CreatePostRequest) (*betterreads.CreatePostResponse, error) {
-	return nil, errors.New("not implemented")
+func (s *Server) CreatePost(ctx context.Context, req *betterreads.CreatePostRequest) (*betterreads.CreatePostResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	book := req.Book
+	var highlight *data.Highlight
+	if req.HighlightId != "" {
+		var err error
+		highlight, err = s.DB.GetHighlight(ctx, userID, req.HighlightId)
+		if err != nil {
+			if errors.Is(err, postgres.ErrHighlightNotFound) {
+				return nil, status.Error(codes.NotFound, "highlight not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get highlight: %v", err)
+		}
+		// A post is shown to every follower, so sharing a private highlight
+		// would publish it. Make it public first.
+		if !highlight.IsPublic {
+			return nil, status.Error(codes.FailedPrecondition, "highlight is private")
+		}
+		if book == nil {
+			book = &betterreads.BookDetails{
+				Id:       highlight.BookID,
+				Author:   highlight.AuthorName,
+				Title:    highlight.BookTitle,
+				ImageUrl: highlight.BookImage,
+			}
+		} else if book.Id != highlight.BookID {
+			return nil, status.Error(codes.InvalidArgument, "book must be the highlight's book")
+		}
+	}
+
+	if err := verifyPostContent(book, req.Description, req.Summary); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	post := &data.Post{
+		ID:          uuid.New().String(),
+		UserID:      userID,
+		Book:        bookDetailsFromProto(book),
+		Description: req.Description,
+		Summary:     req.Summary,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if highlight != nil {
+		post.HighlightID = highlight.ID
+		post.Quote = highlight.Quote
+	}
+
+	createdPost, err := s.DB.CreatePost(ctx, post)
+	if err != nil {
+		switch {
+		case errors.Is(err, postgres.ErrPostAuthorNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case errors.Is(err, postgres.ErrHighlightNotFound):
+			return nil, status.Error(codes.NotFound, "highlight not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create post: %v", err)
+	}
+
+	return &betterreads.CreatePostResponse{
+		Post: postToProto(createdPost),
+	}, nil
 }
 
 // DeletePost implements betterreads.BetterReadsServiceServer.
-func (s *Server) DeletePost(_ context.Context, _ *betterreads.DeletePostRequest) (*betterreads.DeletePostResponse, error) {
-	return nil, errors.New("not implemented")
+func (s *Server) DeletePost(ctx context.Context, req *betterreads.DeletePostRequest) (*betterreads.DeletePostResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.PostId == "" {
+		return nil, status.Error(codes.InvalidArgument, "post_id is required")
+	}
+
+	if err := s.DB.DeletePost(ctx, userID, req.PostId); err != nil {
+		switch {
+		case errors.Is(err, postgres.ErrPostNotFound):
+			return nil, status.Error(codes.NotFound, "post not found")
+		case errors.Is(err, postgres.ErrNotPostAuthor):
+			return nil, status.Error(codes.PermissionDenied, "you can only delete your own posts")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to delete post: %v", err)
+		}
+	}
+
+	return &betterreads.DeletePostResponse{}, nil
 }
 
 // UpdatePost implements betterreads.BetterReadsServiceServer.
-func (s *Server) UpdatePost(_ context.Context, _ *betterreads.UpdatePostRequest) (*betterreads.UpdatePostResponse, error) {
-	return nil, errors.New("not implemented")
+func (s *Server) UpdatePost(ctx context.Context, req *betterreads.UpdatePostRequest) (*betterreads.UpdatePostResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.PostId == "" {
+		return nil, status.Error(codes.InvalidArgument, "post_id is required")
+	}
+
+	if err := verifyPostContent(req.Book, req.Description, req.Summary); err != nil {
+		return nil, err
+	}
+
+	post := &data.Post{
+		ID:          req.PostId,
+		UserID:      userID,
+		Book:        bookDetailsFromProto(req.Book),
+		Description: req.Description,
+		Summary:     req.Summary,
+		UpdatedAt:   time.Now(),
+	}
+
+	updatedPost, err := s.DB.UpdatePost(ctx, post)
+	if err != nil {
+		switch {
+		case errors.Is(err, postgres.ErrPostNotFound):
+			return nil, status.Error(codes.NotFound, "post not found")
+		case errors.Is(err, postgres.ErrNotPostAuthor):
+			return nil, status.Error(codes.PermissionDenied, "you can only update your own posts")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to update post: %v", err)
+		}
+	}
+
+	return &betterreads.UpdatePostResponse{
+		Post: postToProto(updatedPost),
+	}, nil
 }
 
 // GetCommentsForPost implements betterreads.BetterReadsServiceServer.
-func (s *Server) GetCommentsForPost(_ context.Context, _ *betterreads.GetCommentsForPostRequest) (*betterreads.GetCommentsForPostResponse, error) {
-	return nil, errors.New("not implemented")
+func (s *Server) GetCommentsForPost(ctx context.Context, req *betterreads.GetCommentsForPostRequest) (*betterreads.GetCommentsForPostResponse, error) {
+	if _, ok := headers.GetUserID(ctx); !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.PostId == "" {
+		return nil, status.Error(codes.InvalidArgument, "post_id is required")
+	}
+
+	list := "comments:" + req.PostId
+	page, err := newPageRequest(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	page, err = s.withPageToken(page, req.PageToken, list)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, total, err := s.DB.GetCommentsForPost(ctx, req.PostId, page.Query())
+	if err != nil {
+		if errors.Is(err, postgres.ErrPostNotFound) {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get comments: %v", err)
+	}
+
+	pbComments := make([]*betterreads.Comment, 0, len(comments))
+	for _, c := range comments {
+		pbComments = append(pbComments, commentToProto(c))
+	}
+
+	pagination := page.Metadata(total)
+	pagination.NextPageToken = nextPageToken(s, page, list, comments, commentCursor)
+
+	return &betterreads.GetCommentsForPostResponse{
+		Comments:   pbComments,
+		Pagination: pagination,
+	}, nil
 }
 
 // AddComment implements betterreads.BetterReadsServiceServer.
-func (s *Server) AddComment(_ context.Context, _ *betterreads.AddCommentRequest) (*betterreads.AddCommentResponse, error) {
-	return nil, errors.New("not implemented")
+func (s *Server) AddComment(ctx context.Context, req *betterreads.AddCommentRequest) (*betterreads.AddCommentResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.PostId == "" {
+		return nil, status.Error(codes.InvalidArgument, "post_id is required")
+	}
+
+	if req.Content == "" {
+		return nil, status.Error(codes.InvalidArgument, "content is required")
+	}
+	if utf8.RuneCountInString(req.Content) > maxCommentLength {
+		return nil, status.Errorf(codes.InvalidArgument, "content must be at most %d characters", maxCommentLength)
+	}
+
+	comment := &data.Comment{
+		ID:        uuid.New().String(),
+		PostID:    req.PostId,
+		UserID:    userID,
+		Content:   req.Content,
+		CreatedAt: time.Now(),
+	}
+
+	createdComment, err := s.DB.AddComment(ctx, comment)
+	if err != nil {
+		switch {
+		case errors.Is(err, postgres.ErrPostNotFound):
+			return nil, status.Error(codes.NotFound, "post not found")
+		case errors.Is(err, postgres.ErrCommentAuthorNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to add comment: %v", err)
+		}
+	}
+
+	return &betterreads.AddCommentResponse{
+		Comment: commentToProto(createdComment),
+	}, nil
 }
 
 // DeleteComment implements betterreads.BetterReadsServiceServer.
-func (s *Server) DeleteComment(_ context.Context, _ *betterreads.DeleteCommentRequest) (*betterreads.DeleteCommentResponse, error) {
-	return nil, errors.New("not implemented")
+func (s *Server) DeleteComment(ctx context.Context, req *betterreads.DeleteCommentRequest) (*betterreads.DeleteCommentResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.PostId == "" {
+		return nil, status.Error(codes.InvalidArgument, "post_id is required")
+	}
+
+	if req.CommentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "comment_id is required")
+	}
+
+	if err := s.DB.DeleteComment(ctx, userID, req.PostId, req.CommentId); err != nil {
+		switch {
+		case errors.Is(err, postgres.ErrCommentNotFound):
+			return nil, status.Error(codes.NotFound, "comment not found")
+		case errors.Is(err, postgres.ErrCannotDeleteComment):
+			return nil, status.Error(codes.PermissionDenied, "only the comment author or post author can delete this comment")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to delete comment: %v", err)
+		}
+	}
+
+	return &betterreads.DeleteCommentResponse{}, nil
 }
 
 // LikePost implements betterreads.BetterReadsServiceServer.
-func (s *Server) LikePost(_ context.Context, _ *betterreads.LikePostRequest) (*betterreads.LikePostResponse, error) {
-	return nil, errors.New("not implemented")
+func (s *Server) LikePost(ctx context.Context, req *betterreads.LikePostRequest) (*betterreads.LikePostResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.PostId == "" {
+		return nil, status.Error(codes.InvalidArgument, "post_id is required")
+	}
+
+	if err := s.DB.LikePost(ctx, userID, req.PostId); err != nil {
+		switch {
+		case errors.Is(err, postgres.ErrPostNotFound):
+			return nil, status.Error(codes.NotFound, "post not found")
+		case errors.Is(err, postgres.ErrLikeAuthorNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Errorf(codes.Internal, "failed to like post: %v", err)
+		}
+	}
+
+	return &betterreads.LikePostResponse{}, nil
 }
 
 // UnlikePost implements betterreads.BetterReadsServiceServer.
-func (s *Server) UnlikePost(_ context.Context, _ *betterreads.UnlikePostRequest) (*betterreads.UnlikePostResponse, error) {
-	return nil, errors.New("not implemented")
+func (s *Server) UnlikePost(ctx context.Context, req *betterreads.UnlikePostRequest) (*betterreads.UnlikePostResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.PostId == "" {
+		return nil, status.Error(codes.InvalidArgument, "post_id is required")
+	}
+
+	if err := s.DB.UnlikePost(ctx, userID, req.PostId); err != nil {
+		if errors.Is(err, postgres.ErrPostNotFound) {
+			return nil, status.Error(codes.NotFound, "post not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to unlike post: %v", err)
+	}
+
+	return &betterreads.UnlikePostResponse{}, nil
+}
+
+// verifyPostContent validates the fields shared by CreatePost and UpdatePost.
+func verifyPostContent(book *betterreads.BookDetails, description, summary string) error {
+	if book == nil {
+		return status.Error(codes.InvalidArgument, "book is required")
+	}
+	if book.Id == "" {
+		return status.Error(codes.InvalidArgument, "book.id is required")
+	}
+	if book.Title == "" {
+		return status.Error(codes.InvalidArgument, "book.title is required")
+	}
+	if book.Author == "" {
+		return status.Error(codes.InvalidArgument, "book.author is required")
+	}
+	// Validate rating range (0 = unspecified, 1-5 = actual ratings)
+	if book.Rating < 0 || book.Rating > 5 {
+		return status.Error(codes.InvalidArgument, "book.rating must be between 0 and 5")
+	}
+	if description == "" {
+		return status.Error(codes.InvalidArgument, "description is required")
+	}
+	if utf8.RuneCountInString(description) > maxPostDescriptionLength {
+		return status.Errorf(codes.InvalidArgument, "description must be at most %d characters", maxPostDescriptionLength)
+	}
+	if utf8.RuneCountInString(summary) > maxPostSummaryLength {
+		return status.Errorf(codes.InvalidArgument, "summary must be at most %d characters", maxPostSummaryLength)
+	}
+	return nil
+}
+
+func bookDetailsFromProto(book *betterreads.BookDetails) data.BookDetails {
+	return data.BookDetails{
+		ID:       book.Id,
+		Author:   book.Author,
+		Title:    book.Title,
+		ImageURL: book.ImageUrl,
+		Rating:   int32(book.Rating),
+	}
+}
+
+func postToProto(p *data.Post) *betterreads.Post {
+	return &betterreads.Post{
+		Id:          p.ID,
+		Description: p.Description,
+		Book: &betterreads.BookDetails{
+			Id:       p.Book.ID,
+			Author:   p.Book.Author,
+			Title:    p.Book.Title,
+			ImageUrl: p.Book.ImageURL,
+			Rating:   betterreads.BookRating(p.Book.Rating),
+		},
+		LikeCount:       p.LikeCount,
+		Summary:         p.Summary,
+		UserId:          p.UserID,
+		CommentCount:    p.CommentCount,
+		CreatedAt:       timestamppb.New(p.CreatedAt),
+		SystemGenerated: p.SystemGenerated,
+		HighlightId:     p.HighlightID,
+		Quote:           p.Quote,
+	}
+}
+
+func commentToProto(c *data.Comment) *betterreads.Comment {
+	return &betterreads.Comment{
+		Id:        c.ID,
+		Content:   c.Content,
+		PostId:    c.PostID,
+		UserId:    c.UserID,
+		CreatedAt: timestamppb.New(c.CreatedAt),
+	}
 }
@@ -0,0 +1,1592 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/celestialdragonfly/betterreads/internal/postgres/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// testPost returns a reusable data.Post for use across test cases.
+func testPost(id, userID string, t time.Time) *data.Post {
+	return &data.Post{
+		ID:     id,
+		UserID: userID,
+		Book: data.BookDetails{
+			ID:       "OL456M",
+			Author:   "Frank Herbert",
+			Title:    "Dune",
+			ImageURL: "https://covers.openlibrary.org/b/olid/OL456M-L.jpg",
+			Rating:   int32(betterreads.BookRating_BOOK_RATING_FIVE_STAR),
+		},
+		Description:  "A desert planet, a messiah and a lot of spice.",
+		Summary:      "Loved it",
+		LikeCount:    3,
+		CommentCount: 2,
+		CreatedAt:    t,
+		UpdatedAt:    t,
+	}
+}
+
+func testBookDetails() *betterreads.BookDetails {
+	return &betterreads.BookDetails{
+		Id:       "OL456M",
+		Author:   "Frank Herbert",
+		Title:    "Dune",
+		ImageUrl: "https://covers.openlibrary.org/b/olid/OL456M-L.jpg",
+		Rating:   betterreads.BookRating_BOOK_RATING_FIVE_STAR,
+	}
+}
+
+// ---- GetPersonalizedFeed ----------------------------------------------
+
+func TestServer_GetPersonalizedFeed(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	ctxNoUserID := context.Background()
+
+	dbPosts := []*data.Post{
+		testPost("post-2", "followee-b", testTime.Add(time.Hour)),
+		testPost("post-1", "followee-a", testTime),
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.GetPersonalizedFeedRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+		verify    func(*testing.T, *betterreads.GetPersonalizedFeedResponse)
+	}{
+		{
+			name: "successful retrieval with page and limit",
+			ctx:  ctx,
+			request: &betterreads.GetPersonalizedFeedRequest{
+				Page:  2,
+				Limit: 2,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetPersonalizedFeed(gomock.Any(), testUserID, gomock.Nil(), data.Page{Limit: 2, Offset: 2}).
+					Return(dbPosts, int32(5), nil)
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+			verify: func(t *testing.T, resp *betterreads.GetPersonalizedFeedResponse) {
+				t.Helper()
+				require.Len(t, resp.Posts, 2)
+				assert.Equal(t, "post-2", resp.Posts[0].Id)
+				assert.Equal(t, "followee-b", resp.Posts[0].UserId)
+				assert.Equal(t, "post-1", resp.Posts[1].Id)
+				assert.Equal(t, int32(2), resp.Pagination.Page)
+				assert.Equal(t, int32(2), resp.Pagination.Limit)
+				assert.Equal(t, int32(5), resp.Pagination.Total)
+			},
+		},
+		{
+			name: "since is passed through",
+			ctx:  ctx,
+			request: &betterreads.GetPersonalizedFeedRequest{
+				Since: timestamppb.New(testTime),
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetPersonalizedFeed(gomock.Any(), testUserID, gomock.Any(), data.Page{Limit: defaultPageLimit}).
+					DoAndReturn(func(_ context.Context, _ string, since *time.Time, _ data.Page) ([]*data.Post, int32, error) {
+						require.NotNil(t, since)
+						assert.True(t, since.Equal(testTime))
+						return nil, int32(0), nil
+					})
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+			verify: func(t *testing.T, resp *betterreads.GetPersonalizedFeedResponse) {
+				t.Helper()
+				assert.Empty(t, resp.Posts)
+				assert.Equal(t, int32(1), resp.Pagination.Page)
+				assert.Equal(t, defaultPageLimit, resp.Pagination.Limit)
+			},
+		},
+		{
+			name: "invalid since",
+			ctx:  ctx,
+			request: &betterreads.GetPersonalizedFeedRequest{
+				Since: &timestamppb.Timestamp{Seconds: 1, Nanos: -1},
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:    "last page a page number reaches",
+			ctx:     ctx,
+			request: &betterreads.GetPersonalizedFeedRequest{Page: maxFeedOffset / maxPageLimit, Limit: maxPageLimit},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetPersonalizedFeed(gomock.Any(), testUserID, gomock.Nil(), data.Page{Limit: maxPageLimit, Offset: maxFeedOffset - maxPageLimit}).
+					Return(nil, int32(0), nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "page beyond what a page number reaches",
+			ctx:       ctx,
+			request:   &betterreads.GetPersonalizedFeedRequest{Page: maxFeedOffset/maxPageLimit + 1, Limit: maxPageLimit},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "page too large",
+			ctx:       ctx,
+			request:   &betterreads.GetPersonalizedFeedRequest{Page: math.MaxInt32, Limit: 2},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       ctxNoUserID,
+			request:   &betterreads.GetPersonalizedFeedRequest{},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:    "database error",
+			ctx:     ctx,
+			request: &betterreads.GetPersonalizedFeedRequest{},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetPersonalizedFeed(gomock.Any(), testUserID, gomock.Any(), gomock.Any()).
+					Return(nil, int32(0), errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.GetPersonalizedFeed(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+// ---- GetUserFeed ------------------------------------------------------
+
+func TestServer_GetUserFeed(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testAuthorID := "author-456"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	ctxNoUserID := context.Background()
+
+	dbPosts := []*data.Post{
+		testPost("post-2", testAuthorID, testTime.Add(time.Hour)),
+		testPost("post-1", testAuthorID, testTime),
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.GetUserFeedRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+		verify    func(*testing.T, *betterreads.GetUserFeedResponse)
+	}{
+		{
+			name: "successful retrieval with page and limit",
+			ctx:  ctx,
+			request: &betterreads.GetUserFeedRequest{
+				UserId: testAuthorID,
+				Page:   2,
+				Limit:  2,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetUserFeed(gomock.Any(), testAuthorID, data.Page{Limit: 2, Offset: 2}).
+					Return(dbPosts, int32(4), nil)
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+			verify: func(t *testing.T, resp *betterreads.GetUserFeedResponse) {
+				t.Helper()
+				require.Len(t, resp.Posts, 2)
+				assert.Equal(t, "post-2", resp.Posts[0].Id)
+				assert.Equal(t, testAuthorID, resp.Posts[0].UserId)
+				assert.Equal(t, int32(2), resp.Pagination.Page)
+				assert.Equal(t, int32(2), resp.Pagination.Limit)
+				assert.Equal(t, int32(4), resp.Pagination.Total)
+			},
+		},
+		{
+			name: "full page returns a token that resumes after the last post",
+			ctx:  ctx,
+			request: &betterreads.GetUserFeedRequest{
+				UserId:    testAuthorID,
+				Limit:     2,
+				PageToken: (&Server{}).encodePageToken("user_feed:"+testAuthorID, postCursor(dbPosts[0])),
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetUserFeed(gomock.Any(), testAuthorID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, page data.Page) ([]*data.Post, int32, error) {
+						require.NotNil(t, page.After)
+						assert.Equal(t, "post-2", page.After.ID)
+						assert.True(t, page.After.Time.Equal(dbPosts[0].CreatedAt))
+						return dbPosts, int32(4), nil
+					})
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+			verify: func(t *testing.T, resp *betterreads.GetUserFeedResponse) {
+				t.Helper()
+				require.NotEmpty(t, resp.Pagination.NextPageToken)
+				after, err := (&Server{}).decodePageToken(resp.Pagination.NextPageToken, "user_feed:"+testAuthorID)
+				require.NoError(t, err)
+				assert.Equal(t, "post-1", after.ID)
+			},
+		},
+		{
+			name: "page token issued for another user's feed",
+			ctx:  ctx,
+			request: &betterreads.GetUserFeedRequest{
+				UserId:    testAuthorID,
+				PageToken: (&Server{}).encodePageToken("user_feed:someone-else", postCursor(dbPosts[0])),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "user without posts",
+			ctx:  ctx,
+			request: &betterreads.GetUserFeedRequest{
+				UserId: testAuthorID,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetUserFeed(gomock.Any(), testAuthorID, data.Page{Limit: defaultPageLimit}).
+					Return(nil, int32(0), nil)
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+			verify: func(t *testing.T, resp *betterreads.GetUserFeedResponse) {
+				t.Helper()
+				assert.Empty(t, resp.Posts)
+				assert.Equal(t, int32(0), resp.Pagination.Total)
+				assert.Empty(t, resp.Pagination.NextPageToken)
+			},
+		},
+		{
+			name: "missing user_id in context",
+			ctx:  ctxNoUserID,
+			request: &betterreads.GetUserFeedRequest{
+				UserId: testAuthorID,
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:      "empty user_id",
+			ctx:       ctx,
+			request:   &betterreads.GetUserFeedRequest{},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "user not found",
+			ctx:  ctx,
+			request: &betterreads.GetUserFeedRequest{
+				UserId: testAuthorID,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetUserFeed(gomock.Any(), testAuthorID, gomock.Any()).
+					Return(nil, int32(0), postgres.ErrUserNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name: "database error",
+			ctx:  ctx,
+			request: &betterreads.GetUserFeedRequest{
+				UserId: testAuthorID,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetUserFeed(gomock.Any(), testAuthorID, gomock.Any()).
+					Return(nil, int32(0), errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.GetUserFeed(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+// ---- CreatePost --------------------------------------------------------
+
+func TestServer_CreatePost(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	ctxNoUserID := context.Background()
+
+	returnedPost := testPost("post-abc", testUserID, testTime)
+	publicHighlight := testHighlight("highlight-1", testUserID, testTime)
+	publicHighlight.IsPublic = true
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.CreatePostRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+		verify    func(*testing.T, *betterreads.CreatePostResponse)
+	}{
+		{
+			name: "successful creation",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Book:        testBookDetails(),
+				Description: "A desert planet, a messiah and a lot of spice.",
+				Summary:     "Loved it",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					CreatePost(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *data.Post) (*data.Post, error) {
+						assert.NotEmpty(t, p.ID)
+						assert.Equal(t, testUserID, p.UserID)
+						assert.Equal(t, "OL456M", p.Book.ID)
+						assert.Equal(t, int32(5), p.Book.Rating)
+						return returnedPost, nil
+					})
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+			verify: func(t *testing.T, resp *betterreads.CreatePostResponse) {
+				t.Helper()
+				require.NotNil(t, resp.Post)
+				assert.Equal(t, "post-abc", resp.Post.Id)
+				assert.Equal(t, testUserID, resp.Post.UserId)
+				assert.Equal(t, "Dune", resp.Post.Book.Title)
+				assert.Equal(t, betterreads.BookRating_BOOK_RATING_FIVE_STAR, resp.Post.Book.Rating)
+				assert.Equal(t, int32(3), resp.Post.LikeCount)
+				assert.Equal(t, int32(2), resp.Post.CommentCount)
+			},
+		},
+		{
+			name: "missing user_id in context",
+			ctx:  ctxNoUserID,
+			request: &betterreads.CreatePostRequest{
+				Book:        testBookDetails(),
+				Description: "description",
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name: "missing book",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Description: "description",
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "missing book id",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Book:        &betterreads.BookDetails{Title: "Dune", Author: "Frank Herbert"},
+				Description: "description",
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "invalid rating",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Book: &betterreads.BookDetails{
+					Id:     "OL456M",
+					Title:  "Dune",
+					Author: "Frank Herbert",
+					Rating: betterreads.BookRating(6),
+				},
+				Description: "description",
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "missing description",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Book: testBookDetails(),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "description too long",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Book:        testBookDetails(),
+				Description: strings.Repeat("é", maxPostDescriptionLength+1),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "summary too long",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Book:        testBookDetails(),
+				Description: "description",
+				Summary:     strings.Repeat("a", maxPostSummaryLength+1),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "shares a highlight",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Description: "The best line in the book",
+				HighlightId: "highlight-1",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetHighlight(gomock.Any(), testUserID, "highlight-1").
+					Return(publicHighlight, nil)
+				m.EXPECT().
+					CreatePost(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *data.Post) (*data.Post, error) {
+						assert.Equal(t, "OL456M", p.Book.ID)
+						assert.Equal(t, "Dune", p.Book.Title)
+						assert.Equal(t, "Frank Herbert", p.Book.Author)
+						assert.Equal(t, "highlight-1", p.HighlightID)
+						assert.Equal(t, "Fear is the mind-killer.", p.Quote)
+						return p, nil
+					})
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.CreatePostResponse) {
+				t.Helper()
+				assert.Equal(t, "highlight-1", resp.Post.HighlightId)
+				assert.Equal(t, "Fear is the mind-killer.", resp.Post.Quote)
+			},
+		},
+		{
+			name: "highlight from another book",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Book:        &betterreads.BookDetails{Id: "OL1M", Title: "Other", Author: "Someone"},
+				Description: "description",
+				HighlightId: "highlight-1",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetHighlight(gomock.Any(), testUserID, "highlight-1").
+					Return(publicHighlight, nil)
+			},
+			wantCode: codes.InvalidArgument,
+			wantErr:  true,
+		},
+		{
+			name: "private highlight",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Description: "description",
+				HighlightId: "highlight-1",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetHighlight(gomock.Any(), testUserID, "highlight-1").
+					Return(testHighlight("highlight-1", testUserID, testTime), nil)
+			},
+			wantCode: codes.FailedPrecondition,
+			wantErr:  true,
+		},
+		{
+			name: "highlight not found",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Description: "description",
+				HighlightId: "highlight-1",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetHighlight(gomock.Any(), testUserID, "highlight-1").
+					Return(nil, postgres.ErrHighlightNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name: "author has no profile",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Book:        testBookDetails(),
+				Description: "description",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					CreatePost(gomock.Any(), gomock.Any()).
+					Return(nil, postgres.ErrPostAuthorNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name: "database error",
+			ctx:  ctx,
+			request: &betterreads.CreatePostRequest{
+				Book:        testBookDetails(),
+				Description: "description",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					CreatePost(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.CreatePost(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+// ---- UpdatePost --------------------------------------------------------
+
+func TestServer_UpdatePost(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testPostID := "post-abc"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	ctxNoUserID := context.Background()
+
+	returnedPost := testPost(testPostID, testUserID, testTime)
+	returnedPost.Description = "Updated description"
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.UpdatePostRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+		verify    func(*testing.T, *betterreads.UpdatePostResponse)
+	}{
+		{
+			name: "successful update",
+			ctx:  ctx,
+			request: &betterreads.UpdatePostRequest{
+				PostId:      testPostID,
+				Book:        testBookDetails(),
+				Description: "Updated description",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UpdatePost(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *data.Post) (*data.Post, error) {
+						assert.Equal(t, testPostID, p.ID)
+						assert.Equal(t, testUserID, p.UserID)
+						return returnedPost, nil
+					})
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+			verify: func(t *testing.T, resp *betterreads.UpdatePostResponse) {
+				t.Helper()
+				require.NotNil(t, resp.Post)
+				assert.Equal(t, testPostID, resp.Post.Id)
+				assert.Equal(t, "Updated description", resp.Post.Description)
+			},
+		},
+		{
+			name: "missing user_id in context",
+			ctx:  ctxNoUserID,
+			request: &betterreads.UpdatePostRequest{
+				PostId:      testPostID,
+				Book:        testBookDetails(),
+				Description: "Updated description",
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name: "empty post_id",
+			ctx:  ctx,
+			request: &betterreads.UpdatePostRequest{
+				Book:        testBookDetails(),
+				Description: "Updated description",
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "missing book",
+			ctx:  ctx,
+			request: &betterreads.UpdatePostRequest{
+				PostId:      testPostID,
+				Description: "Updated description",
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "description too long",
+			ctx:  ctx,
+			request: &betterreads.UpdatePostRequest{
+				PostId:      testPostID,
+				Book:        testBookDetails(),
+				Description: strings.Repeat("a", maxPostDescriptionLength+1),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "summary too long",
+			ctx:  ctx,
+			request: &betterreads.UpdatePostRequest{
+				PostId:      testPostID,
+				Book:        testBookDetails(),
+				Description: "Updated description",
+				Summary:     strings.Repeat("é", maxPostSummaryLength+1),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "post not found",
+			ctx:  ctx,
+			request: &betterreads.UpdatePostRequest{
+				PostId:      testPostID,
+				Book:        testBookDetails(),
+				Description: "Updated description",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UpdatePost(gomock.Any(), gomock.Any()).
+					Return(nil, postgres.ErrPostNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name: "caller is not the author",
+			ctx:  ctx,
+			request: &betterreads.UpdatePostRequest{
+				PostId:      testPostID,
+				Book:        testBookDetails(),
+				Description: "Updated description",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UpdatePost(gomock.Any(), gomock.Any()).
+					Return(nil, postgres.ErrNotPostAuthor)
+			},
+			wantCode: codes.PermissionDenied,
+			wantErr:  true,
+		},
+		{
+			name: "database error",
+			ctx:  ctx,
+			request: &betterreads.UpdatePostRequest{
+				PostId:      testPostID,
+				Book:        testBookDetails(),
+				Description: "Updated description",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UpdatePost(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.UpdatePost(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+// ---- DeletePost --------------------------------------------------------
+
+func TestServer_DeletePost(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testPostID := "post-abc"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	ctxNoUserID := context.Background()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.DeletePostRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+	}{
+		{
+			name:    "successful deletion",
+			ctx:     ctx,
+			request: &betterreads.DeletePostRequest{PostId: testPostID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					DeletePost(gomock.Any(), testUserID, testPostID).
+					Return(nil)
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       ctxNoUserID,
+			request:   &betterreads.DeletePostRequest{PostId: testPostID},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:      "empty post_id",
+			ctx:       ctx,
+			request:   &betterreads.DeletePostRequest{},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:    "post not found",
+			ctx:     ctx,
+			request: &betterreads.DeletePostRequest{PostId: testPostID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					DeletePost(gomock.Any(), testUserID, testPostID).
+					Return(postgres.ErrPostNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name:    "caller is not the author",
+			ctx:     ctx,
+			request: &betterreads.DeletePostRequest{PostId: testPostID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					DeletePost(gomock.Any(), testUserID, testPostID).
+					Return(postgres.ErrNotPostAuthor)
+			},
+			wantCode: codes.PermissionDenied,
+			wantErr:  true,
+		},
+		{
+			name:    "database error",
+			ctx:     ctx,
+			request: &betterreads.DeletePostRequest{PostId: testPostID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					DeletePost(gomock.Any(), testUserID, testPostID).
+					Return(errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.DeletePost(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+	}
+}
+
+// ---- GetCommentsForPost ------------------------------------------------
+
+func TestServer_GetCommentsForPost(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testPostID := "post-abc"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	ctxNoUserID := context.Background()
+
+	dbComments := []*data.Comment{
+		{ID: "comment-1", PostID: testPostID, UserID: "user-a", Content: "First!", CreatedAt: testTime},
+		{ID: "comment-2", PostID: testPostID, UserID: "user-b", Content: "Great pick", CreatedAt: testTime.Add(time.Minute)},
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.GetCommentsForPostRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+		verify    func(*testing.T, *betterreads.GetCommentsForPostResponse)
+	}{
+		{
+			name: "successful retrieval with page and limit",
+			ctx:  ctx,
+			request: &betterreads.GetCommentsForPostRequest{
+				PostId: testPostID,
+				Page:   3,
+				Limit:  2,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetCommentsForPost(gomock.Any(), testPostID, data.Page{Limit: 2, Offset: 4}).
+					Return(dbComments, int32(6), nil)
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+			verify: func(t *testing.T, resp *betterreads.GetCommentsForPostResponse) {
+				t.Helper()
+				require.Len(t, resp.Comments, 2)
+				assert.Equal(t, "comment-1", resp.Comments[0].Id)
+				assert.Equal(t, "First!", resp.Comments[0].Content)
+				assert.Equal(t, "user-b", resp.Comments[1].UserId)
+				assert.Equal(t, int32(3), resp.Pagination.Page)
+				assert.Equal(t, int32(2), resp.Pagination.Limit)
+				assert.Equal(t, int32(6), resp.Pagination.Total)
+			},
+		},
+		{
+			name: "defaults applied when page and limit are omitted",
+			ctx:  ctx,
+			request: &betterreads.GetCommentsForPostRequest{
+				PostId: testPostID,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetCommentsForPost(gomock.Any(), testPostID, data.Page{Limit: defaultPageLimit}).
+					Return(nil, int32(0), nil)
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+			verify: func(t *testing.T, resp *betterreads.GetCommentsForPostResponse) {
+				t.Helper()
+				assert.Empty(t, resp.Comments)
+				assert.Equal(t, int32(1), resp.Pagination.Page)
+				assert.Equal(t, defaultPageLimit, resp.Pagination.Limit)
+			},
+		},
+		{
+			name: "missing user_id in context",
+			ctx:  ctxNoUserID,
+			request: &betterreads.GetCommentsForPostRequest{
+				PostId: testPostID,
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:      "empty post_id",
+			ctx:       ctx,
+			request:   &betterreads.GetCommentsForPostRequest{},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "post not found",
+			ctx:  ctx,
+			request: &betterreads.GetCommentsForPostRequest{
+				PostId: testPostID,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetCommentsForPost(gomock.Any(), testPostID, gomock.Any()).
+					Return(nil, int32(0), postgres.ErrPostNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name: "database error",
+			ctx:  ctx,
+			request: &betterreads.GetCommentsForPostRequest{
+				PostId: testPostID,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetCommentsForPost(gomock.Any(), testPostID, gomock.Any()).
+					Return(nil, int32(0), errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.GetCommentsForPost(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+// ---- AddComment --------------------------------------------------------
+
+func TestServer_AddComment(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testPostID := "post-abc"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	ctxNoUserID := context.Background()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.AddCommentRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+		verify    func(*testing.T, *betterreads.AddCommentResponse)
+	}{
+		{
+			name: "successful comment",
+			ctx:  ctx,
+			request: &betterreads.AddCommentRequest{
+				PostId:  testPostID,
+				Content: "Great pick",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					AddComment(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, c *data.Comment) (*data.Comment, error) {
+						c.CreatedAt = testTime
+						return c, nil
+					})
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+			verify: func(t *testing.T, resp *betterreads.AddCommentResponse) {
+				t.Helper()
+				require.NotNil(t, resp.Comment)
+				assert.NotEmpty(t, resp.Comment.Id)
+				assert.Equal(t, testPostID, resp.Comment.PostId)
+				assert.Equal(t, testUserID, resp.Comment.UserId)
+				assert.Equal(t, "Great pick", resp.Comment.Content)
+				assert.Equal(t, testTime, resp.Comment.CreatedAt.AsTime())
+			},
+		},
+		{
+			name: "missing user_id in context",
+			ctx:  ctxNoUserID,
+			request: &betterreads.AddCommentRequest{
+				PostId:  testPostID,
+				Content: "Great pick",
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name: "empty post_id",
+			ctx:  ctx,
+			request: &betterreads.AddCommentRequest{
+				Content: "Great pick",
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "empty content",
+			ctx:  ctx,
+			request: &betterreads.AddCommentRequest{
+				PostId: testPostID,
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "content too long",
+			ctx:  ctx,
+			request: &betterreads.AddCommentRequest{
+				PostId:  testPostID,
+				Content: strings.Repeat("é", maxCommentLength+1),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "post not found",
+			ctx:  ctx,
+			request: &betterreads.AddCommentRequest{
+				PostId:  testPostID,
+				Content: "Great pick",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					AddComment(gomock.Any(), gomock.Any()).
+					Return(nil, postgres.ErrPostNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name: "database error",
+			ctx:  ctx,
+			request: &betterreads.AddCommentRequest{
+				PostId:  testPostID,
+				Content: "Great pick",
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					AddComment(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.AddComment(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+// ---- DeleteComment -----------------------------------------------------
+
+func TestServer_DeleteComment(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testPostID := "post-abc"
+	testCommentID := "comment-1"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	ctxNoUserID := context.Background()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.DeleteCommentRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+	}{
+		{
+			name:    "successful deletion",
+			ctx:     ctx,
+			request: &betterreads.DeleteCommentRequest{PostId: testPostID, CommentId: testCommentID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					DeleteComment(gomock.Any(), testUserID, testPostID, testCommentID).
+					Return(nil)
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       ctxNoUserID,
+			request:   &betterreads.DeleteCommentRequest{PostId: testPostID, CommentId: testCommentID},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:      "empty post_id",
+			ctx:       ctx,
+			request:   &betterreads.DeleteCommentRequest{CommentId: testCommentID},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "empty comment_id",
+			ctx:       ctx,
+			request:   &betterreads.DeleteCommentRequest{PostId: testPostID},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:    "comment not found",
+			ctx:     ctx,
+			request: &betterreads.DeleteCommentRequest{PostId: testPostID, CommentId: testCommentID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					DeleteComment(gomock.Any(), testUserID, testPostID, testCommentID).
+					Return(postgres.ErrCommentNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name:    "caller is neither comment nor post author",
+			ctx:     ctx,
+			request: &betterreads.DeleteCommentRequest{PostId: testPostID, CommentId: testCommentID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					DeleteComment(gomock.Any(), testUserID, testPostID, testCommentID).
+					Return(postgres.ErrCannotDeleteComment)
+			},
+			wantCode: codes.PermissionDenied,
+			wantErr:  true,
+		},
+		{
+			name:    "database error",
+			ctx:     ctx,
+			request: &betterreads.DeleteCommentRequest{PostId: testPostID, CommentId: testCommentID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					DeleteComment(gomock.Any(), testUserID, testPostID, testCommentID).
+					Return(errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.DeleteComment(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+	}
+}
+
+// ---- LikePost ----------------------------------------------------------
+
+func TestServer_LikePost(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testPostID := "post-abc"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	ctxNoUserID := context.Background()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.LikePostRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+	}{
+		{
+			name:    "successful like",
+			ctx:     ctx,
+			request: &betterreads.LikePostRequest{PostId: testPostID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					LikePost(gomock.Any(), testUserID, testPostID).
+					Return(nil)
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       ctxNoUserID,
+			request:   &betterreads.LikePostRequest{PostId: testPostID},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:      "empty post_id",
+			ctx:       ctx,
+			request:   &betterreads.LikePostRequest{},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:    "post not found",
+			ctx:     ctx,
+			request: &betterreads.LikePostRequest{PostId: testPostID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					LikePost(gomock.Any(), testUserID, testPostID).
+					Return(postgres.ErrPostNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name:    "database error",
+			ctx:     ctx,
+			request: &betterreads.LikePostRequest{PostId: testPostID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					LikePost(gomock.Any(), testUserID, testPostID).
+					Return(errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.LikePost(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+	}
+}
+
+// ---- UnlikePost --------------------------------------------------------
+
+func TestServer_UnlikePost(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testPostID := "post-abc"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	ctxNoUserID := context.Background()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.UnlikePostRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+	}{
+		{
+			name:    "successful unlike",
+			ctx:     ctx,
+			request: &betterreads.UnlikePostRequest{PostId: testPostID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UnlikePost(gomock.Any(), testUserID, testPostID).
+					Return(nil)
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       ctxNoUserID,
+			request:   &betterreads.UnlikePostRequest{PostId: testPostID},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:      "empty post_id",
+			ctx:       ctx,
+			request:   &betterreads.UnlikePostRequest{},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:    "post not found",
+			ctx:     ctx,
+			request: &betterreads.UnlikePostRequest{PostId: testPostID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UnlikePost(gomock.Any(), testUserID, testPostID).
+					Return(postgres.ErrPostNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name:    "database error",
+			ctx:     ctx,
+			request: &betterreads.UnlikePostRequest{PostId: testPostID},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UnlikePost(gomock.Any(), testUserID, testPostID).
+					Return(errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.UnlikePost(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.NotNil(t, resp)
+		})
+	}
+}
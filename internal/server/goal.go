@@ -0,0 +1,173 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Goals can be set for any year a timestamp can represent.
+const (
+	minGoalYear = 1
+	maxGoalYear = 9999
+)
+
+// SetReadingGoal implements betterreads.BetterReadsServiceServer.
+func (s *Server) SetReadingGoal(ctx context.Context, req *betterreads.SetReadingGoalRequest) (*betterreads.SetReadingGoalResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if err := validateGoalYear(req.Year); err != nil {
+		return nil, err
+	}
+	if req.TargetBooks < 0 || req.TargetPages < 0 {
+		return nil, status.Error(codes.InvalidArgument, "target_books and target_pages must not be negative")
+	}
+	if req.TargetBooks == 0 && req.TargetPages == 0 {
+		return nil, status.Error(codes.InvalidArgument, "target_books or target_pages is required")
+	}
+
+	goal, err := s.DB.SetReadingGoal(ctx, &data.ReadingGoal{
+		UserID:      userID,
+		Year:        req.Year,
+		TargetBooks: req.TargetBooks,
+		TargetPages: req.TargetPages,
+		UpdatedAt:   time.Now(),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set reading goal: %v", err)
+	}
+
+	return &betterreads.SetReadingGoalResponse{Goal: readingGoalToProto(goal)}, nil
+}
+
+// GetReadingGoal implements betterreads.BetterReadsServiceServer.
+func (s *Server) GetReadingGoal(ctx context.Context, req *betterreads.GetReadingGoalRequest) (*betterreads.GetReadingGoalResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if err := validateGoalYear(req.Year); err != nil {
+		return nil, err
+	}
+
+	goal, err := s.DB.GetReadingGoal(ctx, userID, req.Year)
+	if err != nil {
+		if errors.Is(err, postgres.ErrReadingGoalNotFound) {
+			return nil, status.Error(codes.NotFound, "reading goal not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get reading goal: %v", err)
+	}
+
+	books, err := s.DB.GetGoalBooks(ctx, userID, req.Year)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reading goal books: %v", err)
+	}
+
+	return &betterreads.GetReadingGoalResponse{
+		Goal:     readingGoalToProto(goal),
+		Progress: goalProgress(goal, books, time.Now()),
+	}, nil
+}
+
+// DeleteReadingGoal implements betterreads.BetterReadsServiceServer.
+func (s *Server) DeleteReadingGoal(ctx context.Context, req *betterreads.DeleteReadingGoalRequest) (*betterreads.DeleteReadingGoalResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if err := validateGoalYear(req.Year); err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.DeleteReadingGoal(ctx, userID, req.Year); err != nil {
+		if errors.Is(err, postgres.ErrReadingGoalNotFound) {
+			return nil, status.Error(codes.NotFound, "reading goal not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete reading goal: %v", err)
+	}
+
+	return &betterreads.DeleteReadingGoalResponse{}, nil
+}
+
+func validateGoalYear(year int32) error {
+	if year < minGoalYear || year > maxGoalYear {
+		return status.Error(codes.InvalidArgument, "year must be between 1 and 9999")
+	}
+	return nil
+}
+
+// goalProgress measures books against goal as of now. The pace is judged
+// against an even spread of each target over the year, using the books target
+// when there is one and the pages target otherwise.
+func goalProgress(goal *data.ReadingGoal, books []*data.GoalBook, now time.Time) *betterreads.ReadingGoalProgress {
+	progress := &betterreads.ReadingGoalProgress{
+		BooksRead: int32(len(books)), //nolint:gosec // integer overflow unlikely
+		Books:     make([]*betterreads.ReadingGoalBook, 0, len(books)),
+	}
+	for _, b := range books {
+		book := &betterreads.ReadingGoalBook{
+			Book:       libraryBookToProto(b.Book),
+			FinishedAt: timestamppb.New(b.FinishedAt),
+		}
+		if b.Pages > 0 {
+			book.Pages = proto.Int32(b.Pages)
+			progress.PagesRead += b.Pages
+		} else {
+			progress.BooksWithoutPages++
+		}
+		progress.Books = append(progress.Books, book)
+	}
+
+	start := time.Date(int(goal.Year), time.January, 1, 0, 0, 0, 0, time.UTC)
+	elapsed := float64(now.Sub(start)) / float64(start.AddDate(1, 0, 0).Sub(start))
+	elapsed = math.Max(0, math.Min(1, elapsed))
+	progress.ExpectedBooks = int32(math.Floor(float64(goal.TargetBooks) * elapsed))
+	progress.ExpectedPages = int32(math.Floor(float64(goal.TargetPages) * elapsed))
+	progress.BooksAhead = progress.BooksRead - progress.ExpectedBooks
+	progress.PagesAhead = progress.PagesRead - progress.ExpectedPages
+
+	ahead, read, target := progress.BooksAhead, progress.BooksRead, goal.TargetBooks
+	if target == 0 {
+		ahead, read, target = progress.PagesAhead, progress.PagesRead, goal.TargetPages
+	}
+	progress.Percent = 100 * float64(read) / float64(target)
+
+	booksDone := progress.BooksRead >= goal.TargetBooks
+	pagesDone := progress.PagesRead >= goal.TargetPages
+	switch {
+	case booksDone && pagesDone:
+		progress.Pace = betterreads.GoalPace_GOAL_PACE_COMPLETED
+	case ahead > 0:
+		progress.Pace = betterreads.GoalPace_GOAL_PACE_AHEAD
+	case ahead < 0:
+		progress.Pace = betterreads.GoalPace_GOAL_PACE_BEHIND
+	default:
+		progress.Pace = betterreads.GoalPace_GOAL_PACE_ON_TRACK
+	}
+	return progress
+}
+
+func readingGoalToProto(g *data.ReadingGoal) *betterreads.ReadingGoal {
+	return &betterreads.ReadingGoal{
+		Year:        g.Year,
+		TargetBooks: g.TargetBooks,
+		TargetPages: g.TargetPages,
+		CreatedAt:   timestamppb.New(g.CreatedAt),
+		UpdatedAt:   timestamppb.New(g.UpdatedAt),
+	}
+}
@@ -0,0 +1,335 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/celestialdragonfly/betterreads/internal/postgres/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ---- SetReadingGoal ----------------------------------------------------
+
+func TestServer_SetReadingGoal(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.SetReadingGoalRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+	}{
+		{
+			name:    "sets a books goal",
+			ctx:     ctx,
+			request: &betterreads.SetReadingGoalRequest{Year: 2026, TargetBooks: 52},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					SetReadingGoal(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, g *data.ReadingGoal) (*data.ReadingGoal, error) {
+						assert.Equal(t, testUserID, g.UserID)
+						assert.Equal(t, int32(2026), g.Year)
+						assert.Equal(t, int32(52), g.TargetBooks)
+						assert.Zero(t, g.TargetPages)
+						return g, nil
+					})
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "no target",
+			ctx:       ctx,
+			request:   &betterreads.SetReadingGoalRequest{Year: 2026},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "negative target",
+			ctx:       ctx,
+			request:   &betterreads.SetReadingGoalRequest{Year: 2026, TargetBooks: 10, TargetPages: -1},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "invalid year",
+			ctx:       ctx,
+			request:   &betterreads.SetReadingGoalRequest{TargetBooks: 10},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       context.Background(),
+			request:   &betterreads.SetReadingGoalRequest{Year: 2026, TargetBooks: 52},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+		},
+		{
+			name:    "database error",
+			ctx:     ctx,
+			request: &betterreads.SetReadingGoalRequest{Year: 2026, TargetPages: 10000},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().SetReadingGoal(gomock.Any(), gomock.Any()).Return(nil, errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			_, err := s.SetReadingGoal(tt.ctx, tt.request)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+// ---- GetReadingGoal ----------------------------------------------------
+
+func TestServer_GetReadingGoal(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	finishedAt := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		verify    func(*testing.T, *betterreads.GetReadingGoalResponse)
+	}{
+		{
+			name: "returns progress",
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().GetReadingGoal(gomock.Any(), testUserID, int32(2025)).
+					Return(&data.ReadingGoal{UserID: testUserID, Year: 2025, TargetBooks: 3}, nil)
+				m.EXPECT().GetGoalBooks(gomock.Any(), testUserID, int32(2025)).
+					Return([]*data.GoalBook{
+						{
+							Book:       &data.LibraryBook{BookID: "OL1M", Title: "Dune", ReadingStatus: data.ReadingStatusRead},
+							FinishedAt: finishedAt,
+							Pages:      412,
+						},
+						{
+							Book:       &data.LibraryBook{BookID: "OL2M", Title: "Emma", ReadingStatus: data.ReadingStatusRead},
+							FinishedAt: finishedAt.AddDate(0, -1, 0),
+						},
+					}, nil)
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.GetReadingGoalResponse) {
+				t.Helper()
+				assert.Equal(t, int32(3), resp.Goal.TargetBooks)
+				// 2025 is over, so the unmet goal is behind.
+				assert.Equal(t, int32(2), resp.Progress.BooksRead)
+				assert.Equal(t, int32(412), resp.Progress.PagesRead)
+				assert.Equal(t, int32(1), resp.Progress.BooksWithoutPages)
+				assert.Equal(t, betterreads.GoalPace_GOAL_PACE_BEHIND, resp.Progress.Pace)
+				require.Len(t, resp.Progress.Books, 2)
+				assert.Equal(t, "OL1M", resp.Progress.Books[0].Book.BookId)
+				assert.Equal(t, finishedAt, resp.Progress.Books[0].FinishedAt.AsTime())
+				require.NotNil(t, resp.Progress.Books[0].Pages)
+				assert.Equal(t, int32(412), *resp.Progress.Books[0].Pages)
+				assert.Nil(t, resp.Progress.Books[1].Pages)
+			},
+		},
+		{
+			name: "no goal",
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().GetReadingGoal(gomock.Any(), testUserID, int32(2025)).Return(nil, postgres.ErrReadingGoalNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "database error",
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().GetReadingGoal(gomock.Any(), testUserID, int32(2025)).
+					Return(&data.ReadingGoal{UserID: testUserID, Year: 2025, TargetBooks: 2}, nil)
+				m.EXPECT().GetGoalBooks(gomock.Any(), testUserID, int32(2025)).Return(nil, errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.GetReadingGoal(ctx, &betterreads.GetReadingGoalRequest{Year: 2025})
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+// ---- DeleteReadingGoal -------------------------------------------------
+
+func TestServer_DeleteReadingGoal(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "deleted", wantCode: codes.OK},
+		{name: "not found", err: postgres.ErrReadingGoalNotFound, wantCode: codes.NotFound},
+		{name: "database error", err: errors.New("database connection failed"), wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			mockDB.EXPECT().DeleteReadingGoal(gomock.Any(), testUserID, int32(2026)).Return(tt.err)
+
+			s := &Server{DB: mockDB}
+			_, err := s.DeleteReadingGoal(ctx, &betterreads.DeleteReadingGoalRequest{Year: 2026})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+// ---- goalProgress ------------------------------------------------------
+
+func Test_goalProgress(t *testing.T) {
+	t.Parallel()
+
+	// A quarter of the way through 2026.
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC).Add(365 * 24 * time.Hour / 4)
+	read := func(pages ...int32) []*data.GoalBook {
+		books := make([]*data.GoalBook, 0, len(pages))
+		for _, p := range pages {
+			books = append(books, &data.GoalBook{Book: &data.LibraryBook{}, FinishedAt: now, Pages: p})
+		}
+		return books
+	}
+
+	tests := []struct {
+		name        string
+		goal        data.ReadingGoal
+		books       []*data.GoalBook
+		now         time.Time
+		wantPace    betterreads.GoalPace
+		wantAhead   int32
+		wantPercent float64
+	}{
+		{
+			name:        "on track",
+			goal:        data.ReadingGoal{Year: 2026, TargetBooks: 40},
+			books:       read(100, 200, 300, 400, 500, 600, 700, 800, 900, 1000),
+			now:         now,
+			wantPace:    betterreads.GoalPace_GOAL_PACE_ON_TRACK,
+			wantAhead:   0,
+			wantPercent: 25,
+		},
+		{
+			name:        "ahead",
+			goal:        data.ReadingGoal{Year: 2026, TargetBooks: 4},
+			books:       read(0, 0, 0),
+			now:         now,
+			wantPace:    betterreads.GoalPace_GOAL_PACE_AHEAD,
+			wantAhead:   2,
+			wantPercent: 75,
+		},
+		{
+			name:        "behind on pages",
+			goal:        data.ReadingGoal{Year: 2026, TargetPages: 10000},
+			books:       read(300, 400),
+			now:         now,
+			wantPace:    betterreads.GoalPace_GOAL_PACE_BEHIND,
+			wantAhead:   -1800,
+			wantPercent: 7,
+		},
+		{
+			name:        "completed",
+			goal:        data.ReadingGoal{Year: 2026, TargetBooks: 2, TargetPages: 500},
+			books:       read(300, 400),
+			now:         now,
+			wantPace:    betterreads.GoalPace_GOAL_PACE_COMPLETED,
+			wantAhead:   2,
+			wantPercent: 100,
+		},
+		{
+			name:        "books done but pages short",
+			goal:        data.ReadingGoal{Year: 2026, TargetBooks: 2, TargetPages: 5000},
+			books:       read(300, 400),
+			now:         now,
+			wantPace:    betterreads.GoalPace_GOAL_PACE_AHEAD,
+			wantAhead:   2,
+			wantPercent: 100,
+		},
+		{
+			name:        "future year",
+			goal:        data.ReadingGoal{Year: 2027, TargetBooks: 12},
+			now:         now,
+			wantPace:    betterreads.GoalPace_GOAL_PACE_ON_TRACK,
+			wantAhead:   0,
+			wantPercent: 0,
+		},
+		{
+			name:        "past year missed",
+			goal:        data.ReadingGoal{Year: 2025, TargetBooks: 12},
+			books:       read(0),
+			now:         now,
+			wantPace:    betterreads.GoalPace_GOAL_PACE_BEHIND,
+			wantAhead:   -11,
+			wantPercent: 100.0 / 12,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			progress := goalProgress(&tt.goal, tt.books, tt.now)
+			assert.Equal(t, tt.wantPace, progress.Pace)
+			if tt.goal.TargetBooks > 0 {
+				assert.Equal(t, tt.wantAhead, progress.BooksAhead)
+			} else {
+				assert.Equal(t, tt.wantAhead, progress.PagesAhead)
+			}
+			assert.InDelta(t, tt.wantPercent, progress.Percent, 0.001)
+			assert.Len(t, progress.Books, len(tt.books))
+		})
+	}
+}
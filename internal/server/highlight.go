@@ -0,0 +1,378 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/importer"
+	"github.com/celestialdragonfly/betterreads/internal/logger"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Limits on the text of a highlight, in characters.
+const (
+	maxQuoteLength            = 5000
+	maxLocationLength         = 100
+	maxHighlightCommentLength = 10000
+)
+
+// CreateHighlight implements betterreads.BetterReadsServiceServer.
+func (s *Server) CreateHighlight(ctx context.Context, req *betterreads.CreateHighlightRequest) (*betterreads.CreateHighlightResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.BookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "book_id is required")
+	}
+
+	now := time.Now()
+	highlight := &data.Highlight{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		BookID:    req.BookId,
+		Quote:     req.Quote,
+		Page:      req.Page,
+		Location:  req.Location,
+		Comment:   req.Comment,
+		IsPublic:  req.IsPublic,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := normalizeHighlight(highlight); err != nil {
+		return nil, err
+	}
+
+	created, err := s.DB.CreateHighlight(ctx, highlight)
+	if err != nil {
+		if errors.Is(err, postgres.ErrBookNotFound) {
+			return nil, status.Error(codes.NotFound, "book not found in library")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create highlight: %v", err)
+	}
+
+	return &betterreads.CreateHighlightResponse{Highlight: highlightToProto(created)}, nil
+}
+
+// UpdateHighlight implements betterreads.BetterReadsServiceServer.
+func (s *Server) UpdateHighlight(ctx context.Context, req *betterreads.UpdateHighlightRequest) (*betterreads.UpdateHighlightResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.HighlightId == "" {
+		return nil, status.Error(codes.InvalidArgument, "highlight_id is required")
+	}
+
+	highlight := &data.Highlight{
+		ID:        req.HighlightId,
+		UserID:    userID,
+		Quote:     req.Quote,
+		Page:      req.Page,
+		Location:  req.Location,
+		Comment:   req.Comment,
+		IsPublic:  req.IsPublic,
+		UpdatedAt: time.Now(),
+	}
+	if err := normalizeHighlight(highlight); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.DB.UpdateHighlight(ctx, highlight)
+	if err != nil {
+		if errors.Is(err, postgres.ErrHighlightNotFound) {
+			return nil, status.Error(codes.NotFound, "highlight not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update highlight: %v", err)
+	}
+
+	return &betterreads.UpdateHighlightResponse{Highlight: highlightToProto(updated)}, nil
+}
+
+// DeleteHighlight implements betterreads.BetterReadsServiceServer.
+func (s *Server) DeleteHighlight(ctx context.Context, req *betterreads.DeleteHighlightRequest) (*betterreads.DeleteHighlightResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.HighlightId == "" {
+		return nil, status.Error(codes.InvalidArgument, "highlight_id is required")
+	}
+
+	if err := s.DB.DeleteHighlight(ctx, userID, req.HighlightId); err != nil {
+		if errors.Is(err, postgres.ErrHighlightNotFound) {
+			return nil, status.Error(codes.NotFound, "highlight not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete highlight: %v", err)
+	}
+
+	return &betterreads.DeleteHighlightResponse{}, nil
+}
+
+// ListHighlights implements betterreads.BetterReadsServiceServer.
+func (s *Server) ListHighlights(ctx context.Context, req *betterreads.ListHighlightsRequest) (*betterreads.ListHighlightsResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	ownerID := req.UserId
+	if ownerID == "" {
+		ownerID = userID
+	}
+	publicOnly := ownerID != userID
+
+	list := "highlights:" + ownerID + ":" + req.BookId
+	page, err := newPageRequest(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	page, err = s.withPageToken(page, req.PageToken, list)
+	if err != nil {
+		return nil, err
+	}
+
+	highlights, total, err := s.DB.GetHighlights(ctx, ownerID, req.BookId, publicOnly, page.Query())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list highlights: %v", err)
+	}
+
+	pbHighlights := make([]*betterreads.Highlight, 0, len(highlights))
+	for _, h := range highlights {
+		pbHighlights = append(pbHighlights, highlightToProto(h))
+	}
+
+	pagination := page.Metadata(total)
+	pagination.NextPageToken = nextPageToken(s, page, list, highlights, highlightCursor)
+
+	return &betterreads.ListHighlightsResponse{
+		Highlights: pbHighlights,
+		Pagination: pagination,
+	}, nil
+}
+
+// ImportKindleHighlights implements betterreads.BetterReadsServiceServer.
+func (s *Server) ImportKindleHighlights(ctx context.Context, req *betterreads.ImportKindleHighlightsRequest) (*betterreads.ImportKindleHighlightsResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if len(req.File) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "file is required")
+	}
+
+	clippings, err := importer.ParseKindleClippings(bytes.NewReader(req.File))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "not a Kindle clippings file: %v", err)
+	}
+
+	imp := &clippingImport{
+		userID: userID,
+		dryRun: req.DryRun,
+		books:  map[string]*data.LibraryBook{},
+		seen:   map[string]bool{},
+	}
+	resp := &betterreads.ImportKindleHighlightsResponse{
+		Rows:   make([]*betterreads.ImportRowResult, 0, len(clippings)),
+		DryRun: req.DryRun,
+	}
+	for _, clipping := range clippings {
+		result := s.importClipping(ctx, imp, clipping)
+		switch result.Status {
+		case betterreads.ImportRowStatus_IMPORT_ROW_STATUS_IMPORTED:
+			resp.ImportedCount++
+		case betterreads.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED:
+			resp.SkippedCount++
+		default:
+			resp.FailedCount++
+		}
+		resp.Rows = append(resp.Rows, result)
+	}
+	return resp, nil
+}
+
+// clippingImport is the state of one Kindle import as it works through the
+// clippings.
+type clippingImport struct {
+	userID string
+	dryRun bool
+	// books caches the library book matched to each title and author, or
+	// nil when there is none.
+	books map[string]*data.LibraryBook
+	// seen holds the book and quote of each highlight imported so far, so a
+	// quote clipped twice is skipped the second time.
+	seen map[string]bool
+}
+
+// importClipping adds one clipping as a highlight on the library book with
+// its title. In a dry run nothing is written and the result is what the
+// import would have done.
+func (s *Server) importClipping(ctx context.Context, imp *clippingImport, clipping importer.Clipping) *betterreads.ImportRowResult {
+	result := &betterreads.ImportRowResult{
+		Line:  int32(clipping.Line), //nolint:gosec // G115: line count is bounded by message size
+		Title: clipping.Highlight.BookTitle,
+	}
+	failed := func(message string) *betterreads.ImportRowResult {
+		result.Status = betterreads.ImportRowStatus_IMPORT_ROW_STATUS_FAILED
+		result.Message = message
+		return result
+	}
+	skipped := func() *betterreads.ImportRowResult {
+		result.Status = betterreads.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED
+		result.Message = "highlight already imported"
+		return result
+	}
+
+	if clipping.Err != nil {
+		return failed(clipping.Err.Error())
+	}
+
+	book, err := s.clippingBook(ctx, imp, clipping.Highlight.BookTitle, clipping.Highlight.AuthorName)
+	if err != nil {
+		logger.Error("kindle import: failed to find book", "user_id", imp.userID, "title", clipping.Highlight.BookTitle, "error", err)
+		return failed("failed to find book in library")
+	}
+	if book == nil {
+		return failed("no book with this title in library")
+	}
+	result.BookId = book.BookID
+
+	now := time.Now()
+	highlight := clipping.Highlight
+	highlight.ID = uuid.New().String()
+	highlight.UserID = imp.userID
+	highlight.BookID = book.BookID
+	highlight.UpdatedAt = now
+	if highlight.CreatedAt.IsZero() {
+		highlight.CreatedAt = now
+	}
+	if err := normalizeHighlight(&highlight); err != nil {
+		return failed(status.Convert(err).Message())
+	}
+
+	key := highlight.BookID + "\x00" + highlight.Quote
+	if imp.seen[key] {
+		return skipped()
+	}
+	imp.seen[key] = true
+	if imp.dryRun {
+		result.Status = betterreads.ImportRowStatus_IMPORT_ROW_STATUS_IMPORTED
+		return result
+	}
+
+	added, err := s.DB.ImportHighlight(ctx, &highlight)
+	if err != nil {
+		if errors.Is(err, postgres.ErrBookNotFound) {
+			return failed("book was removed from library during import")
+		}
+		logger.Error("kindle import: failed to add highlight", "user_id", imp.userID, "book_id", highlight.BookID, "error", err)
+		return failed("failed to add highlight")
+	}
+	if !added {
+		return skipped()
+	}
+
+	result.Status = betterreads.ImportRowStatus_IMPORT_ROW_STATUS_IMPORTED
+	return result
+}
+
+// clippingBook returns the library book a Kindle title refers to, or nil if
+// there is none. Kindle titles often carry a series or subtitle the library
+// entry lacks, so those are dropped in turn until a book matches.
+func (s *Server) clippingBook(ctx context.Context, imp *clippingImport, title, author string) (*data.LibraryBook, error) {
+	key := title + "\x00" + author
+	if book, ok := imp.books[key]; ok {
+		return book, nil
+	}
+
+	for _, candidate := range clippingTitles(title) {
+		book, err := s.DB.FindLibraryBookByTitle(ctx, imp.userID, candidate, author)
+		if errors.Is(err, postgres.ErrBookNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		imp.books[key] = book
+		return book, nil
+	}
+	imp.books[key] = nil
+	return nil, nil //nolint: nilnil // a title not in the library is not an error
+}
+
+// clippingTitles returns title followed by the shorter titles it could be
+// listed under: without a trailing parenthesized series and without a
+// subtitle.
+func clippingTitles(title string) []string {
+	titles := []string{title}
+	add := func(t string) {
+		t = strings.TrimSpace(t)
+		if t != "" && t != titles[len(titles)-1] {
+			titles = append(titles, t)
+		}
+	}
+	if strings.HasSuffix(title, ")") {
+		if i := strings.LastIndex(title, " ("); i > 0 {
+			add(title[:i])
+		}
+	}
+	if before, _, ok := strings.Cut(titles[len(titles)-1], ": "); ok {
+		add(before)
+	}
+	return titles
+}
+
+// normalizeHighlight trims the quote, location and comment of h and checks
+// them against the size limits. Errors are returned as gRPC status errors.
+func normalizeHighlight(h *data.Highlight) error {
+	h.Quote = strings.TrimSpace(h.Quote)
+	h.Location = strings.TrimSpace(h.Location)
+	h.Comment = strings.TrimSpace(h.Comment)
+
+	switch {
+	case h.Quote == "":
+		return status.Error(codes.InvalidArgument, "quote is required")
+	case utf8.RuneCountInString(h.Quote) > maxQuoteLength:
+		return status.Errorf(codes.InvalidArgument, "quote must be at most %d characters", maxQuoteLength)
+	case utf8.RuneCountInString(h.Location) > maxLocationLength:
+		return status.Errorf(codes.InvalidArgument, "location must be at most %d characters", maxLocationLength)
+	case utf8.RuneCountInString(h.Comment) > maxHighlightCommentLength:
+		return status.Errorf(codes.InvalidArgument, "comment must be at most %d characters", maxHighlightCommentLength)
+	case h.Page < 0:
+		return status.Error(codes.InvalidArgument, "page must not be negative")
+	}
+	return nil
+}
+
+func highlightToProto(h *data.Highlight) *betterreads.Highlight {
+	return &betterreads.Highlight{
+		Id:         h.ID,
+		UserId:     h.UserID,
+		BookId:     h.BookID,
+		BookTitle:  h.BookTitle,
+		AuthorName: h.AuthorName,
+		BookImage:  h.BookImage,
+		Quote:      h.Quote,
+		Page:       h.Page,
+		Location:   h.Location,
+		Comment:    h.Comment,
+		IsPublic:   h.IsPublic,
+		CreatedAt:  timestamppb.New(h.CreatedAt),
+		UpdatedAt:  timestamppb.New(h.UpdatedAt),
+	}
+}
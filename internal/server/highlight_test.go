@@ -0,0 +1,559 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/celestialdragonfly/betterreads/internal/postgres/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testHighlight(id, userID string, createdAt time.Time) *data.Highlight {
+	return &data.Highlight{
+		ID:         id,
+		UserID:     userID,
+		BookID:     "OL456M",
+		BookTitle:  "Dune",
+		AuthorName: "Frank Herbert",
+		Quote:      "Fear is the mind-killer.",
+		Page:       8,
+		CreatedAt:  createdAt,
+		UpdatedAt:  createdAt,
+	}
+}
+
+// ---- CreateHighlight ---------------------------------------------------
+
+func TestServer_CreateHighlight(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.CreateHighlightRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+		verify    func(*testing.T, *betterreads.CreateHighlightResponse)
+	}{
+		{
+			name: "saves a trimmed highlight",
+			ctx:  ctx,
+			request: &betterreads.CreateHighlightRequest{
+				BookId:   "OL456M",
+				Quote:    "  Fear is the mind-killer.\n",
+				Page:     8,
+				Location: "120-121",
+				Comment:  "The litany",
+				IsPublic: true,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					CreateHighlight(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, h *data.Highlight) (*data.Highlight, error) {
+						assert.NotEmpty(t, h.ID)
+						assert.Equal(t, testUserID, h.UserID)
+						assert.Equal(t, "OL456M", h.BookID)
+						assert.Equal(t, "Fear is the mind-killer.", h.Quote)
+						assert.Equal(t, "120-121", h.Location)
+						assert.True(t, h.IsPublic)
+						h.BookTitle = "Dune"
+						return h, nil
+					})
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.CreateHighlightResponse) {
+				t.Helper()
+				assert.Equal(t, "Dune", resp.Highlight.BookTitle)
+				assert.Equal(t, int32(8), resp.Highlight.Page)
+				assert.Equal(t, "The litany", resp.Highlight.Comment)
+			},
+		},
+		{
+			name:      "missing quote",
+			ctx:       ctx,
+			request:   &betterreads.CreateHighlightRequest{BookId: "OL456M", Quote: "   "},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "quote too long",
+			ctx:       ctx,
+			request:   &betterreads.CreateHighlightRequest{BookId: "OL456M", Quote: strings.Repeat("é", maxQuoteLength+1)},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "negative page",
+			ctx:       ctx,
+			request:   &betterreads.CreateHighlightRequest{BookId: "OL456M", Quote: "quote", Page: -1},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "missing book_id",
+			ctx:       ctx,
+			request:   &betterreads.CreateHighlightRequest{Quote: "quote"},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       context.Background(),
+			request:   &betterreads.CreateHighlightRequest{BookId: "OL456M", Quote: "quote"},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:    "book not in library",
+			ctx:     ctx,
+			request: &betterreads.CreateHighlightRequest{BookId: "OL456M", Quote: "quote"},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().CreateHighlight(gomock.Any(), gomock.Any()).Return(nil, postgres.ErrBookNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.CreateHighlight(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+// ---- UpdateHighlight ---------------------------------------------------
+
+func TestServer_UpdateHighlight(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name      string
+		request   *betterreads.UpdateHighlightRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+	}{
+		{
+			name:    "replaces the highlight",
+			request: &betterreads.UpdateHighlightRequest{HighlightId: "highlight-1", Quote: "Fear is the mind-killer.", Comment: "Edited"},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UpdateHighlight(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, h *data.Highlight) (*data.Highlight, error) {
+						assert.Equal(t, "highlight-1", h.ID)
+						assert.Equal(t, testUserID, h.UserID)
+						assert.Equal(t, "Edited", h.Comment)
+						assert.False(t, h.IsPublic)
+						return testHighlight(h.ID, h.UserID, testTime), nil
+					})
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "missing highlight_id",
+			request:   &betterreads.UpdateHighlightRequest{Quote: "quote"},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "comment too long",
+			request:   &betterreads.UpdateHighlightRequest{HighlightId: "highlight-1", Quote: "quote", Comment: strings.Repeat("a", maxHighlightCommentLength+1)},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:    "not the caller's highlight",
+			request: &betterreads.UpdateHighlightRequest{HighlightId: "highlight-1", Quote: "quote"},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().UpdateHighlight(gomock.Any(), gomock.Any()).Return(nil, postgres.ErrHighlightNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.UpdateHighlight(ctx, tt.request)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "highlight-1", resp.Highlight.Id)
+			}
+		})
+	}
+}
+
+// ---- DeleteHighlight ---------------------------------------------------
+
+func TestServer_DeleteHighlight(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name      string
+		request   *betterreads.DeleteHighlightRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+	}{
+		{
+			name:    "deletes the highlight",
+			request: &betterreads.DeleteHighlightRequest{HighlightId: "highlight-1"},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().DeleteHighlight(gomock.Any(), testUserID, "highlight-1").Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "missing highlight_id",
+			request:   &betterreads.DeleteHighlightRequest{},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:    "not found",
+			request: &betterreads.DeleteHighlightRequest{HighlightId: "highlight-1"},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().DeleteHighlight(gomock.Any(), testUserID, "highlight-1").Return(postgres.ErrHighlightNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:    "database error",
+			request: &betterreads.DeleteHighlightRequest{HighlightId: "highlight-1"},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().DeleteHighlight(gomock.Any(), testUserID, "highlight-1").Return(errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			_, err := s.DeleteHighlight(ctx, tt.request)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+// ---- ListHighlights ----------------------------------------------------
+
+func TestServer_ListHighlights(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	otherUserID := "other-user-456"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name      string
+		request   *betterreads.ListHighlightsRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantCount int
+	}{
+		{
+			name:    "the caller's whole library",
+			request: &betterreads.ListHighlightsRequest{},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetHighlights(gomock.Any(), testUserID, "", false, data.Page{Limit: 20}).
+					Return([]*data.Highlight{
+						testHighlight("highlight-2", testUserID, testTime.Add(time.Hour)),
+						testHighlight("highlight-1", testUserID, testTime),
+					}, int32(2), nil)
+			},
+			wantCode:  codes.OK,
+			wantCount: 2,
+		},
+		{
+			name:    "one book of another user, public only",
+			request: &betterreads.ListHighlightsRequest{UserId: otherUserID, BookId: "OL456M"},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetHighlights(gomock.Any(), otherUserID, "OL456M", true, gomock.Any()).
+					Return([]*data.Highlight{testHighlight("highlight-3", otherUserID, testTime)}, int32(1), nil)
+			},
+			wantCode:  codes.OK,
+			wantCount: 1,
+		},
+		{
+			name:      "invalid page token",
+			request:   &betterreads.ListHighlightsRequest{PageToken: "garbage"},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:    "database error",
+			request: &betterreads.ListHighlightsRequest{},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetHighlights(gomock.Any(), testUserID, "", false, gomock.Any()).
+					Return(nil, int32(0), errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.ListHighlights(ctx, tt.request)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Len(t, resp.Highlights, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestServer_ListHighlights_Unauthenticated(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{DB: mocks.NewMockAPI(gomock.NewController(t))}
+	_, err := s.ListHighlights(context.Background(), &betterreads.ListHighlightsRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+// ---- ImportKindleHighlights --------------------------------------------
+
+const testClippings = "The Hunger Games (Hunger Games, Book 1) (Collins, Suzanne)\r\n" +
+	"- Your Highlight on page 12 | Location 170-172 | Added on Sunday, March 3, 2019 10:00:00 PM\r\n" +
+	"\r\n" +
+	"May the odds be ever in your favor.\r\n" +
+	"==========\r\n" +
+	"The Hunger Games (Hunger Games, Book 1) (Collins, Suzanne)\r\n" +
+	"- Your Note on page 12 | Location 172 | Added on Sunday, March 3, 2019 10:01:00 PM\r\n" +
+	"\r\n" +
+	"Effie\r\n" +
+	"==========\r\n" +
+	"The Hunger Games (Hunger Games, Book 1) (Collins, Suzanne)\r\n" +
+	"- Your Highlight on page 12 | Location 170-172 | Added on Sunday, March 3, 2019 10:05:00 PM\r\n" +
+	"\r\n" +
+	"May the odds be ever in your favor.\r\n" +
+	"==========\r\n" +
+	"Unknown Book (Someone)\r\n" +
+	"- Your Highlight on page 1 | Added on Sunday, March 3, 2019 10:00:00 PM\r\n" +
+	"\r\n" +
+	"Not in the library\r\n" +
+	"==========\r\n"
+
+func TestServer_ImportKindleHighlights(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	hungerGames := &data.LibraryBook{UserID: testUserID, BookID: "isbn:9780439023481", Title: "The Hunger Games"}
+
+	expectBooks := func(m *mocks.MockAPI) {
+		m.EXPECT().
+			FindLibraryBookByTitle(gomock.Any(), testUserID, "The Hunger Games (Hunger Games, Book 1)", "Suzanne Collins").
+			Return(nil, postgres.ErrBookNotFound)
+		m.EXPECT().
+			FindLibraryBookByTitle(gomock.Any(), testUserID, "The Hunger Games", "Suzanne Collins").
+			Return(hungerGames, nil)
+		m.EXPECT().
+			FindLibraryBookByTitle(gomock.Any(), testUserID, "Unknown Book", "Someone").
+			Return(nil, postgres.ErrBookNotFound)
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.ImportKindleHighlightsRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		verify    func(*testing.T, *betterreads.ImportKindleHighlightsResponse)
+	}{
+		{
+			name:    "imports highlights into matching books",
+			ctx:     ctx,
+			request: &betterreads.ImportKindleHighlightsRequest{File: []byte(testClippings)},
+			setupMock: func(m *mocks.MockAPI) {
+				expectBooks(m)
+				m.EXPECT().
+					ImportHighlight(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, h *data.Highlight) (bool, error) {
+						assert.NotEmpty(t, h.ID)
+						assert.Equal(t, testUserID, h.UserID)
+						assert.Equal(t, hungerGames.BookID, h.BookID)
+						assert.Equal(t, "May the odds be ever in your favor.", h.Quote)
+						assert.Equal(t, "Effie", h.Comment)
+						assert.Equal(t, "170-172", h.Location)
+						assert.False(t, h.IsPublic)
+						assert.Equal(t, time.Date(2019, 3, 3, 22, 0, 0, 0, time.UTC), h.CreatedAt)
+						return true, nil
+					})
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.ImportKindleHighlightsResponse) {
+				t.Helper()
+				assert.Equal(t, int32(1), resp.ImportedCount)
+				assert.Equal(t, int32(1), resp.SkippedCount)
+				assert.Equal(t, int32(1), resp.FailedCount)
+				require.Len(t, resp.Rows, 3)
+				assert.Equal(t, hungerGames.BookID, resp.Rows[0].BookId)
+				assert.Equal(t, int32(11), resp.Rows[1].Line)
+				assert.Equal(t, "Unknown Book", resp.Rows[2].Title)
+				assert.Equal(t, betterreads.ImportRowStatus_IMPORT_ROW_STATUS_FAILED, resp.Rows[2].Status)
+			},
+		},
+		{
+			name:      "dry run writes nothing",
+			ctx:       ctx,
+			request:   &betterreads.ImportKindleHighlightsRequest{File: []byte(testClippings), DryRun: true},
+			setupMock: expectBooks,
+			wantCode:  codes.OK,
+			verify: func(t *testing.T, resp *betterreads.ImportKindleHighlightsResponse) {
+				t.Helper()
+				assert.True(t, resp.DryRun)
+				assert.Equal(t, int32(1), resp.ImportedCount)
+				assert.Equal(t, int32(1), resp.SkippedCount)
+			},
+		},
+		{
+			name:    "already imported",
+			ctx:     ctx,
+			request: &betterreads.ImportKindleHighlightsRequest{File: []byte(testClippings)},
+			setupMock: func(m *mocks.MockAPI) {
+				expectBooks(m)
+				m.EXPECT().ImportHighlight(gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.ImportKindleHighlightsResponse) {
+				t.Helper()
+				assert.Equal(t, int32(0), resp.ImportedCount)
+				assert.Equal(t, int32(2), resp.SkippedCount)
+			},
+		},
+		{
+			name:      "not a clippings file",
+			ctx:       ctx,
+			request:   &betterreads.ImportKindleHighlightsRequest{File: []byte("Title,Author\nDune,Frank Herbert\n")},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "missing file",
+			ctx:       ctx,
+			request:   &betterreads.ImportKindleHighlightsRequest{},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       context.Background(),
+			request:   &betterreads.ImportKindleHighlightsRequest{File: []byte(testClippings)},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.ImportKindleHighlights(tt.ctx, tt.request)
+			require.Equal(t, tt.wantCode, status.Code(err), err)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+func Test_clippingTitles(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		title string
+		want  []string
+	}{
+		{"Dune", []string{"Dune"}},
+		{"The Hunger Games (Hunger Games, Book 1)", []string{"The Hunger Games (Hunger Games, Book 1)", "The Hunger Games"}},
+		{"Sapiens: A Brief History of Humankind", []string{"Sapiens: A Brief History of Humankind", "Sapiens"}},
+		{"Dune: Deluxe Edition (Dune Chronicles, Book 1)", []string{"Dune: Deluxe Edition (Dune Chronicles, Book 1)", "Dune: Deluxe Edition", "Dune"}},
+		{"(Untitled)", []string{"(Untitled)"}},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, clippingTitles(tt.title), tt.title)
+	}
+}
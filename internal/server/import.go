@@ -0,0 +1,290 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/importer"
+	"github.com/celestialdragonfly/betterreads/internal/logger"
+	"github.com/celestialdragonfly/betterreads/internal/openlibrary"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var importSources = map[betterreads.ImportSource]importer.Source{
+	betterreads.ImportSource_IMPORT_SOURCE_GOODREADS:    importer.Goodreads{},
+	betterreads.ImportSource_IMPORT_SOURCE_STORYGRAPH:   importer.StoryGraph{},
+	betterreads.ImportSource_IMPORT_SOURCE_LIBRARYTHING: importer.LibraryThing{},
+}
+
+// ImportLibrary implements betterreads.BetterReadsServiceServer.
+func (s *Server) ImportLibrary(ctx context.Context, req *betterreads.ImportLibraryRequest) (*betterreads.ImportLibraryResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	source, ok := importSources[req.Source]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "source must be specified")
+	}
+	if len(req.File) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "file is required")
+	}
+
+	return s.importLibrary(ctx, userID, source, req.File, req.DryRun)
+}
+
+// ImportGoodreadsLibrary implements betterreads.BetterReadsServiceServer.
+func (s *Server) ImportGoodreadsLibrary(ctx context.Context, req *betterreads.ImportGoodreadsLibraryRequest) (*betterreads.ImportGoodreadsLibraryResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if len(req.Csv) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "csv is required")
+	}
+
+	resp, err := s.importLibrary(ctx, userID, importer.Goodreads{}, req.Csv, false)
+	if err != nil {
+		return nil, err
+	}
+	return &betterreads.ImportGoodreadsLibraryResponse{
+		ImportedCount: resp.ImportedCount,
+		SkippedCount:  resp.SkippedCount,
+		FailedCount:   resp.FailedCount,
+		Rows:          resp.Rows,
+	}, nil
+}
+
+// openLibraryTimeout bounds each request an import makes to Open Library, so
+// a slow response fails one row rather than the import.
+const openLibraryTimeout = 10 * time.Second
+
+// libraryImport is the state of one import as it works through the rows.
+type libraryImport struct {
+	userID string
+	dryRun bool
+	// shelfIDs maps the user's shelf names to ids, gaining shelves as
+	// imported rows create them. In a dry run new shelves have no id.
+	shelfIDs map[string]string
+	// inLibrary holds the books the user already has, plus those imported
+	// so far, so a book listed twice is skipped the second time.
+	inLibrary map[string]bool
+	resp      *betterreads.ImportLibraryResponse
+}
+
+// importLibrary parses file with source and adds its books to the user's
+// library, or with dryRun reports what adding them would do.
+func (s *Server) importLibrary(ctx context.Context, userID string, source importer.Source, file []byte, dryRun bool) (*betterreads.ImportLibraryResponse, error) {
+	rows, err := source.Parse(bytes.NewReader(file))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "not a %s export: %v", source.Name(), err)
+	}
+
+	shelves, err := s.DB.GetUserShelves(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user shelves: %v", err)
+	}
+
+	imp := &libraryImport{
+		userID:    userID,
+		dryRun:    dryRun,
+		shelfIDs:  make(map[string]string, len(shelves)),
+		inLibrary: map[string]bool{},
+		resp: &betterreads.ImportLibraryResponse{
+			Rows:   make([]*betterreads.ImportRowResult, 0, len(rows)),
+			DryRun: dryRun,
+		},
+	}
+	for _, shelf := range shelves {
+		imp.shelfIDs[shelf.Name] = shelf.ID
+	}
+
+	var bookIDs []string
+	// scopedIDs maps the edition a book was matched to to the id it was
+	// imported under before imports were matched, so it is not added twice.
+	scopedIDs := map[string]string{}
+	editions := map[string]*openlibrary.BookDetails{}
+	for i := range rows {
+		if rows[i].Err != nil {
+			continue
+		}
+		book := &rows[i].Book
+		scopedID := importer.ScopedBookID(userID, book.BookID)
+		edition, err := s.importedEdition(ctx, book.ISBN, editions)
+		if err != nil {
+			logger.Error("import: failed to look up ISBN", "user_id", userID, "isbn", book.ISBN, "error", err)
+			rows[i].Err = errors.New("failed to look up ISBN " + book.ISBN)
+			continue
+		}
+		if edition == nil {
+			book.BookID = scopedID
+		} else {
+			book.BookID = edition.Key
+			book.Source = data.BookSourceOpenLibrary
+			book.WorkKey = edition.WorkKey
+			scopedIDs[book.BookID] = scopedID
+			bookIDs = append(bookIDs, scopedID)
+		}
+		bookIDs = append(bookIDs, book.BookID)
+	}
+	if len(bookIDs) > 0 {
+		existing, err := s.DB.GetExistingLibraryBookIDs(ctx, userID, bookIDs)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check library: %v", err)
+		}
+		for _, id := range existing {
+			imp.inLibrary[id] = true
+		}
+		for id, scopedID := range scopedIDs {
+			if imp.inLibrary[scopedID] {
+				imp.inLibrary[id] = true
+			}
+		}
+	}
+
+	for _, row := range rows {
+		result := s.importRow(ctx, imp, row)
+		switch result.Status {
+		case betterreads.ImportRowStatus_IMPORT_ROW_STATUS_IMPORTED:
+			imp.resp.ImportedCount++
+		case betterreads.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED:
+			imp.resp.SkippedCount++
+		default:
+			imp.resp.FailedCount++
+		}
+		imp.resp.Rows = append(imp.resp.Rows, result)
+	}
+	return imp.resp, nil
+}
+
+// importedEdition returns the Open Library edition with isbn, so an imported
+// book shares its catalog entry with every library that has the edition, or
+// nil if the book has no ISBN or Open Library does not know it. Editions holds
+// the lookups already made by the import.
+func (s *Server) importedEdition(ctx context.Context, isbn string, editions map[string]*openlibrary.BookDetails) (*openlibrary.BookDetails, error) {
+	if isbn == "" {
+		return nil, nil //nolint: nilnil // a book without an ISBN has no edition
+	}
+	if edition, ok := editions[isbn]; ok {
+		return edition, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, openLibraryTimeout)
+	defer cancel()
+	edition, err := s.OpenLibrary.GetEditionByISBN(ctx, isbn)
+	if err != nil && !errors.Is(err, openlibrary.ErrNotFound) {
+		return nil, err
+	}
+	editions[isbn] = edition
+	return edition, nil
+}
+
+// importRow adds one parsed row to the user's library, creating any shelves it
+// names that the user does not have yet along with the book, so a row that is
+// skipped or fails leaves no new shelf behind. In a dry run nothing is written
+// and the result is what the import would have done.
+func (s *Server) importRow(ctx context.Context, imp *libraryImport, row importer.Row) *betterreads.ImportRowResult {
+	result := &betterreads.ImportRowResult{
+		Line:          int32(row.Line), //nolint:gosec // G115: line count is bounded by message size
+		Title:         row.Book.Title,
+		BookId:        row.Book.BookID,
+		Shelves:       row.Shelves,
+		ReadingStatus: betterreads.ReadingStatus(row.Book.ReadingStatus),
+		Rating:        betterreads.BookRating(row.Book.Rating),
+	}
+	failed := func(message string) *betterreads.ImportRowResult {
+		result.Status = betterreads.ImportRowStatus_IMPORT_ROW_STATUS_FAILED
+		result.Message = message
+		return result
+	}
+	skipped := func() *betterreads.ImportRowResult {
+		result.Status = betterreads.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED
+		result.Message = "already in library"
+		return result
+	}
+
+	if row.Err != nil {
+		return failed(row.Err.Error())
+	}
+	if imp.inLibrary[row.Book.BookID] {
+		return skipped()
+	}
+
+	book := row.Book
+	if err := normalizeBookText(&book); err != nil {
+		return failed(status.Convert(err).Message())
+	}
+	now := time.Now()
+	book.UserID = imp.userID
+	book.UpdatedAt = now
+	if book.AddedAt.IsZero() {
+		book.AddedAt = now
+	}
+	for _, session := range book.Sessions {
+		session.ID = uuid.New().String()
+		session.UserID = imp.userID
+		session.BookID = book.BookID
+		session.CreatedAt = now
+		session.UpdatedAt = now
+	}
+
+	var newShelves []*data.Shelf
+	for _, name := range row.Shelves {
+		if id, ok := imp.shelfIDs[name]; ok {
+			book.ShelfIDs = append(book.ShelfIDs, id)
+			continue
+		}
+		newShelves = append(newShelves, &data.Shelf{
+			ID:        uuid.New().String(),
+			Name:      name,
+			UserID:    imp.userID,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+
+	imp.inLibrary[book.BookID] = true
+	if imp.dryRun {
+		imp.addShelves(newShelves)
+		result.Status = betterreads.ImportRowStatus_IMPORT_ROW_STATUS_IMPORTED
+		return result
+	}
+
+	added, err := s.DB.ImportLibraryBook(ctx, &book, newShelves)
+	if err != nil {
+		if errors.Is(err, postgres.ErrShelfNotFound) {
+			return failed("shelf was deleted during import")
+		}
+		logger.Error("import: failed to add book", "user_id", imp.userID, "book_id", book.BookID, "error", err)
+		return failed("failed to add book to library")
+	}
+	if !added {
+		return skipped()
+	}
+
+	imp.addShelves(newShelves)
+	result.Status = betterreads.ImportRowStatus_IMPORT_ROW_STATUS_IMPORTED
+	return result
+}
+
+// addShelves records shelves an imported row created, so later rows put their
+// books on them instead of creating them again. In a dry run they have no id.
+func (imp *libraryImport) addShelves(shelves []*data.Shelf) {
+	for _, shelf := range shelves {
+		id := shelf.ID
+		if imp.dryRun {
+			id = ""
+		}
+		imp.shelfIDs[shelf.Name] = id
+		imp.resp.NewShelves = append(imp.resp.NewShelves, shelf.Name)
+	}
+}
@@ -0,0 +1,563 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/openlibrary"
+	"github.com/celestialdragonfly/betterreads/internal/postgres/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ---- ImportGoodreadsLibrary --------------------------------------------
+
+// unknownISBNs returns an Open Library client that has no edition with any
+// ISBN, so every imported book keeps an id of its own.
+func unknownISBNs() *MockOpenLibraryClient {
+	openLibrary := NewMockClient()
+	openLibrary.On("GetEditionByISBN", mock.Anything, mock.Anything).Return(nil, openlibrary.ErrNotFound)
+	return openLibrary
+}
+
+const goodreadsExport = `Book Id,Title,Author,ISBN,ISBN13,My Rating,Date Added,Bookshelves,Exclusive Shelf
+2767052,The Hunger Games,Suzanne Collins,"=""0439023483""","=""9780439023481""",4,2012/04/20,"favorites, read",read
+41865,Twilight,Stephenie Meyer,"=""""","=""""",0,2013/01/02,"favorites, to-read",to-read
+3,,Nobody,"=""""","=""""",0,2020/01/01,,read
+`
+
+func TestServer_ImportGoodreadsLibrary(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.ImportGoodreadsLibraryRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+		verify    func(*testing.T, *betterreads.ImportGoodreadsLibraryResponse)
+	}{
+		{
+			name:    "imports new books and creates shelves once",
+			ctx:     ctx,
+			request: &betterreads.ImportGoodreadsLibraryRequest{Csv: []byte(goodreadsExport)},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().GetUserShelves(gomock.Any(), testUserID).Return(nil, nil)
+				m.EXPECT().GetExistingLibraryBookIDs(gomock.Any(), testUserID, gomock.Any()).Return(nil, nil)
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook, newShelves []*data.Shelf) (bool, error) {
+						assert.Equal(t, testUserID, book.UserID)
+						assert.Equal(t, "import:test-user-123:isbn:9780439023481", book.BookID)
+						assert.Equal(t, "9780439023481", book.ISBN)
+						assert.Equal(t, int32(4), book.Rating)
+						assert.Equal(t, data.ReadingStatusRead, book.ReadingStatus)
+						assert.Equal(t, time.Date(2012, 4, 20, 0, 0, 0, 0, time.UTC), book.AddedAt)
+						assert.Empty(t, book.ShelfIDs)
+						require.Len(t, newShelves, 1)
+						assert.Equal(t, "favorites", newShelves[0].Name)
+						assert.Equal(t, testUserID, newShelves[0].UserID)
+						newShelves[0].ID = "shelf-favorites"
+						return true, nil
+					})
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook, newShelves []*data.Shelf) (bool, error) {
+						assert.Equal(t, "import:test-user-123:goodreads:41865", book.BookID)
+						assert.Equal(t, []string{"shelf-favorites"}, book.ShelfIDs)
+						assert.Empty(t, newShelves)
+						return false, nil
+					})
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.ImportGoodreadsLibraryResponse) {
+				t.Helper()
+				assert.Equal(t, int32(1), resp.ImportedCount)
+				assert.Equal(t, int32(1), resp.SkippedCount)
+				assert.Equal(t, int32(1), resp.FailedCount)
+				require.Len(t, resp.Rows, 3)
+				assert.Equal(t, betterreads.ImportRowStatus_IMPORT_ROW_STATUS_IMPORTED, resp.Rows[0].Status)
+				assert.Equal(t, int32(2), resp.Rows[0].Line)
+				assert.Equal(t, "The Hunger Games", resp.Rows[0].Title)
+				assert.Equal(t, betterreads.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED, resp.Rows[1].Status)
+				assert.Equal(t, "already in library", resp.Rows[1].Message)
+				assert.Equal(t, betterreads.ImportRowStatus_IMPORT_ROW_STATUS_FAILED, resp.Rows[2].Status)
+				assert.Contains(t, resp.Rows[2].Message, "title")
+			},
+		},
+		{
+			name:    "reuses existing shelves",
+			ctx:     ctx,
+			request: &betterreads.ImportGoodreadsLibraryRequest{Csv: []byte(goodreadsExport)},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetUserShelves(gomock.Any(), testUserID).
+					Return([]*data.Shelf{testShelf("shelf-existing", "favorites", testUserID, testTime)}, nil)
+				m.EXPECT().GetExistingLibraryBookIDs(gomock.Any(), testUserID, gomock.Any()).Return(nil, nil)
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook, _ []*data.Shelf) (bool, error) {
+						assert.Equal(t, []string{"shelf-existing"}, book.ShelfIDs)
+						return true, nil
+					}).
+					Times(2)
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.ImportGoodreadsLibraryResponse) {
+				t.Helper()
+				assert.Equal(t, int32(2), resp.ImportedCount)
+			},
+		},
+		{
+			name:    "database error fails the row, not the import",
+			ctx:     ctx,
+			request: &betterreads.ImportGoodreadsLibraryRequest{Csv: []byte(goodreadsExport)},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetUserShelves(gomock.Any(), testUserID).
+					Return([]*data.Shelf{testShelf("shelf-existing", "favorites", testUserID, testTime)}, nil)
+				m.EXPECT().GetExistingLibraryBookIDs(gomock.Any(), testUserID, gomock.Any()).Return(nil, nil)
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(false, errors.New("database connection failed")).
+					Times(2)
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.ImportGoodreadsLibraryResponse) {
+				t.Helper()
+				assert.Equal(t, int32(3), resp.FailedCount)
+				assert.Equal(t, "failed to add book to library", resp.Rows[0].Message)
+			},
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       context.Background(),
+			request:   &betterreads.ImportGoodreadsLibraryRequest{Csv: []byte(goodreadsExport)},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:      "empty file",
+			ctx:       ctx,
+			request:   &betterreads.ImportGoodreadsLibraryRequest{},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "not a Goodreads export",
+			ctx:       ctx,
+			request:   &betterreads.ImportGoodreadsLibraryRequest{Csv: []byte("Title,Author\nDune,Frank Herbert\n")},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:    "shelves database error",
+			ctx:     ctx,
+			request: &betterreads.ImportGoodreadsLibraryRequest{Csv: []byte(goodreadsExport)},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetUserShelves(gomock.Any(), testUserID).
+					Return(nil, errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB, OpenLibrary: unknownISBNs()}
+			resp, err := s.ImportGoodreadsLibrary(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+// ---- ImportLibrary -----------------------------------------------------
+
+const storyGraphExport = `Title,Authors,ISBN/UID,Read Status,Date Added,Last Date Read,Star Rating,Tags
+The Hunger Games,Suzanne Collins,9780439023481,read,2012/04/20,2012/05/01,3.5,"favorites, dystopia"
+Piranesi,Susanna Clarke,a1b2c3d4,paused,2021/03/04,,,favorites
+Piranesi,Susanna Clarke,a1b2c3d4,paused,2021/03/04,,,favorites
+Dune,Frank Herbert,9780441013593,to-read,2020/01/01,,,sci-fi
+`
+
+func TestServer_ImportLibrary(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.ImportLibraryRequest
+		setupMock func(*mocks.MockAPI)
+		// setupOpenLibrary is optional; Open Library has no other ISBN.
+		setupOpenLibrary func(*MockOpenLibraryClient)
+		wantCode         codes.Code
+		wantErr          bool
+		verify           func(*testing.T, *betterreads.ImportLibraryResponse)
+	}{
+		{
+			name: "dry run writes nothing",
+			ctx:  ctx,
+			request: &betterreads.ImportLibraryRequest{
+				Source: betterreads.ImportSource_IMPORT_SOURCE_STORYGRAPH,
+				File:   []byte(storyGraphExport),
+				DryRun: true,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetUserShelves(gomock.Any(), testUserID).
+					Return([]*data.Shelf{testShelf("shelf-existing", "favorites", testUserID, testTime)}, nil)
+				m.EXPECT().
+					GetExistingLibraryBookIDs(gomock.Any(), testUserID, []string{
+						"import:test-user-123:isbn:9780439023481",
+						"import:test-user-123:storygraph:a1b2c3d4",
+						"import:test-user-123:storygraph:a1b2c3d4",
+						"import:test-user-123:isbn:9780441013593",
+					}).
+					Return([]string{"import:test-user-123:isbn:9780441013593"}, nil)
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.ImportLibraryResponse) {
+				t.Helper()
+				assert.True(t, resp.DryRun)
+				assert.Equal(t, int32(2), resp.ImportedCount)
+				assert.Equal(t, int32(2), resp.SkippedCount)
+				assert.Zero(t, resp.FailedCount)
+				// sci-fi belongs only to a book that is skipped.
+				assert.Equal(t, []string{"dystopia"}, resp.NewShelves)
+
+				require.Len(t, resp.Rows, 4)
+				hungerGames := resp.Rows[0]
+				assert.Equal(t, betterreads.ImportRowStatus_IMPORT_ROW_STATUS_IMPORTED, hungerGames.Status)
+				assert.Equal(t, []string{"favorites", "dystopia"}, hungerGames.Shelves)
+				assert.Equal(t, betterreads.ReadingStatus_READING_STATUS_READ, hungerGames.ReadingStatus)
+				assert.Equal(t, betterreads.BookRating_BOOK_RATING_FOUR_STAR, hungerGames.Rating)
+				assert.Equal(t, betterreads.ReadingStatus_READING_STATUS_READING, resp.Rows[1].ReadingStatus)
+				assert.Equal(t, betterreads.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED, resp.Rows[2].Status)
+				assert.Equal(t, betterreads.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED, resp.Rows[3].Status)
+			},
+		},
+		{
+			name: "imports what the dry run reported",
+			ctx:  ctx,
+			request: &betterreads.ImportLibraryRequest{
+				Source: betterreads.ImportSource_IMPORT_SOURCE_STORYGRAPH,
+				File:   []byte(storyGraphExport),
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetUserShelves(gomock.Any(), testUserID).
+					Return([]*data.Shelf{testShelf("shelf-existing", "favorites", testUserID, testTime)}, nil)
+				m.EXPECT().
+					GetExistingLibraryBookIDs(gomock.Any(), testUserID, gomock.Any()).
+					Return([]string{"import:test-user-123:isbn:9780441013593"}, nil)
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook, newShelves []*data.Shelf) (bool, error) {
+						assert.Equal(t, "import:test-user-123:isbn:9780439023481", book.BookID)
+						assert.Equal(t, []string{"shelf-existing"}, book.ShelfIDs)
+						require.Len(t, newShelves, 1)
+						assert.Equal(t, "dystopia", newShelves[0].Name)
+						require.Len(t, book.Sessions, 1)
+						session := book.Sessions[0]
+						assert.NotEmpty(t, session.ID)
+						assert.Equal(t, testUserID, session.UserID)
+						assert.Equal(t, book.BookID, session.BookID)
+						assert.Equal(t, time.Date(2012, 5, 1, 0, 0, 0, 0, time.UTC), *session.FinishedAt)
+						assert.Equal(t, int32(4), session.Rating)
+						return true, nil
+					})
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook, _ []*data.Shelf) (bool, error) {
+						assert.Equal(t, "import:test-user-123:storygraph:a1b2c3d4", book.BookID)
+						return true, nil
+					})
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.ImportLibraryResponse) {
+				t.Helper()
+				assert.False(t, resp.DryRun)
+				assert.Equal(t, int32(2), resp.ImportedCount)
+				assert.Equal(t, int32(2), resp.SkippedCount)
+				assert.Equal(t, []string{"dystopia"}, resp.NewShelves)
+			},
+		},
+		{
+			name: "rows that are not added create no shelves",
+			ctx:  ctx,
+			request: &betterreads.ImportLibraryRequest{
+				Source: betterreads.ImportSource_IMPORT_SOURCE_STORYGRAPH,
+				File:   []byte(storyGraphExport),
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().GetUserShelves(gomock.Any(), testUserID).Return(nil, nil)
+				m.EXPECT().GetExistingLibraryBookIDs(gomock.Any(), testUserID, gomock.Any()).Return(nil, nil)
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(false, errors.New("database connection failed"))
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook, newShelves []*data.Shelf) (bool, error) {
+						// favorites is still new, as the failed row did not create it.
+						assert.Equal(t, "import:test-user-123:storygraph:a1b2c3d4", book.BookID)
+						require.Len(t, newShelves, 1)
+						assert.Equal(t, "favorites", newShelves[0].Name)
+						return false, nil
+					})
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook, newShelves []*data.Shelf) (bool, error) {
+						assert.Equal(t, "import:test-user-123:isbn:9780441013593", book.BookID)
+						require.Len(t, newShelves, 1)
+						assert.Equal(t, "sci-fi", newShelves[0].Name)
+						newShelves[0].ID = "shelf-sci-fi"
+						return true, nil
+					})
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.ImportLibraryResponse) {
+				t.Helper()
+				assert.Equal(t, int32(1), resp.ImportedCount)
+				assert.Equal(t, int32(2), resp.SkippedCount)
+				assert.Equal(t, int32(1), resp.FailedCount)
+				assert.Equal(t, []string{"sci-fi"}, resp.NewShelves)
+			},
+		},
+		{
+			name: "matches ISBNs to Open Library editions",
+			ctx:  ctx,
+			request: &betterreads.ImportLibraryRequest{
+				Source: betterreads.ImportSource_IMPORT_SOURCE_STORYGRAPH,
+				File:   []byte(storyGraphExport),
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().GetUserShelves(gomock.Any(), testUserID).Return(nil, nil)
+				m.EXPECT().
+					GetExistingLibraryBookIDs(gomock.Any(), testUserID, []string{
+						"import:test-user-123:isbn:9780439023481",
+						"OL7353617M",
+						"import:test-user-123:storygraph:a1b2c3d4",
+						"import:test-user-123:storygraph:a1b2c3d4",
+						"import:test-user-123:isbn:9780441013593",
+					}).
+					Return(nil, nil)
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook, _ []*data.Shelf) (bool, error) {
+						assert.Equal(t, "OL7353617M", book.BookID)
+						assert.Equal(t, data.BookSourceOpenLibrary, book.Source)
+						assert.Equal(t, "OL893415W", book.WorkKey)
+						assert.Equal(t, "9780439023481", book.ISBN)
+						require.Len(t, book.Sessions, 1)
+						assert.Equal(t, "OL7353617M", book.Sessions[0].BookID)
+						return true, nil
+					})
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook, _ []*data.Shelf) (bool, error) {
+						assert.Equal(t, "import:test-user-123:storygraph:a1b2c3d4", book.BookID)
+						assert.Equal(t, data.BookSourceManual, book.Source)
+						return true, nil
+					})
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook, _ []*data.Shelf) (bool, error) {
+						// Open Library does not have it.
+						assert.Equal(t, "import:test-user-123:isbn:9780441013593", book.BookID)
+						assert.Equal(t, data.BookSourceManual, book.Source)
+						return true, nil
+					})
+			},
+			setupOpenLibrary: func(m *MockOpenLibraryClient) {
+				m.On("GetEditionByISBN", mock.Anything, "9780439023481").
+					Return(&openlibrary.BookDetails{Edition: openlibrary.Edition{Key: "OL7353617M"}, WorkKey: "OL893415W"}, nil).
+					Once()
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.ImportLibraryResponse) {
+				t.Helper()
+				assert.Equal(t, int32(3), resp.ImportedCount)
+				assert.Equal(t, "OL7353617M", resp.Rows[0].BookId)
+			},
+		},
+		{
+			name: "skips books imported before they were matched",
+			ctx:  ctx,
+			request: &betterreads.ImportLibraryRequest{
+				Source: betterreads.ImportSource_IMPORT_SOURCE_STORYGRAPH,
+				File:   []byte(storyGraphExport),
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().GetUserShelves(gomock.Any(), testUserID).Return(nil, nil)
+				m.EXPECT().
+					GetExistingLibraryBookIDs(gomock.Any(), testUserID, gomock.Any()).
+					Return([]string{"import:test-user-123:isbn:9780439023481"}, nil)
+				m.EXPECT().
+					ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook, _ []*data.Shelf) (bool, error) {
+						assert.NotEqual(t, "OL7353617M", book.BookID)
+						return true, nil
+					}).
+					Times(2)
+			},
+			setupOpenLibrary: func(m *MockOpenLibraryClient) {
+				m.On("GetEditionByISBN", mock.Anything, "9780439023481").
+					Return(&openlibrary.BookDetails{Edition: openlibrary.Edition{Key: "OL7353617M"}}, nil)
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.ImportLibraryResponse) {
+				t.Helper()
+				assert.Equal(t, betterreads.ImportRowStatus_IMPORT_ROW_STATUS_SKIPPED, resp.Rows[0].Status)
+				assert.Equal(t, int32(2), resp.ImportedCount)
+			},
+		},
+		{
+			name: "failed ISBN lookup fails the row",
+			ctx:  ctx,
+			request: &betterreads.ImportLibraryRequest{
+				Source: betterreads.ImportSource_IMPORT_SOURCE_STORYGRAPH,
+				File:   []byte(storyGraphExport),
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().GetUserShelves(gomock.Any(), testUserID).Return(nil, nil)
+				m.EXPECT().GetExistingLibraryBookIDs(gomock.Any(), testUserID, gomock.Any()).Return(nil, nil)
+				m.EXPECT().ImportLibraryBook(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).Times(2)
+			},
+			setupOpenLibrary: func(m *MockOpenLibraryClient) {
+				m.On("GetEditionByISBN", mock.Anything, "9780439023481").Return(nil, openlibrary.ErrInternalServer)
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.ImportLibraryResponse) {
+				t.Helper()
+				assert.Equal(t, betterreads.ImportRowStatus_IMPORT_ROW_STATUS_FAILED, resp.Rows[0].Status)
+				assert.Equal(t, "failed to look up ISBN 9780439023481", resp.Rows[0].Message)
+				assert.Equal(t, int32(2), resp.ImportedCount)
+			},
+		},
+		{
+			name: "missing user_id in context",
+			ctx:  context.Background(),
+			request: &betterreads.ImportLibraryRequest{
+				Source: betterreads.ImportSource_IMPORT_SOURCE_STORYGRAPH,
+				File:   []byte(storyGraphExport),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:      "source unspecified",
+			ctx:       ctx,
+			request:   &betterreads.ImportLibraryRequest{File: []byte(storyGraphExport)},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "export from another site",
+			ctx:  ctx,
+			request: &betterreads.ImportLibraryRequest{
+				Source: betterreads.ImportSource_IMPORT_SOURCE_LIBRARYTHING,
+				File:   []byte(storyGraphExport),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "existing books database error",
+			ctx:  ctx,
+			request: &betterreads.ImportLibraryRequest{
+				Source: betterreads.ImportSource_IMPORT_SOURCE_STORYGRAPH,
+				File:   []byte(storyGraphExport),
+				DryRun: true,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().GetUserShelves(gomock.Any(), testUserID).Return(nil, nil)
+				m.EXPECT().
+					GetExistingLibraryBookIDs(gomock.Any(), testUserID, gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+			openLibrary := NewMockClient()
+			if tt.setupOpenLibrary != nil {
+				tt.setupOpenLibrary(openLibrary)
+			}
+			openLibrary.On("GetEditionByISBN", mock.Anything, mock.Anything).Return(nil, openlibrary.ErrNotFound).Maybe()
+
+			s := &Server{DB: mockDB, OpenLibrary: openLibrary}
+			resp, err := s.ImportLibrary(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
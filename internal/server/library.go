@@ -3,7 +3,10 @@ package server
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	betterreads "github.com/celestialdragonfly/betterreads/generated"
 	"github.com/celestialdragonfly/betterreads/internal/data"
@@ -14,6 +17,14 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Limits on the text a user can attach to a library book, in characters.
+const (
+	maxReviewLength = 20000
+	maxNotesLength  = 10000
+	maxTags         = 50
+	maxTagLength    = 50
+)
+
 // RemoveLibraryBook.
 func (s *Server) RemoveLibraryBook(ctx context.Context, req *betterreads.RemoveLibraryBookRequest) (*betterreads.RemoveLibraryBookResponse, error) {
 	userID, ok := headers.GetUserID(ctx)
@@ -72,22 +83,51 @@ func (s *Server) UpdateLibraryBook(ctx context.Context, req *betterreads.UpdateL
 		return nil, status.Error(codes.InvalidArgument, "invalid reading status")
 	}
 
+	if req.ClearTags && len(req.Tags) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "tags must be empty when clear_tags is set")
+	}
+
 	now := time.Now()
 	book := &data.LibraryBook{
-		UserID:        userID,
-		BookID:        req.BookId,
-		Title:         req.Title,
-		AuthorName:    req.AuthorName,
-		BookImage:     req.BookImage,
-		Rating:        int32(req.Rating),
-		Source:        int32(req.Source),
-		ReadingStatus: int32(req.ReadingStatus),
-		ShelfIDs:      req.ShelfIds,
-		AddedAt:       now, // Used only on INSERT, preserved on UPDATE (see DB upsert query)
-		UpdatedAt:     now, // Always updated on both INSERT and UPDATE
+		UserID:            userID,
+		BookID:            req.BookId,
+		Title:             req.Title,
+		AuthorName:        req.AuthorName,
+		BookImage:         req.BookImage,
+		ISBN:              req.Isbn,
+		Rating:            int32(req.Rating),
+		Source:            int32(req.Source),
+		ReadingStatus:     int32(req.ReadingStatus),
+		Review:            req.GetReview(),
+		ReviewHasSpoilers: req.GetReviewHasSpoilers(),
+		Notes:             req.GetNotes(),
+		Tags:              req.Tags,
+		ShelfIDs:          req.ShelfIds,
+		AddedAt:           now, // Used only on INSERT, preserved on UPDATE (see DB upsert query)
+		UpdatedAt:         now, // Always updated on both INSERT and UPDATE
+	}
+	// Clients that predate reviews, notes and tags do not send them, and must
+	// not erase what the user wrote elsewhere.
+	if req.Review == nil {
+		book.Keep |= data.FieldReview
+	}
+	if req.ReviewHasSpoilers == nil {
+		book.Keep |= data.FieldReviewHasSpoilers
+	}
+	if req.Notes == nil {
+		book.Keep |= data.FieldNotes
+	}
+	if len(req.Tags) == 0 && !req.ClearTags {
+		book.Keep |= data.FieldTags
+	}
+	if err := normalizeBookText(book); err != nil {
+		return nil, err
 	}
 
 	if err := s.DB.UpdateLibraryBook(ctx, book); err != nil {
+		if errors.Is(err, postgres.ErrCatalogBookNotFound) {
+			return nil, status.Error(codes.NotFound, "book not found in catalog")
+		}
 		// UpdateLibraryBook could fail if shelf IDs are invalid, but we don't have a specific error for that yet in postgres package usually.
 		return nil, status.Errorf(codes.Internal, "failed to update library book: %v", err)
 	}
@@ -112,14 +152,25 @@ func (s *Server) GetUserLibrary(ctx context.Context, req *betterreads.GetUserLib
 		return nil, status.Error(codes.PermissionDenied, "you can only view your own library")
 	}
 
+	list := "library:" + targetUserID
+	page, err := newPageRequest(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	page, err = s.withPageToken(page, req.PageToken, list)
+	if err != nil {
+		return nil, err
+	}
+
 	// 1. Get all shelves
 	shelves, err := s.DB.GetUserShelves(ctx, targetUserID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user shelves: %v", err)
 	}
 
-	// 2. Get all books
-	books, err := s.DB.GetUserLibrary(ctx, targetUserID)
+	// 2. Get one page of books. Shelves are always returned in full so the
+	// page can be grouped by shelf; a book appears under every shelf it is on.
+	books, total, err := s.DB.GetUserLibrary(ctx, targetUserID, page.Query())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user library: %v", err)
 	}
@@ -146,18 +197,7 @@ func (s *Server) GetUserLibrary(ctx context.Context, req *betterreads.GetUserLib
 	var unshelved []*betterreads.LibraryBook
 
 	for _, b := range books {
-		pbBook := &betterreads.LibraryBook{
-			AuthorName:    b.AuthorName,
-			BookId:        b.BookID,
-			BookImage:     b.BookImage,
-			Rating:        betterreads.BookRating(b.Rating),
-			ShelfIds:      b.ShelfIDs,
-			Source:        betterreads.BookSource(b.Source),
-			ReadingStatus: betterreads.ReadingStatus(b.ReadingStatus),
-			Title:         b.Title,
-			AddedAt:       timestamppb.New(b.AddedAt),
-			UpdatedAt:     timestamppb.New(b.UpdatedAt),
-		}
+		pbBook := libraryBookToProto(b)
 
 		if len(b.ShelfIDs) == 0 {
 			unshelved = append(unshelved, pbBook)
@@ -182,13 +222,111 @@ func (s *Server) GetUserLibrary(ctx context.Context, req *betterreads.GetUserLib
 		}
 	}
 
+	pagination := page.Metadata(total)
+	pagination.NextPageToken = nextPageToken(s, page, list, books, libraryBookCursor)
+
 	return &betterreads.GetUserLibraryResponse{
 		Shelves:        finalShelves,
 		UnshelvedBooks: unshelved,
-		Pagination: &betterreads.PaginationMetadata{
-			Total: int32(len(books)), //nolint:gosec // G115: len(books) is unlikely to overflow int32
-			Page:  req.Page,
-			Limit: req.Limit,
-		},
+		Pagination:     pagination,
 	}, nil
 }
+
+// SearchLibrary implements betterreads.BetterReadsServiceServer.
+func (s *Server) SearchLibrary(ctx context.Context, req *betterreads.SearchLibraryRequest) (*betterreads.SearchLibraryResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	search := data.LibrarySearch{
+		Query: strings.TrimSpace(req.Query),
+		Tag:   strings.ToLower(strings.TrimSpace(req.Tag)),
+	}
+	if search.Query == "" && search.Tag == "" {
+		return nil, status.Error(codes.InvalidArgument, "query or tag is required")
+	}
+
+	// The list includes the search so that a token cannot continue a
+	// different one.
+	list := "library-search:" + userID + ":" + search.Tag + ":" + search.Query
+	page, err := newPageRequest(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	page, err = s.withPageToken(page, req.PageToken, list)
+	if err != nil {
+		return nil, err
+	}
+
+	books, total, err := s.DB.SearchLibrary(ctx, userID, search, page.Query())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search library: %v", err)
+	}
+
+	resp := &betterreads.SearchLibraryResponse{
+		Books:      make([]*betterreads.LibraryBook, 0, len(books)),
+		Pagination: page.Metadata(total),
+	}
+	for _, b := range books {
+		resp.Books = append(resp.Books, libraryBookToProto(b))
+	}
+	resp.Pagination.NextPageToken = nextPageToken(s, page, list, books, libraryBookCursor)
+	return resp, nil
+}
+
+func libraryBookToProto(b *data.LibraryBook) *betterreads.LibraryBook {
+	sessions := make([]*betterreads.ReadingSession, 0, len(b.Sessions))
+	for _, session := range b.Sessions {
+		sessions = append(sessions, readingSessionToProto(session))
+	}
+	return &betterreads.LibraryBook{
+		AuthorName:        b.AuthorName,
+		BookId:            b.BookID,
+		BookImage:         b.BookImage,
+		Isbn:              b.ISBN,
+		Rating:            betterreads.BookRating(b.Rating),
+		ShelfIds:          b.ShelfIDs,
+		Source:            betterreads.BookSource(b.Source),
+		ReadingStatus:     betterreads.ReadingStatus(b.ReadingStatus),
+		Title:             b.Title,
+		Sessions:          sessions,
+		Review:            b.Review,
+		ReviewHasSpoilers: b.ReviewHasSpoilers,
+		Notes:             b.Notes,
+		Tags:              b.Tags,
+		AddedAt:           timestamppb.New(b.AddedAt),
+		UpdatedAt:         timestamppb.New(b.UpdatedAt),
+	}
+}
+
+// normalizeBookText checks the review, notes and tags of book against the
+// size limits and normalizes its tags: trimmed, lower-cased and without
+// duplicates. Errors are returned as gRPC status errors.
+func normalizeBookText(book *data.LibraryBook) error {
+	if utf8.RuneCountInString(book.Review) > maxReviewLength {
+		return status.Errorf(codes.InvalidArgument, "review must be at most %d characters", maxReviewLength)
+	}
+	if utf8.RuneCountInString(book.Notes) > maxNotesLength {
+		return status.Errorf(codes.InvalidArgument, "notes must be at most %d characters", maxNotesLength)
+	}
+	if len(book.Tags) > maxTags {
+		return status.Errorf(codes.InvalidArgument, "a book can have at most %d tags", maxTags)
+	}
+
+	tags := make([]string, 0, len(book.Tags))
+	for _, tag := range book.Tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			return status.Error(codes.InvalidArgument, "tags must not be empty")
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return status.Errorf(codes.InvalidArgument, "tags must be at most %d characters", maxTagLength)
+		}
+		if !slices.Contains(tags, tag) {
+			tags = append(tags, tag)
+		}
+	}
+	book.Tags = tags
+	return nil
+}
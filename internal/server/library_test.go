@@ -3,6 +3,8 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestServer_RemoveLibraryBook(t *testing.T) {
@@ -253,6 +256,184 @@ func TestServer_UpdateLibraryBook(t *testing.T) {
 			wantCode:  codes.InvalidArgument,
 			wantErr:   true,
 		},
+		{
+			name: "review, notes and tags are stored",
+			ctx:  ctx,
+			request: &betterreads.UpdateLibraryBookRequest{
+				BookId:            testBookID,
+				Title:             "Test Book",
+				AuthorName:        "Test Author",
+				ReadingStatus:     betterreads.ReadingStatus_READING_STATUS_READ,
+				Review:            proto.String("The ending surprised me."),
+				ReviewHasSpoilers: proto.Bool(true),
+				Notes:             proto.String("Lent to Sam"),
+				Tags:              []string{" Space Opera", "space opera", "re-read"},
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UpdateLibraryBook(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook) error {
+						assert.Equal(t, "The ending surprised me.", book.Review)
+						assert.True(t, book.ReviewHasSpoilers)
+						assert.Equal(t, "Lent to Sam", book.Notes)
+						assert.Equal(t, []string{"space opera", "re-read"}, book.Tags)
+						assert.Zero(t, book.Keep)
+						return nil
+					})
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+		},
+		{
+			name: "status change keeps review, notes and tags",
+			ctx:  ctx,
+			request: &betterreads.UpdateLibraryBookRequest{
+				BookId:        testBookID,
+				Title:         "Test Book",
+				AuthorName:    "Test Author",
+				ReadingStatus: betterreads.ReadingStatus_READING_STATUS_READING,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UpdateLibraryBook(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook) error {
+						assert.Equal(t, data.FieldReview|data.FieldReviewHasSpoilers|data.FieldNotes|data.FieldTags, book.Keep)
+						return nil
+					})
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+		},
+		{
+			name: "empty review and cleared tags replace the stored ones",
+			ctx:  ctx,
+			request: &betterreads.UpdateLibraryBookRequest{
+				BookId:        testBookID,
+				Title:         "Test Book",
+				AuthorName:    "Test Author",
+				ReadingStatus: betterreads.ReadingStatus_READING_STATUS_READ,
+				Review:        proto.String(""),
+				ClearTags:     true,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UpdateLibraryBook(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, book *data.LibraryBook) error {
+						assert.Equal(t, data.FieldReviewHasSpoilers|data.FieldNotes, book.Keep)
+						assert.Empty(t, book.Tags)
+						return nil
+					})
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+		},
+		{
+			name: "tags with clear_tags",
+			ctx:  ctx,
+			request: &betterreads.UpdateLibraryBookRequest{
+				BookId:        testBookID,
+				Title:         "Test Book",
+				AuthorName:    "Test Author",
+				ReadingStatus: betterreads.ReadingStatus_READING_STATUS_READ,
+				Tags:          []string{"sci-fi"},
+				ClearTags:     true,
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "review too long",
+			ctx:  ctx,
+			request: &betterreads.UpdateLibraryBookRequest{
+				BookId:        testBookID,
+				Title:         "Test Book",
+				AuthorName:    "Test Author",
+				ReadingStatus: betterreads.ReadingStatus_READING_STATUS_READ,
+				Review:        proto.String(strings.Repeat("é", maxReviewLength+1)),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "notes too long",
+			ctx:  ctx,
+			request: &betterreads.UpdateLibraryBookRequest{
+				BookId:        testBookID,
+				Title:         "Test Book",
+				AuthorName:    "Test Author",
+				ReadingStatus: betterreads.ReadingStatus_READING_STATUS_READ,
+				Notes:         proto.String(strings.Repeat("a", maxNotesLength+1)),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "blank tag",
+			ctx:  ctx,
+			request: &betterreads.UpdateLibraryBookRequest{
+				BookId:        testBookID,
+				Title:         "Test Book",
+				AuthorName:    "Test Author",
+				ReadingStatus: betterreads.ReadingStatus_READING_STATUS_READ,
+				Tags:          []string{"fantasy", "  "},
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "too many tags",
+			ctx:  ctx,
+			request: &betterreads.UpdateLibraryBookRequest{
+				BookId:        testBookID,
+				Title:         "Test Book",
+				AuthorName:    "Test Author",
+				ReadingStatus: betterreads.ReadingStatus_READING_STATUS_READ,
+				Tags:          make([]string, maxTags+1),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "book from an unverified source not in catalog",
+			ctx:  ctx,
+			request: &betterreads.UpdateLibraryBookRequest{
+				BookId:        "gb-abc123",
+				Title:         "Test Book",
+				AuthorName:    "Test Author",
+				Source:        betterreads.BookSource_BOOK_SOURCE_GOOGLE_BOOKS,
+				ReadingStatus: betterreads.ReadingStatus_READING_STATUS_READ,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UpdateLibraryBook(gomock.Any(), gomock.Any()).
+					Return(fmt.Errorf("UpdateLibraryBook: %w", postgres.ErrCatalogBookNotFound))
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name: "manual book not created with CreateManualBook",
+			ctx:  ctx,
+			request: &betterreads.UpdateLibraryBookRequest{
+				BookId:        "manual:anything",
+				Title:         "Test Book",
+				AuthorName:    "Test Author",
+				Source:        betterreads.BookSource_BOOK_SOURCE_MANUAL,
+				ReadingStatus: betterreads.ReadingStatus_READING_STATUS_READ,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UpdateLibraryBook(gomock.Any(), gomock.Any()).
+					Return(fmt.Errorf("UpdateLibraryBook: %w", postgres.ErrCatalogBookNotFound))
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
 		{
 			name:    "database error",
 			ctx:     ctx,
@@ -363,8 +544,8 @@ func TestServer_GetUserLibrary(t *testing.T) {
 					GetUserShelves(gomock.Any(), testUserID).
 					Return(testShelves, nil)
 				m.EXPECT().
-					GetUserLibrary(gomock.Any(), testUserID).
-					Return([]*data.LibraryBook{&shelvedBook, &unshelvedBook}, nil)
+					GetUserLibrary(gomock.Any(), testUserID, data.Page{Limit: defaultPageLimit}).
+					Return([]*data.LibraryBook{&shelvedBook, &unshelvedBook}, int32(2), nil)
 			},
 			wantCode: codes.OK,
 			wantErr:  false,
@@ -387,8 +568,8 @@ func TestServer_GetUserLibrary(t *testing.T) {
 					GetUserShelves(gomock.Any(), testUserID).
 					Return(testShelves, nil)
 				m.EXPECT().
-					GetUserLibrary(gomock.Any(), testUserID).
-					Return([]*data.LibraryBook{}, nil)
+					GetUserLibrary(gomock.Any(), testUserID, gomock.Any()).
+					Return([]*data.LibraryBook{}, int32(0), nil)
 			},
 			wantCode: codes.OK,
 			wantErr:  false,
@@ -444,8 +625,8 @@ func TestServer_GetUserLibrary(t *testing.T) {
 					GetUserShelves(gomock.Any(), testUserID).
 					Return(testShelves, nil)
 				m.EXPECT().
-					GetUserLibrary(gomock.Any(), testUserID).
-					Return(nil, errors.New("database connection failed"))
+					GetUserLibrary(gomock.Any(), testUserID, gomock.Any()).
+					Return(nil, int32(0), errors.New("database connection failed"))
 			},
 			wantCode: codes.Internal,
 			wantErr:  true,
@@ -461,8 +642,8 @@ func TestServer_GetUserLibrary(t *testing.T) {
 					GetUserShelves(gomock.Any(), testUserID).
 					Return(testShelves, nil)
 				m.EXPECT().
-					GetUserLibrary(gomock.Any(), testUserID).
-					Return([]*data.LibraryBook{&shelvedBook}, nil)
+					GetUserLibrary(gomock.Any(), testUserID, gomock.Any()).
+					Return([]*data.LibraryBook{&shelvedBook}, int32(1), nil)
 			},
 			wantCode: codes.OK,
 			wantErr:  false,
@@ -495,8 +676,8 @@ func TestServer_GetUserLibrary(t *testing.T) {
 					GetUserShelves(gomock.Any(), testUserID).
 					Return([]*data.Shelf{}, nil)
 				m.EXPECT().
-					GetUserLibrary(gomock.Any(), testUserID).
-					Return([]*data.LibraryBook{}, nil)
+					GetUserLibrary(gomock.Any(), testUserID, gomock.Any()).
+					Return([]*data.LibraryBook{}, int32(0), nil)
 			},
 			wantCode: codes.OK,
 			wantErr:  false,
@@ -520,14 +701,15 @@ func TestServer_GetUserLibrary(t *testing.T) {
 					GetUserShelves(gomock.Any(), testUserID).
 					Return([]*data.Shelf{}, nil)
 				m.EXPECT().
-					GetUserLibrary(gomock.Any(), testUserID).
-					Return([]*data.LibraryBook{&unshelvedBook}, nil)
+					GetUserLibrary(gomock.Any(), testUserID, data.Page{Limit: 10, Offset: 10}).
+					Return([]*data.LibraryBook{&unshelvedBook}, int32(11), nil)
 			},
 			wantCode: codes.OK,
 			wantErr:  false,
 			verify: func(t *testing.T, resp *betterreads.GetUserLibraryResponse) {
 				t.Helper()
-				assert.Equal(t, int32(1), resp.Pagination.Total)
+				assert.Len(t, resp.UnshelvedBooks, 1)
+				assert.Equal(t, int32(11), resp.Pagination.Total)
 				assert.Equal(t, int32(2), resp.Pagination.Page)
 				assert.Equal(t, int32(10), resp.Pagination.Limit)
 			},
@@ -564,3 +746,102 @@ func TestServer_GetUserLibrary(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_SearchLibrary(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.SearchLibraryRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		verify    func(*testing.T, *betterreads.SearchLibraryResponse)
+	}{
+		{
+			name:    "matches books",
+			ctx:     ctx,
+			request: &betterreads.SearchLibraryRequest{Query: "  dune ", Tag: "Sci-Fi", Limit: 1},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					SearchLibrary(gomock.Any(), testUserID, data.LibrarySearch{Query: "dune", Tag: "sci-fi"}, data.Page{Limit: 1}).
+					Return([]*data.LibraryBook{{
+						BookID:     "OL1M",
+						Title:      "Dune",
+						AuthorName: "Frank Herbert",
+						Notes:      "Reread every winter",
+						Tags:       []string{"sci-fi"},
+						AddedAt:    testTime,
+					}}, int32(2), nil)
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.SearchLibraryResponse) {
+				t.Helper()
+				require.Len(t, resp.Books, 1)
+				assert.Equal(t, "Reread every winter", resp.Books[0].Notes)
+				assert.Equal(t, []string{"sci-fi"}, resp.Books[0].Tags)
+				assert.Equal(t, int32(2), resp.Pagination.Total)
+				assert.NotEmpty(t, resp.Pagination.NextPageToken)
+			},
+		},
+		{
+			name:      "no query or tag",
+			ctx:       ctx,
+			request:   &betterreads.SearchLibraryRequest{Query: "  "},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       context.Background(),
+			request:   &betterreads.SearchLibraryRequest{Query: "dune"},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+		},
+		{
+			name:    "database error",
+			ctx:     ctx,
+			request: &betterreads.SearchLibraryRequest{Query: "dune"},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					SearchLibrary(gomock.Any(), testUserID, gomock.Any(), gomock.Any()).
+					Return(nil, int32(0), errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.SearchLibrary(tt.ctx, tt.request)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+func TestServer_SearchLibrary_PageTokenIsPerSearch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, "test-user-123")
+	s := &Server{PageTokenSecret: []byte("secret")}
+	token := s.encodePageToken("library-search:test-user-123::dune", data.Cursor{ID: "OL1M"})
+
+	_, err := s.SearchLibrary(ctx, &betterreads.SearchLibraryRequest{Query: "emma", PageToken: token})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
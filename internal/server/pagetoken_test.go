@@ -0,0 +1,80 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServer_pageToken(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{PageTokenSecret: []byte("test-secret")}
+	cursor := data.Cursor{
+		Time: time.Date(2026, 1, 1, 12, 30, 0, 123456000, time.UTC),
+		Keys: []string{"Dune", "Frank Herbert"},
+		ID:   "OL456M",
+	}
+	token := s.encodePageToken("shelf:abc:1", cursor)
+
+	tests := []struct {
+		name    string
+		server  *Server
+		token   string
+		list    string
+		want    *data.Cursor
+		wantErr bool
+	}{
+		{name: "round trip", server: s, token: token, list: "shelf:abc:1", want: &cursor},
+		{name: "empty token", server: s, token: "", list: "shelf:abc:1", want: nil},
+		{name: "issued for another list", server: s, token: token, list: "shelf:abc:2", wantErr: true},
+		{name: "signed with another secret", server: &Server{PageTokenSecret: []byte("other")}, token: token, list: "shelf:abc:1", wantErr: true},
+		{name: "tampered payload", server: s, token: "e30" + token[3:], list: "shelf:abc:1", wantErr: true},
+		{name: "missing signature", server: s, token: "e30", list: "shelf:abc:1", wantErr: true},
+		{name: "garbage", server: s, token: "not-a-token", list: "shelf:abc:1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.server.decodePageToken(tt.token, tt.list)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidPageToken)
+				return
+			}
+			require.NoError(t, err)
+			if tt.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.True(t, tt.want.Time.Equal(got.Time))
+			assert.Equal(t, tt.want.Keys, got.Keys)
+			assert.Equal(t, tt.want.ID, got.ID)
+		})
+	}
+}
+
+func Test_nextPageToken(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{PageTokenSecret: []byte("test-secret")}
+	page, err := newPageRequest(1, 2)
+	require.NoError(t, err)
+	c1 := &data.Comment{ID: "comment-1", CreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c2 := &data.Comment{ID: "comment-2", CreatedAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)}
+
+	assert.Empty(t, nextPageToken(s, page, "comments:post", []*data.Comment{c1}, commentCursor))
+	assert.Empty(t, nextPageToken(s, page, "comments:post", []*data.Comment{}, commentCursor))
+
+	token := nextPageToken(s, page, "comments:post", []*data.Comment{c1, c2}, commentCursor)
+	require.NotEmpty(t, token)
+	after, err := s.decodePageToken(token, "comments:post")
+	require.NoError(t, err)
+	assert.Equal(t, "comment-2", after.ID)
+	assert.True(t, c2.CreatedAt.Equal(after.Time))
+}
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"math"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPageLimit int32 = 20
+	maxPageLimit     int32 = 100
+)
+
+// pageRequest is a normalized page/limit pair taken from a list request. After
+// is set when the client continues from a page_token instead of a page number.
+type pageRequest struct {
+	Page  int32
+	Limit int32
+	After *data.Cursor
+}
+
+// newPageRequest applies defaults to client supplied pagination: pages start
+// at 1 and limits fall back to defaultPageLimit and are capped at maxPageLimit.
+// A page whose offset does not fit in an int32 is rejected with a gRPC status
+// error.
+func newPageRequest(page, limit int32) (pageRequest, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	if int64(page-1)*int64(limit) > math.MaxInt32 {
+		return pageRequest{}, status.Error(codes.InvalidArgument, "page is too large")
+	}
+	return pageRequest{Page: page, Limit: limit}, nil
+}
+
+// withPageToken decodes a page_token issued for list into p.After. Errors are
+// returned as gRPC status errors.
+func (s *Server) withPageToken(p pageRequest, token, list string) (pageRequest, error) {
+	after, err := s.decodePageToken(token, list)
+	if err != nil {
+		return p, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+	p.After = after
+	return p, nil
+}
+
+// Offset returns the number of rows to skip for the page.
+func (p pageRequest) Offset() int32 {
+	return (p.Page - 1) * p.Limit
+}
+
+// Query returns the page as passed to the database layer.
+func (p pageRequest) Query() data.Page {
+	return data.Page{Limit: p.Limit, Offset: p.Offset(), After: p.After}
+}
+
+// Metadata builds the pagination block returned to clients.
+func (p pageRequest) Metadata(total int32) *betterreads.PaginationMetadata {
+	return &betterreads.PaginationMetadata{
+		Page:  p.Page,
+		Limit: p.Limit,
+		Total: total,
+	}
+}
+
+// nextPageToken returns a token for the page after rows, or "" when rows did
+// not fill the page. cursor extracts the keyset position of a row.
+func nextPageToken[T any](s *Server, p pageRequest, list string, rows []T, cursor func(T) data.Cursor) string {
+	if len(rows) == 0 || len(rows) < int(p.Limit) {
+		return ""
+	}
+	return s.encodePageToken(list, cursor(rows[len(rows)-1]))
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test_newPageRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		page       int32
+		limit      int32
+		wantPage   int32
+		wantLimit  int32
+		wantOffset int32
+		wantCode   codes.Code
+	}{
+		{name: "defaults", page: 0, limit: 0, wantPage: 1, wantLimit: defaultPageLimit, wantOffset: 0},
+		{name: "negative values", page: -3, limit: -1, wantPage: 1, wantLimit: defaultPageLimit, wantOffset: 0},
+		{name: "explicit page and limit", page: 3, limit: 10, wantPage: 3, wantLimit: 10, wantOffset: 20},
+		{name: "limit capped", page: 2, limit: 1000, wantPage: 2, wantLimit: maxPageLimit, wantOffset: maxPageLimit},
+		{
+			name: "last page with an offset", page: math.MaxInt32/maxPageLimit + 1, limit: maxPageLimit,
+			wantPage: math.MaxInt32/maxPageLimit + 1, wantLimit: maxPageLimit, wantOffset: math.MaxInt32 / maxPageLimit * maxPageLimit,
+		},
+		{name: "offset overflows", page: math.MaxInt32/maxPageLimit + 2, limit: maxPageLimit, wantCode: codes.InvalidArgument},
+		{name: "largest page", page: math.MaxInt32, limit: 0, wantCode: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			p, err := newPageRequest(tt.page, tt.limit)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPage, p.Page)
+			assert.Equal(t, tt.wantLimit, p.Limit)
+			assert.Equal(t, tt.wantOffset, p.Offset())
+
+			md := p.Metadata(42)
+			assert.Equal(t, tt.wantPage, md.Page)
+			assert.Equal(t, tt.wantLimit, md.Limit)
+			assert.Equal(t, int32(42), md.Total)
+		})
+	}
+}
@@ -52,13 +52,14 @@ func (s *Server) GetCurrentUserProfile(ctx context.Context, _ *betterreads.GetCu
 	}
 
 	return &betterreads.GetCurrentUserProfileResponse{
-		CreatedAt:       timestamppb.New(profile.GetCreatedAt()),
-		Email:           profile.GetEmail(),
-		FirstName:       profile.GetFirstName(),
-		Id:              profile.GetID(),
-		LastName:        profile.GetLastName(),
-		ProfilePhotoUrl: profile.GetProfilePhotoURL(),
-		Username:        profile.GetUsername(),
+		CreatedAt:            timestamppb.New(profile.GetCreatedAt()),
+		Email:                profile.GetEmail(),
+		FirstName:            profile.GetFirstName(),
+		Id:                   profile.GetID(),
+		LastName:             profile.GetLastName(),
+		ProfilePhotoUrl:      profile.GetProfilePhotoURL(),
+		Username:             profile.GetUsername(),
+		ActivityPostsEnabled: profile.GetActivityPostsEnabled(),
 	}, nil
 }
 
@@ -102,6 +103,27 @@ func (s *Server) UpdateUserProfile(ctx context.Context, request *betterreads.Upd
 	}, nil
 }
 
+// UpdateActivitySettings implements betterreads.BetterReadsServiceServer.
+func (s *Server) UpdateActivitySettings(ctx context.Context, request *betterreads.UpdateActivitySettingsRequest) (*betterreads.UpdateActivitySettingsResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unable to retrieve user_id from context")
+	}
+
+	if err := s.DB.SetActivityPostsEnabled(ctx, userID, request.GetActivityPostsEnabled()); err != nil {
+		switch {
+		case errors.Is(err, postgres.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		default:
+			return nil, status.Error(codes.Internal, "failed to update activity settings")
+		}
+	}
+
+	return &betterreads.UpdateActivitySettingsResponse{
+		ActivityPostsEnabled: request.GetActivityPostsEnabled(),
+	}, nil
+}
+
 // CreateUserProfile implements betterreads.BetterReadsServiceServer.
 func (s *Server) CreateUserProfile(ctx context.Context, request *betterreads.CreateUserProfileRequest) (*betterreads.CreateUserProfileResponse, error) {
 	userID, ok := headers.GetUserID(ctx)
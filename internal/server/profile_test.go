@@ -364,6 +364,107 @@ func TestServer_UpdateUserProfile(t *testing.T) {
 	}
 }
 
+func TestServer_UpdateActivitySettings(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	ctxNoUserID := context.Background()
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.UpdateActivitySettingsRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+	}{
+		{
+			name:    "opt out",
+			ctx:     ctx,
+			request: &betterreads.UpdateActivitySettingsRequest{ActivityPostsEnabled: false},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					SetActivityPostsEnabled(gomock.Any(), testUserID, false).
+					Return(nil)
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+		},
+		{
+			name:    "opt in",
+			ctx:     ctx,
+			request: &betterreads.UpdateActivitySettingsRequest{ActivityPostsEnabled: true},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					SetActivityPostsEnabled(gomock.Any(), testUserID, true).
+					Return(nil)
+			},
+			wantCode: codes.OK,
+			wantErr:  false,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       ctxNoUserID,
+			request:   &betterreads.UpdateActivitySettingsRequest{},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:    "user not found",
+			ctx:     ctx,
+			request: &betterreads.UpdateActivitySettingsRequest{},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					SetActivityPostsEnabled(gomock.Any(), testUserID, false).
+					Return(postgres.ErrUserNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name:    "database error",
+			ctx:     ctx,
+			request: &betterreads.UpdateActivitySettingsRequest{},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					SetActivityPostsEnabled(gomock.Any(), testUserID, false).
+					Return(errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.UpdateActivitySettings(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, tt.request.ActivityPostsEnabled, resp.ActivityPostsEnabled)
+		})
+	}
+}
+
 func TestServer_CreateUserProfile(t *testing.T) {
 	t.Parallel()
 
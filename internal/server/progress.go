@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LogReadingProgress implements betterreads.BetterReadsServiceServer.
+func (s *Server) LogReadingProgress(ctx context.Context, req *betterreads.LogReadingProgressRequest) (*betterreads.LogReadingProgressResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.BookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "book_id is required")
+	}
+	if req.Page < 0 || req.TotalPages < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page and total_pages must not be negative")
+	}
+	if req.Page == 0 && (math.IsNaN(req.Percent) || req.Percent < 0 || req.Percent > 100) {
+		return nil, status.Error(codes.InvalidArgument, "percent must be between 0 and 100")
+	}
+
+	progress := &data.ReadingProgress{
+		ID:         uuid.New().String(),
+		UserID:     userID,
+		BookID:     req.BookId,
+		Page:       req.Page,
+		TotalPages: req.TotalPages,
+		Percent:    req.Percent,
+		Note:       req.Note,
+		CreatedAt:  time.Now(),
+	}
+
+	book, err := s.DB.LogReadingProgress(ctx, progress)
+	if err != nil {
+		switch {
+		case errors.Is(err, postgres.ErrBookNotFound):
+			return nil, status.Error(codes.NotFound, "book not found in library")
+		case errors.Is(err, postgres.ErrTotalPagesUnknown):
+			return nil, status.Error(codes.InvalidArgument, "total_pages is required until the book's page count is known")
+		case errors.Is(err, postgres.ErrPageBeyondTotal):
+			return nil, status.Error(codes.InvalidArgument, "page must not be greater than total_pages")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to log reading progress: %v", err)
+	}
+
+	return &betterreads.LogReadingProgressResponse{
+		Progress: readingProgressToProto(progress),
+		Book:     libraryBookToProto(book),
+	}, nil
+}
+
+// GetReadingProgress implements betterreads.BetterReadsServiceServer.
+func (s *Server) GetReadingProgress(ctx context.Context, req *betterreads.GetReadingProgressRequest) (*betterreads.GetReadingProgressResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.BookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "book_id is required")
+	}
+
+	list := "progress:" + userID + ":" + req.BookId
+	page, err := newPageRequest(req.Page, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	page, err = s.withPageToken(page, req.PageToken, list)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, total, err := s.DB.GetReadingProgress(ctx, userID, req.BookId, page.Query())
+	if err != nil {
+		if errors.Is(err, postgres.ErrBookNotFound) {
+			return nil, status.Error(codes.NotFound, "book not found in library")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get reading progress: %v", err)
+	}
+
+	progress := make([]*betterreads.ReadingProgress, 0, len(entries))
+	for _, entry := range entries {
+		progress = append(progress, readingProgressToProto(entry))
+	}
+
+	pagination := page.Metadata(total)
+	pagination.NextPageToken = nextPageToken(s, page, list, entries, readingProgressCursor)
+
+	return &betterreads.GetReadingProgressResponse{
+		Progress:   progress,
+		Pagination: pagination,
+	}, nil
+}
+
+func readingProgressToProto(p *data.ReadingProgress) *betterreads.ReadingProgress {
+	return &betterreads.ReadingProgress{
+		Id:         p.ID,
+		BookId:     p.BookID,
+		Page:       p.Page,
+		TotalPages: p.TotalPages,
+		Percent:    p.Percent,
+		Note:       p.Note,
+		CreatedAt:  timestamppb.New(p.CreatedAt),
+	}
+}
@@ -0,0 +1,301 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/celestialdragonfly/betterreads/internal/postgres/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ---- LogReadingProgress ------------------------------------------------
+
+func TestServer_LogReadingProgress(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.LogReadingProgressRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+		verify    func(*testing.T, *betterreads.LogReadingProgressResponse)
+	}{
+		{
+			name:    "logs a page and returns the updated book",
+			ctx:     ctx,
+			request: &betterreads.LogReadingProgressRequest{BookId: "OL1M", Page: 50, TotalPages: 200, Note: "slow start"},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					LogReadingProgress(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *data.ReadingProgress) (*data.LibraryBook, error) {
+						assert.NotEmpty(t, p.ID)
+						assert.Equal(t, testUserID, p.UserID)
+						assert.Equal(t, "OL1M", p.BookID)
+						assert.Equal(t, int32(50), p.Page)
+						assert.Equal(t, int32(200), p.TotalPages)
+						assert.Equal(t, "slow start", p.Note)
+						p.Percent = 25
+						return &data.LibraryBook{
+							UserID:        testUserID,
+							BookID:        "OL1M",
+							ReadingStatus: data.ReadingStatusReading,
+							AddedAt:       testTime,
+							UpdatedAt:     testTime,
+						}, nil
+					})
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.LogReadingProgressResponse) {
+				t.Helper()
+				assert.InDelta(t, 25, resp.Progress.Percent, 0)
+				assert.Equal(t, int32(50), resp.Progress.Page)
+				assert.Equal(t, betterreads.ReadingStatus_READING_STATUS_READING, resp.Book.ReadingStatus)
+			},
+		},
+		{
+			name:      "percent out of range",
+			ctx:       ctx,
+			request:   &betterreads.LogReadingProgressRequest{BookId: "OL1M", Percent: 120},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "percent not a number",
+			ctx:       ctx,
+			request:   &betterreads.LogReadingProgressRequest{BookId: "OL1M", Percent: math.NaN()},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "negative page",
+			ctx:       ctx,
+			request:   &betterreads.LogReadingProgressRequest{BookId: "OL1M", Page: -1},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "missing book_id",
+			ctx:       ctx,
+			request:   &betterreads.LogReadingProgressRequest{Percent: 10},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       context.Background(),
+			request:   &betterreads.LogReadingProgressRequest{BookId: "OL1M", Percent: 10},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:    "book not in library",
+			ctx:     ctx,
+			request: &betterreads.LogReadingProgressRequest{BookId: "OL1M", Percent: 10},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().LogReadingProgress(gomock.Any(), gomock.Any()).Return(nil, postgres.ErrBookNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name:    "page count unknown",
+			ctx:     ctx,
+			request: &betterreads.LogReadingProgressRequest{BookId: "OL1M", Page: 10},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().LogReadingProgress(gomock.Any(), gomock.Any()).Return(nil, postgres.ErrTotalPagesUnknown)
+			},
+			wantCode: codes.InvalidArgument,
+			wantErr:  true,
+		},
+		{
+			name:    "database error",
+			ctx:     ctx,
+			request: &betterreads.LogReadingProgressRequest{BookId: "OL1M", Percent: 10},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().LogReadingProgress(gomock.Any(), gomock.Any()).Return(nil, errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.LogReadingProgress(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+// ---- GetReadingProgress ------------------------------------------------
+
+func TestServer_GetReadingProgress(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	testTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	list := "progress:" + testUserID + ":OL1M"
+
+	entries := []*data.ReadingProgress{
+		{ID: "progress-2", UserID: testUserID, BookID: "OL1M", Percent: 50, CreatedAt: testTime.Add(time.Hour)},
+		{ID: "progress-1", UserID: testUserID, BookID: "OL1M", Page: 20, TotalPages: 200, Percent: 10, CreatedAt: testTime},
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.GetReadingProgressRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+		verify    func(*testing.T, *betterreads.GetReadingProgressResponse)
+	}{
+		{
+			name:    "returns a page of history",
+			ctx:     ctx,
+			request: &betterreads.GetReadingProgressRequest{BookId: "OL1M", Limit: 2},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetReadingProgress(gomock.Any(), testUserID, "OL1M", data.Page{Limit: 2}).
+					Return(entries, int32(3), nil)
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.GetReadingProgressResponse) {
+				t.Helper()
+				require.Len(t, resp.Progress, 2)
+				assert.Equal(t, "progress-2", resp.Progress[0].Id)
+				assert.Equal(t, int32(200), resp.Progress[1].TotalPages)
+				assert.Equal(t, int32(3), resp.Pagination.Total)
+
+				after, err := (&Server{}).decodePageToken(resp.Pagination.NextPageToken, list)
+				require.NoError(t, err)
+				assert.Equal(t, "progress-1", after.ID)
+			},
+		},
+		{
+			name: "token from another book",
+			ctx:  ctx,
+			request: &betterreads.GetReadingProgressRequest{
+				BookId:    "OL1M",
+				PageToken: (&Server{}).encodePageToken("progress:"+testUserID+":OL2M", readingProgressCursor(entries[1])),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "missing book_id",
+			ctx:       ctx,
+			request:   &betterreads.GetReadingProgressRequest{},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       context.Background(),
+			request:   &betterreads.GetReadingProgressRequest{BookId: "OL1M"},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:    "book not in library",
+			ctx:     ctx,
+			request: &betterreads.GetReadingProgressRequest{BookId: "OL1M"},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetReadingProgress(gomock.Any(), testUserID, "OL1M", gomock.Any()).
+					Return(nil, int32(0), postgres.ErrBookNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+		{
+			name:    "database error",
+			ctx:     ctx,
+			request: &betterreads.GetReadingProgressRequest{BookId: "OL1M"},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetReadingProgress(gomock.Any(), testUserID, "OL1M", gomock.Any()).
+					Return(nil, int32(0), errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.GetReadingProgress(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
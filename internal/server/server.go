@@ -4,17 +4,24 @@ import (
 	betterreads "github.com/celestialdragonfly/betterreads/generated"
 	"github.com/celestialdragonfly/betterreads/internal/openlibrary"
 	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/celestialdragonfly/betterreads/internal/provider"
 )
 
 type Config struct {
 	SQLClient   postgres.API
 	OpenLibrary openlibrary.ClientInterface
+	// GoogleBooks is searched alongside Open Library when set.
+	GoogleBooks provider.Provider
+	// PageTokenSecret signs page tokens. Every replica must share it.
+	PageTokenSecret []byte
 }
 
 type Server struct {
 	betterreads.UnimplementedBetterReadsServiceServer
-	DB          postgres.API
-	OpenLibrary openlibrary.ClientInterface
+	DB              postgres.API
+	OpenLibrary     openlibrary.ClientInterface
+	GoogleBooks     provider.Provider
+	PageTokenSecret []byte
 }
 
 var _ betterreads.BetterReadsServiceServer = (*Server)(nil)
@@ -22,7 +29,9 @@ var _ betterreads.BetterReadsServiceServer = (*Server)(nil)
 // NewServer creates a new server instance with the provided configuration.
 func NewServer(cfg *Config) *Server {
 	return &Server{
-		DB:          cfg.SQLClient,
-		OpenLibrary: cfg.OpenLibrary,
+		DB:              cfg.SQLClient,
+		OpenLibrary:     cfg.OpenLibrary,
+		GoogleBooks:     cfg.GoogleBooks,
+		PageTokenSecret: cfg.PageTokenSecret,
 	}
 }
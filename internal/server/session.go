@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateReadingSession implements betterreads.BetterReadsServiceServer.
+func (s *Server) CreateReadingSession(ctx context.Context, req *betterreads.CreateReadingSessionRequest) (*betterreads.CreateReadingSessionResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.BookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "book_id is required")
+	}
+
+	now := time.Now()
+	session := &data.ReadingSession{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		BookID:    req.BookId,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := setReadingSession(session, req.StartedAt, req.FinishedAt, req.DidNotFinish, req.Rating); err != nil {
+		return nil, err
+	}
+
+	created, err := s.DB.CreateReadingSession(ctx, session)
+	if err != nil {
+		if errors.Is(err, postgres.ErrBookNotFound) {
+			return nil, status.Error(codes.NotFound, "book not found in library")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create reading session: %v", err)
+	}
+
+	return &betterreads.CreateReadingSessionResponse{Session: readingSessionToProto(created)}, nil
+}
+
+// UpdateReadingSession implements betterreads.BetterReadsServiceServer.
+func (s *Server) UpdateReadingSession(ctx context.Context, req *betterreads.UpdateReadingSessionRequest) (*betterreads.UpdateReadingSessionResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.BookId == "" || req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "book_id and session_id are required")
+	}
+
+	session := &data.ReadingSession{
+		ID:        req.SessionId,
+		UserID:    userID,
+		BookID:    req.BookId,
+		UpdatedAt: time.Now(),
+	}
+	if err := setReadingSession(session, req.StartedAt, req.FinishedAt, req.DidNotFinish, req.Rating); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.DB.UpdateReadingSession(ctx, session)
+	if err != nil {
+		if errors.Is(err, postgres.ErrReadingSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "reading session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to update reading session: %v", err)
+	}
+
+	return &betterreads.UpdateReadingSessionResponse{Session: readingSessionToProto(updated)}, nil
+}
+
+// DeleteReadingSession implements betterreads.BetterReadsServiceServer.
+func (s *Server) DeleteReadingSession(ctx context.Context, req *betterreads.DeleteReadingSessionRequest) (*betterreads.DeleteReadingSessionResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	if req.BookId == "" || req.SessionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "book_id and session_id are required")
+	}
+
+	if err := s.DB.DeleteReadingSession(ctx, userID, req.BookId, req.SessionId); err != nil {
+		if errors.Is(err, postgres.ErrReadingSessionNotFound) {
+			return nil, status.Error(codes.NotFound, "reading session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to delete reading session: %v", err)
+	}
+
+	return &betterreads.DeleteReadingSessionResponse{}, nil
+}
+
+// setReadingSession validates the editable fields of a session request and
+// copies them to session. Errors are returned as gRPC status errors.
+func setReadingSession(session *data.ReadingSession, startedAt, finishedAt *timestamppb.Timestamp, dnf bool, rating betterreads.BookRating) error {
+	if startedAt == nil && finishedAt == nil {
+		return status.Error(codes.InvalidArgument, "started_at or finished_at is required")
+	}
+	if dnf && finishedAt == nil {
+		return status.Error(codes.InvalidArgument, "finished_at is required when did_not_finish is set")
+	}
+	if rating < 0 || rating > 5 {
+		return status.Error(codes.InvalidArgument, "rating must be between 0 and 5")
+	}
+
+	for _, ts := range []*timestamppb.Timestamp{startedAt, finishedAt} {
+		if ts != nil && ts.CheckValid() != nil {
+			return status.Error(codes.InvalidArgument, "invalid timestamp")
+		}
+	}
+	if startedAt != nil {
+		t := startedAt.AsTime()
+		session.StartedAt = &t
+	}
+	if finishedAt != nil {
+		t := finishedAt.AsTime()
+		session.FinishedAt = &t
+	}
+	if session.StartedAt != nil && session.FinishedAt != nil && session.FinishedAt.Before(*session.StartedAt) {
+		return status.Error(codes.InvalidArgument, "finished_at must not be before started_at")
+	}
+
+	session.DidNotFinish = dnf
+	session.Rating = int32(rating)
+	return nil
+}
+
+func readingSessionToProto(s *data.ReadingSession) *betterreads.ReadingSession {
+	session := &betterreads.ReadingSession{
+		Id:           s.ID,
+		BookId:       s.BookID,
+		DidNotFinish: s.DidNotFinish,
+		Rating:       betterreads.BookRating(s.Rating),
+		CreatedAt:    timestamppb.New(s.CreatedAt),
+		UpdatedAt:    timestamppb.New(s.UpdatedAt),
+	}
+	if s.StartedAt != nil {
+		session.StartedAt = timestamppb.New(*s.StartedAt)
+	}
+	if s.FinishedAt != nil {
+		session.FinishedAt = timestamppb.New(*s.FinishedAt)
+	}
+	return session
+}
@@ -0,0 +1,258 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/celestialdragonfly/betterreads/internal/postgres/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ---- CreateReadingSession ----------------------------------------------
+
+func TestServer_CreateReadingSession(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	startedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	finishedAt := startedAt.AddDate(0, 0, 10)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.CreateReadingSessionRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		wantErr   bool
+		verify    func(*testing.T, *betterreads.CreateReadingSessionResponse)
+	}{
+		{
+			name: "records a finished read",
+			ctx:  ctx,
+			request: &betterreads.CreateReadingSessionRequest{
+				BookId:     "OL1M",
+				StartedAt:  timestamppb.New(startedAt),
+				FinishedAt: timestamppb.New(finishedAt),
+				Rating:     betterreads.BookRating_BOOK_RATING_FOUR_STAR,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					CreateReadingSession(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s *data.ReadingSession) (*data.ReadingSession, error) {
+						assert.NotEmpty(t, s.ID)
+						assert.Equal(t, testUserID, s.UserID)
+						assert.Equal(t, "OL1M", s.BookID)
+						assert.Equal(t, startedAt, *s.StartedAt)
+						assert.Equal(t, finishedAt, *s.FinishedAt)
+						assert.Equal(t, int32(4), s.Rating)
+						return s, nil
+					})
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, resp *betterreads.CreateReadingSessionResponse) {
+				t.Helper()
+				assert.Equal(t, finishedAt, resp.Session.FinishedAt.AsTime())
+				assert.Equal(t, betterreads.BookRating_BOOK_RATING_FOUR_STAR, resp.Session.Rating)
+			},
+		},
+		{
+			name:      "no dates",
+			ctx:       ctx,
+			request:   &betterreads.CreateReadingSessionRequest{BookId: "OL1M"},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "finished before started",
+			ctx:  ctx,
+			request: &betterreads.CreateReadingSessionRequest{
+				BookId:     "OL1M",
+				StartedAt:  timestamppb.New(finishedAt),
+				FinishedAt: timestamppb.New(startedAt),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "did not finish without a date",
+			ctx:  ctx,
+			request: &betterreads.CreateReadingSessionRequest{
+				BookId:       "OL1M",
+				StartedAt:    timestamppb.New(startedAt),
+				DidNotFinish: true,
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       context.Background(),
+			request:   &betterreads.CreateReadingSessionRequest{BookId: "OL1M", StartedAt: timestamppb.New(startedAt)},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+			wantErr:   true,
+		},
+		{
+			name:    "book not in library",
+			ctx:     ctx,
+			request: &betterreads.CreateReadingSessionRequest{BookId: "OL1M", StartedAt: timestamppb.New(startedAt)},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().CreateReadingSession(gomock.Any(), gomock.Any()).Return(nil, postgres.ErrBookNotFound)
+			},
+			wantCode: codes.NotFound,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.CreateReadingSession(tt.ctx, tt.request)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok, "error should be a status error")
+				assert.Equal(t, tt.wantCode, st.Code())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			if tt.verify != nil {
+				tt.verify(t, resp)
+			}
+		})
+	}
+}
+
+// ---- UpdateReadingSession ----------------------------------------------
+
+func TestServer_UpdateReadingSession(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	finishedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name      string
+		request   *betterreads.UpdateReadingSessionRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+	}{
+		{
+			name: "marks a read as abandoned",
+			request: &betterreads.UpdateReadingSessionRequest{
+				BookId:       "OL1M",
+				SessionId:    "session-1",
+				FinishedAt:   timestamppb.New(finishedAt),
+				DidNotFinish: true,
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					UpdateReadingSession(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, s *data.ReadingSession) (*data.ReadingSession, error) {
+						assert.Equal(t, "session-1", s.ID)
+						assert.Equal(t, testUserID, s.UserID)
+						assert.True(t, s.DidNotFinish)
+						assert.Nil(t, s.StartedAt)
+						return s, nil
+					})
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "missing session_id",
+			request:   &betterreads.UpdateReadingSessionRequest{BookId: "OL1M", FinishedAt: timestamppb.New(finishedAt)},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "not found",
+			request: &betterreads.UpdateReadingSessionRequest{
+				BookId:     "OL1M",
+				SessionId:  "session-1",
+				FinishedAt: timestamppb.New(finishedAt),
+			},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().UpdateReadingSession(gomock.Any(), gomock.Any()).Return(nil, postgres.ErrReadingSessionNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			_, err := s.UpdateReadingSession(ctx, tt.request)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+// ---- DeleteReadingSession ----------------------------------------------
+
+func TestServer_DeleteReadingSession(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{name: "deleted", wantCode: codes.OK},
+		{name: "not found", err: postgres.ErrReadingSessionNotFound, wantCode: codes.NotFound},
+		{name: "database error", err: errors.New("database connection failed"), wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			mockDB.EXPECT().DeleteReadingSession(gomock.Any(), testUserID, "OL1M", "session-1").Return(tt.err)
+
+			s := &Server{DB: mockDB}
+			_, err := s.DeleteReadingSession(ctx, &betterreads.DeleteReadingSessionRequest{BookId: "OL1M", SessionId: "session-1"})
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	betterreads "github.com/celestialdragonfly/betterreads/generated"
@@ -168,38 +169,39 @@ func (s *Server) GetShelfBooks(ctx context.Context, req *betterreads.GetShelfBoo
 		return nil, status.Error(codes.InvalidArgument, "shelf_id is required")
 	}
 
-	books, err := s.DB.GetShelfBooks(ctx, userID, req.ShelfId)
+	if _, ok := betterreads.ShelfSortOrder_name[int32(req.Sort)]; !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid sort order")
+	}
+
+	list := fmt.Sprintf("shelf:%s:%d", req.ShelfId, req.Sort)
+	page, err := newPageRequest(req.Page, req.Limit)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get shelf books: %v", err)
+		return nil, err
+	}
+	page, err = s.withPageToken(page, req.PageToken, list)
+	if err != nil {
+		return nil, err
 	}
 
-	// Sort logic not implemented in DB (except maybe default).
-	// req.Sort is available. DB query relies on default.
-	// Pagination (limit/page) not implemented in DB.
+	books, total, err := s.DB.GetShelfBooks(ctx, userID, req.ShelfId, data.ShelfSortOrder(req.Sort), page.Query())
+	if err != nil {
+		if errors.Is(err, postgres.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get shelf books: %v", err)
+	}
 
-	var pbBooks []*betterreads.LibraryBook
+	pbBooks := make([]*betterreads.LibraryBook, 0, len(books))
 	for _, b := range books {
-		pbBooks = append(pbBooks, &betterreads.LibraryBook{
-			AuthorName:    b.AuthorName,
-			BookId:        b.BookID,
-			BookImage:     b.BookImage,
-			Rating:        betterreads.BookRating(b.Rating),
-			ShelfIds:      b.ShelfIDs,
-			Source:        betterreads.BookSource(b.Source),
-			ReadingStatus: betterreads.ReadingStatus(b.ReadingStatus),
-			Title:         b.Title,
-			AddedAt:       timestamppb.New(b.AddedAt),
-			UpdatedAt:     timestamppb.New(b.UpdatedAt),
-		})
+		pbBooks = append(pbBooks, libraryBookToProto(b))
 	}
 
+	pagination := page.Metadata(total)
+	pagination.NextPageToken = nextPageToken(s, page, list, books, libraryBookCursor)
+
 	return &betterreads.GetShelfBooksResponse{
-		Books: pbBooks,
-		Pagination: &betterreads.PaginationMetadata{
-			Total: int32(len(books)), //nolint:gosec // G115: len(books) is unlikely to overflow int32
-			Page:  req.Page,
-			Limit: req.Limit,
-		},
+		Books:      pbBooks,
+		Pagination: pagination,
 	}, nil
 }
 
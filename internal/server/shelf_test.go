@@ -618,13 +618,14 @@ func TestServer_GetShelfBooks(t *testing.T) {
 			},
 			setupMock: func(m *mocks.MockAPI) {
 				m.EXPECT().
-					GetShelfBooks(gomock.Any(), testUserID, testShelfID).
-					Return(dbBooks, nil)
+					GetShelfBooks(gomock.Any(), testUserID, testShelfID, data.ShelfSortOrderUnspecified, data.Page{Limit: 10, Offset: 0}).
+					Return(dbBooks, int32(2), nil)
 			},
 			wantCode: codes.OK,
 			wantErr:  false,
 			verify: func(t *testing.T, resp *betterreads.GetShelfBooksResponse) {
 				t.Helper()
+				assert.Empty(t, resp.Pagination.NextPageToken, "a partial page has no next page")
 				require.Len(t, resp.Books, 2)
 				assert.Equal(t, "OL111M", resp.Books[0].BookId)
 				assert.Equal(t, "Dune", resp.Books[0].Title)
@@ -635,23 +636,24 @@ func TestServer_GetShelfBooks(t *testing.T) {
 			},
 		},
 		{
-			name: "pagination metadata is correctly propagated",
+			name: "page, limit and sort are pushed down to the database",
 			ctx:  ctx,
 			request: &betterreads.GetShelfBooksRequest{
 				ShelfId: testShelfID,
 				Page:    3,
 				Limit:   5,
+				Sort:    betterreads.ShelfSortOrder_SHELF_SORT_ORDER_AUTHOR_DESC,
 			},
 			setupMock: func(m *mocks.MockAPI) {
 				m.EXPECT().
-					GetShelfBooks(gomock.Any(), testUserID, testShelfID).
-					Return(dbBooks, nil)
+					GetShelfBooks(gomock.Any(), testUserID, testShelfID, data.ShelfSortOrderAuthorDesc, data.Page{Limit: 5, Offset: 10}).
+					Return(dbBooks, int32(12), nil)
 			},
 			wantCode: codes.OK,
 			wantErr:  false,
 			verify: func(t *testing.T, resp *betterreads.GetShelfBooksResponse) {
 				t.Helper()
-				assert.Equal(t, int32(2), resp.Pagination.Total)
+				assert.Equal(t, int32(12), resp.Pagination.Total)
 				assert.Equal(t, int32(3), resp.Pagination.Page)
 				assert.Equal(t, int32(5), resp.Pagination.Limit)
 			},
@@ -664,8 +666,8 @@ func TestServer_GetShelfBooks(t *testing.T) {
 			},
 			setupMock: func(m *mocks.MockAPI) {
 				m.EXPECT().
-					GetShelfBooks(gomock.Any(), testUserID, testShelfID).
-					Return([]*data.LibraryBook{}, nil)
+					GetShelfBooks(gomock.Any(), testUserID, testShelfID, data.ShelfSortOrderUnspecified, data.Page{Limit: defaultPageLimit}).
+					Return([]*data.LibraryBook{}, int32(0), nil)
 			},
 			wantCode: codes.OK,
 			wantErr:  false,
@@ -673,6 +675,8 @@ func TestServer_GetShelfBooks(t *testing.T) {
 				t.Helper()
 				assert.Empty(t, resp.Books)
 				assert.Equal(t, int32(0), resp.Pagination.Total)
+				assert.Equal(t, int32(1), resp.Pagination.Page)
+				assert.Equal(t, defaultPageLimit, resp.Pagination.Limit)
 			},
 		},
 		{
@@ -695,6 +699,29 @@ func TestServer_GetShelfBooks(t *testing.T) {
 			wantCode:  codes.InvalidArgument,
 			wantErr:   true,
 		},
+		{
+			name: "page token is bound to the sort order",
+			ctx:  ctx,
+			request: &betterreads.GetShelfBooksRequest{
+				ShelfId:   testShelfID,
+				Sort:      betterreads.ShelfSortOrder_SHELF_SORT_ORDER_TITLE_ASC,
+				PageToken: (&Server{}).encodePageToken("shelf:"+testShelfID+":2", libraryBookCursor(dbBooks[0])),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
+		{
+			name: "unknown sort order",
+			ctx:  ctx,
+			request: &betterreads.GetShelfBooksRequest{
+				ShelfId: testShelfID,
+				Sort:    betterreads.ShelfSortOrder(99),
+			},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+			wantErr:   true,
+		},
 		{
 			name: "database error",
 			ctx:  ctx,
@@ -703,8 +730,8 @@ func TestServer_GetShelfBooks(t *testing.T) {
 			},
 			setupMock: func(m *mocks.MockAPI) {
 				m.EXPECT().
-					GetShelfBooks(gomock.Any(), testUserID, testShelfID).
-					Return(nil, errors.New("database connection failed"))
+					GetShelfBooks(gomock.Any(), testUserID, testShelfID, gomock.Any(), gomock.Any()).
+					Return(nil, int32(0), errors.New("database connection failed"))
 			},
 			wantCode: codes.Internal,
 			wantErr:  true,
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetReadingStats implements betterreads.BetterReadsServiceServer.
+func (s *Server) GetReadingStats(ctx context.Context, req *betterreads.GetReadingStatsRequest) (*betterreads.GetReadingStatsResponse, error) {
+	userID, ok := headers.GetUserID(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user not found in context")
+	}
+
+	from, err := optionalTime(req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := optionalTime(req.To)
+	if err != nil {
+		return nil, err
+	}
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+	if from != nil {
+		end := time.Now()
+		if to != nil {
+			end = *to
+		}
+		if from.AddDate(data.MaxStatsYears, 0, 0).Before(end) {
+			return nil, status.Errorf(codes.InvalidArgument, "from and to must be at most %d years apart", data.MaxStatsYears)
+		}
+	}
+
+	stats, err := s.DB.GetReadingStats(ctx, userID, from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reading stats: %v", err)
+	}
+
+	return &betterreads.GetReadingStatsResponse{Stats: readingStatsToProto(stats)}, nil
+}
+
+// optionalTime converts an optional timestamp, returning nil when it is unset.
+func optionalTime(ts *timestamppb.Timestamp) (*time.Time, error) {
+	if ts == nil {
+		return nil, nil //nolint: nilnil // an unset bound is not an error
+	}
+	if ts.CheckValid() != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid timestamp")
+	}
+	t := ts.AsTime()
+	return &t, nil
+}
+
+func readingStatsToProto(s *data.ReadingStats) *betterreads.ReadingStats {
+	stats := &betterreads.ReadingStats{
+		BooksRead:           s.BooksRead,
+		PagesRead:           s.PagesRead,
+		BooksNotFinished:    s.BooksNotFinished,
+		AverageDaysToFinish: s.AverageTimeToFinish.Hours() / 24, //nolint: mnd // hours in a day
+		Ratings:             make([]*betterreads.RatingCount, 0, len(s.RatingCounts)),
+		TopAuthors:          make([]*betterreads.AuthorCount, 0, len(s.TopAuthors)),
+		BooksPerMonth:       make([]*betterreads.MonthCount, 0, len(s.Months)),
+	}
+	if ended := s.BooksRead + s.BooksNotFinished; ended > 0 {
+		stats.DnfRate = float64(s.BooksNotFinished) / float64(ended)
+	}
+	for rating, count := range s.RatingCounts {
+		stats.Ratings = append(stats.Ratings, &betterreads.RatingCount{
+			Rating: betterreads.BookRating(rating), //nolint:gosec // indexes a six-element array
+			Books:  count,
+		})
+	}
+	for _, a := range s.TopAuthors {
+		stats.TopAuthors = append(stats.TopAuthors, &betterreads.AuthorCount{AuthorName: a.AuthorName, Books: a.Books})
+	}
+	for _, m := range s.Months {
+		stats.BooksPerMonth = append(stats.BooksPerMonth, &betterreads.MonthCount{
+			Year:  int32(m.Month.Year()), //nolint:gosec // integer overflow unlikely
+			Month: int32(m.Month.Month()),
+			Books: m.Books,
+			Pages: m.Pages,
+		})
+	}
+	return stats
+}
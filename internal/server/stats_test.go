@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/data"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/postgres/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ---- GetReadingStats ---------------------------------------------------
+
+func TestServer_GetReadingStats(t *testing.T) {
+	t.Parallel()
+
+	testUserID := "test-user-123"
+	ctx := context.WithValue(context.Background(), headers.UserIDContextKey, testUserID)
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		request   *betterreads.GetReadingStatsRequest
+		setupMock func(*mocks.MockAPI)
+		wantCode  codes.Code
+		verify    func(*testing.T, *betterreads.ReadingStats)
+	}{
+		{
+			name:    "year in review",
+			ctx:     ctx,
+			request: &betterreads.GetReadingStatsRequest{From: timestamppb.New(from), To: timestamppb.New(to)},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetReadingStats(gomock.Any(), testUserID, &from, &to).
+					Return(&data.ReadingStats{
+						BooksRead:           3,
+						PagesRead:           900,
+						BooksNotFinished:    1,
+						AverageTimeToFinish: 36 * time.Hour,
+						RatingCounts:        [6]int32{1, 0, 0, 0, 0, 2},
+						TopAuthors:          []*data.AuthorCount{{AuthorName: "Frank Herbert", Books: 2}},
+						Months:              []*data.MonthCount{{Month: from.AddDate(0, 1, 0), Books: 3, Pages: 900}},
+					}, nil)
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, stats *betterreads.ReadingStats) {
+				t.Helper()
+				assert.Equal(t, int32(3), stats.BooksRead)
+				assert.InDelta(t, 0.25, stats.DnfRate, 0.001)
+				assert.InDelta(t, 1.5, stats.AverageDaysToFinish, 0.001)
+				require.Len(t, stats.Ratings, 6)
+				assert.Equal(t, betterreads.BookRating_BOOK_RATING_UNSPECIFIED, stats.Ratings[0].Rating)
+				assert.Equal(t, int32(1), stats.Ratings[0].Books)
+				assert.Equal(t, betterreads.BookRating_BOOK_RATING_FIVE_STAR, stats.Ratings[5].Rating)
+				assert.Equal(t, int32(2), stats.Ratings[5].Books)
+				assert.Equal(t, "Frank Herbert", stats.TopAuthors[0].AuthorName)
+				require.Len(t, stats.BooksPerMonth, 1)
+				assert.Equal(t, int32(2025), stats.BooksPerMonth[0].Year)
+				assert.Equal(t, int32(2), stats.BooksPerMonth[0].Month)
+			},
+		},
+		{
+			name:    "all time with no reads",
+			ctx:     ctx,
+			request: &betterreads.GetReadingStatsRequest{},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetReadingStats(gomock.Any(), testUserID, (*time.Time)(nil), (*time.Time)(nil)).
+					Return(&data.ReadingStats{}, nil)
+			},
+			wantCode: codes.OK,
+			verify: func(t *testing.T, stats *betterreads.ReadingStats) {
+				t.Helper()
+				assert.Zero(t, stats.DnfRate)
+				assert.Len(t, stats.Ratings, 6)
+				assert.Empty(t, stats.BooksPerMonth)
+			},
+		},
+		{
+			name:      "empty range",
+			ctx:       ctx,
+			request:   &betterreads.GetReadingStatsRequest{From: timestamppb.New(to), To: timestamppb.New(from)},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "range too long",
+			ctx:       ctx,
+			request:   &betterreads.GetReadingStatsRequest{From: timestamppb.New(from), To: timestamppb.New(from.AddDate(data.MaxStatsYears, 0, 1))},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "from too long ago",
+			ctx:       ctx,
+			request:   &betterreads.GetReadingStatsRequest{From: timestamppb.New(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC))},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "missing user_id in context",
+			ctx:       context.Background(),
+			request:   &betterreads.GetReadingStatsRequest{},
+			setupMock: func(_ *mocks.MockAPI) {},
+			wantCode:  codes.Unauthenticated,
+		},
+		{
+			name:    "database error",
+			ctx:     ctx,
+			request: &betterreads.GetReadingStatsRequest{},
+			setupMock: func(m *mocks.MockAPI) {
+				m.EXPECT().
+					GetReadingStats(gomock.Any(), testUserID, gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("database connection failed"))
+			},
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockDB := mocks.NewMockAPI(ctrl)
+			tt.setupMock(mockDB)
+
+			s := &Server{DB: mockDB}
+			resp, err := s.GetReadingStats(tt.ctx, tt.request)
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.verify != nil {
+				tt.verify(t, resp.Stats)
+			}
+		})
+	}
+}
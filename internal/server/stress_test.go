@@ -0,0 +1,91 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+
+	betterreads "github.com/celestialdragonfly/betterreads/generated"
+	"github.com/celestialdragonfly/betterreads/internal/headers"
+	"github.com/celestialdragonfly/betterreads/internal/postgres"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestServer_ParallelRPCs drives 100 concurrent RPCs through a single Server
+// backed by a connection pool that is deliberately smaller than the number of
+// callers. It needs a disposable database, see BETTERREADS_TEST_SQL_URL in the
+// postgres package.
+func TestServer_ParallelRPCs(t *testing.T) {
+	dsn := os.Getenv("BETTERREADS_TEST_SQL_URL")
+	if dsn == "" {
+		t.Skip("BETTERREADS_TEST_SQL_URL not set, skipping postgres stress test")
+	}
+
+	const parallel = 100
+
+	ctx := context.Background()
+	client, err := postgres.NewClient(ctx, postgres.Config{URL: dsn, MaxConns: 10})
+	require.NoError(t, err)
+	t.Cleanup(client.Close)
+
+	s := NewServer(&Config{SQLClient: client, PageTokenSecret: []byte("stress")})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, parallel)
+	for i := range parallel {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			userID := uuid.New().String()
+			userCtx := context.WithValue(ctx, headers.UserIDContextKey, userID)
+			defer func() {
+				_ = client.ProfileDelete(ctx, userID)
+			}()
+
+			if _, err := s.CreateUserProfile(userCtx, &betterreads.CreateUserProfileRequest{
+				Username:  "stress-" + userID,
+				Email:     userID + "@example.com",
+				FirstName: "Stress",
+				LastName:  fmt.Sprintf("User %d", i),
+			}); err != nil {
+				errs <- fmt.Errorf("CreateUserProfile: %w", err)
+				return
+			}
+
+			if _, err := s.UpdateLibraryBook(userCtx, &betterreads.UpdateLibraryBookRequest{
+				BookId:        "OL456M",
+				Title:         "Dune",
+				AuthorName:    "Frank Herbert",
+				Source:        betterreads.BookSource_BOOK_SOURCE_OPEN_LIBRARY,
+				ReadingStatus: betterreads.ReadingStatus_READING_STATUS_READING,
+			}); err != nil {
+				errs <- fmt.Errorf("UpdateLibraryBook: %w", err)
+				return
+			}
+
+			library, err := s.GetUserLibrary(userCtx, &betterreads.GetUserLibraryRequest{})
+			if err != nil {
+				errs <- fmt.Errorf("GetUserLibrary: %w", err)
+				return
+			}
+			if library.Pagination.Total != 1 {
+				errs <- fmt.Errorf("GetUserLibrary: got %d books, want 1", library.Pagination.Total)
+				return
+			}
+
+			if _, err := s.GetPersonalizedFeed(userCtx, &betterreads.GetPersonalizedFeedRequest{}); err != nil {
+				errs <- fmt.Errorf("GetPersonalizedFeed: %w", err)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		assert.NoError(t, err)
+	}
+}